@@ -43,11 +43,13 @@ type Client interface {
 //----------------------------------------
 
 // NewClient returns a new ABCI client of the specified transport type.
-// It returns an error if the transport is not "socket" or "grpc"
+// It returns an error if the transport is not "socket", "socket_mux" or "grpc"
 func NewClient(addr, transport string, mustConnect bool) (client Client, err error) {
 	switch transport {
 	case "socket":
 		client = NewSocketClient(addr, mustConnect)
+	case "socket_mux":
+		client = NewMuxSocketClient(addr, mustConnect)
 	case "grpc":
 		client = NewGRPCClient(addr, mustConnect)
 	default:
@@ -0,0 +1,368 @@
+package abcicli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/cometbft/cometbft/abci/types"
+	auto "github.com/cometbft/cometbft/libs/autofile"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// recordingClient is a middleware around an ABCI client, which writes every
+// request it forwards, followed by the response it got back, to an autofile
+// group. Both messages are written as varint length-delimited protobuf
+// messages (see types.WriteMessage), so a recording is a plain sequence of
+// Request/Response pairs that can be read back with a RecordReader.
+//
+// Client errors are recorded as a ResponseException, so that each request
+// always has a matching response in the recording.
+//
+// The recording client takes ownership of the group: it is started together
+// with the wrapped client and flushed, stopped and closed when the client is
+// stopped.
+type recordingClient struct {
+	Client
+
+	mtx    cmtsync.Mutex
+	group  *auto.Group
+	logger log.Logger
+
+	// tracks CheckTxAsync responses that still have to be recorded
+	pending sync.WaitGroup
+}
+
+var _ Client = (*recordingClient)(nil)
+
+// NewRecordingClient returns a new ABCI client, which forwards all calls to
+// client and records them to group.
+func NewRecordingClient(client Client, group *auto.Group) Client {
+	return &recordingClient{
+		Client: client,
+		group:  group,
+		logger: log.NewNopLogger(),
+	}
+}
+
+// OpenRecordingClient opens (or creates) the autofile group with head at
+// headPath and returns a recording client wrapping client.
+func OpenRecordingClient(client Client, headPath string) (Client, error) {
+	group, err := auto.OpenGroup(headPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ABCI recording %q: %w", headPath, err)
+	}
+	return NewRecordingClient(client, group), nil
+}
+
+func (cli *recordingClient) SetLogger(l log.Logger) {
+	cli.mtx.Lock()
+	cli.logger = l
+	cli.mtx.Unlock()
+	cli.group.SetLogger(l)
+	cli.Client.SetLogger(l)
+}
+
+func (cli *recordingClient) Start() error {
+	if err := cli.group.Start(); err != nil {
+		return err
+	}
+	if err := cli.Client.Start(); err != nil {
+		if err := cli.group.Stop(); err != nil {
+			cli.logger.Error("Error stopping ABCI recording", "err", err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (cli *recordingClient) Stop() error {
+	err := cli.Client.Stop()
+	cli.pending.Wait()
+
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+	if err := cli.group.FlushAndSync(); err != nil {
+		cli.logger.Error("Error flushing ABCI recording", "err", err)
+	}
+	if err := cli.group.Stop(); err != nil {
+		cli.logger.Error("Error stopping ABCI recording", "err", err)
+	}
+	cli.group.Close()
+	return err
+}
+
+// record writes a request/response pair to the group. Recording errors are
+// logged rather than returned, as they must not affect the node.
+func (cli *recordingClient) record(req *types.Request, res *types.Response, err error) {
+	switch {
+	case err != nil:
+		res = types.ToResponseException(err.Error())
+	case res == nil || res.Value == nil:
+		res = types.ToResponseException("no response")
+	}
+
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+	if err := types.WriteMessage(req, cli.group); err != nil {
+		cli.logger.Error("Error recording ABCI request", "err", err)
+		return
+	}
+	if err := types.WriteMessage(res, cli.group); err != nil {
+		cli.logger.Error("Error recording ABCI response", "err", err)
+	}
+}
+
+//----------------------------------------
+
+func (cli *recordingClient) CheckTxAsync(ctx context.Context, req *types.RequestCheckTx) (*ReqRes, error) {
+	reqRes, err := cli.Client.CheckTxAsync(ctx, req)
+	if err != nil {
+		cli.record(types.ToRequestCheckTx(req), nil, err)
+		return nil, err
+	}
+	// The response callback is owned by the caller, so wait for the response
+	// on a separate goroutine.
+	cli.pending.Add(1)
+	go func() {
+		defer cli.pending.Done()
+		reqRes.Wait()
+		cli.record(reqRes.Request, reqRes.Response, nil)
+	}()
+	return reqRes, nil
+}
+
+func (cli *recordingClient) Echo(ctx context.Context, msg string) (*types.ResponseEcho, error) {
+	res, err := cli.Client.Echo(ctx, msg)
+	cli.record(types.ToRequestEcho(msg), types.ToResponseEcho(res.GetMessage()), err)
+	return res, err
+}
+
+func (cli *recordingClient) Info(ctx context.Context, req *types.RequestInfo) (*types.ResponseInfo, error) {
+	res, err := cli.Client.Info(ctx, req)
+	cli.record(types.ToRequestInfo(req), types.ToResponseInfo(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) CheckTx(ctx context.Context, req *types.RequestCheckTx) (*types.ResponseCheckTx, error) {
+	res, err := cli.Client.CheckTx(ctx, req)
+	cli.record(types.ToRequestCheckTx(req), types.ToResponseCheckTx(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) Query(ctx context.Context, req *types.RequestQuery) (*types.ResponseQuery, error) {
+	res, err := cli.Client.Query(ctx, req)
+	cli.record(types.ToRequestQuery(req), types.ToResponseQuery(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) Commit(ctx context.Context, req *types.RequestCommit) (*types.ResponseCommit, error) {
+	res, err := cli.Client.Commit(ctx, req)
+	cli.record(types.ToRequestCommit(), types.ToResponseCommit(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) InitChain(ctx context.Context, req *types.RequestInitChain) (*types.ResponseInitChain, error) {
+	res, err := cli.Client.InitChain(ctx, req)
+	cli.record(types.ToRequestInitChain(req), types.ToResponseInitChain(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) ListSnapshots(ctx context.Context, req *types.RequestListSnapshots) (*types.ResponseListSnapshots, error) {
+	res, err := cli.Client.ListSnapshots(ctx, req)
+	cli.record(types.ToRequestListSnapshots(req), types.ToResponseListSnapshots(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) OfferSnapshot(ctx context.Context, req *types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error) {
+	res, err := cli.Client.OfferSnapshot(ctx, req)
+	cli.record(types.ToRequestOfferSnapshot(req), types.ToResponseOfferSnapshot(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) LoadSnapshotChunk(ctx context.Context,
+	req *types.RequestLoadSnapshotChunk,
+) (*types.ResponseLoadSnapshotChunk, error) {
+	res, err := cli.Client.LoadSnapshotChunk(ctx, req)
+	cli.record(types.ToRequestLoadSnapshotChunk(req), types.ToResponseLoadSnapshotChunk(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) ApplySnapshotChunk(ctx context.Context,
+	req *types.RequestApplySnapshotChunk,
+) (*types.ResponseApplySnapshotChunk, error) {
+	res, err := cli.Client.ApplySnapshotChunk(ctx, req)
+	cli.record(types.ToRequestApplySnapshotChunk(req), types.ToResponseApplySnapshotChunk(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) PrepareProposal(ctx context.Context, req *types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	res, err := cli.Client.PrepareProposal(ctx, req)
+	cli.record(types.ToRequestPrepareProposal(req), types.ToResponsePrepareProposal(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) ProcessProposal(ctx context.Context, req *types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	res, err := cli.Client.ProcessProposal(ctx, req)
+	cli.record(types.ToRequestProcessProposal(req), types.ToResponseProcessProposal(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) ExtendVote(ctx context.Context, req *types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	res, err := cli.Client.ExtendVote(ctx, req)
+	cli.record(types.ToRequestExtendVote(req), types.ToResponseExtendVote(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) VerifyVoteExtension(ctx context.Context, req *types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	res, err := cli.Client.VerifyVoteExtension(ctx, req)
+	cli.record(types.ToRequestVerifyVoteExtension(req), types.ToResponseVerifyVoteExtension(res), err)
+	return res, err
+}
+
+func (cli *recordingClient) FinalizeBlock(ctx context.Context, req *types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error) {
+	res, err := cli.Client.FinalizeBlock(ctx, req)
+	cli.record(types.ToRequestFinalizeBlock(req), types.ToResponseFinalizeBlock(res), err)
+	return res, err
+}
+
+//----------------------------------------
+
+// RecordReader reads the request/response pairs written by a recording
+// client.
+type RecordReader struct {
+	r io.Reader
+}
+
+// NewRecordReader returns a RecordReader reading from r. Callers should pass
+// a buffered reader, as messages are read without buffering.
+func NewRecordReader(r io.Reader) *RecordReader {
+	return &RecordReader{r: r}
+}
+
+// Next returns the next recorded request and its response. It returns io.EOF
+// once the end of the recording has been reached, and io.ErrUnexpectedEOF if
+// the recording ends with a request that has no response (e.g. because the
+// node crashed while the request was in flight).
+func (rr *RecordReader) Next() (*types.Request, *types.Response, error) {
+	req := new(types.Request)
+	if err := types.ReadMessage(rr.r, req); err != nil {
+		return nil, nil, err
+	}
+	res := new(types.Response)
+	if err := types.ReadMessage(rr.r, res); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return req, nil, err
+	}
+	return req, res, nil
+}
+
+// Call sends req to client using the matching ABCI method and returns the
+// response wrapped in a types.Response. Client errors are returned as is.
+// Flush requests are forwarded to Client.Flush.
+func Call(ctx context.Context, client Client, req *types.Request) (*types.Response, error) {
+	switch r := req.Value.(type) {
+	case *types.Request_Echo:
+		res, err := client.Echo(ctx, r.Echo.Message)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseEcho(res.Message), nil
+	case *types.Request_Flush:
+		if err := client.Flush(ctx); err != nil {
+			return nil, err
+		}
+		return types.ToResponseFlush(), nil
+	case *types.Request_Info:
+		res, err := client.Info(ctx, r.Info)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseInfo(res), nil
+	case *types.Request_CheckTx:
+		res, err := client.CheckTx(ctx, r.CheckTx)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseCheckTx(res), nil
+	case *types.Request_Commit:
+		res, err := client.Commit(ctx, r.Commit)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseCommit(res), nil
+	case *types.Request_Query:
+		res, err := client.Query(ctx, r.Query)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseQuery(res), nil
+	case *types.Request_InitChain:
+		res, err := client.InitChain(ctx, r.InitChain)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseInitChain(res), nil
+	case *types.Request_ListSnapshots:
+		res, err := client.ListSnapshots(ctx, r.ListSnapshots)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseListSnapshots(res), nil
+	case *types.Request_OfferSnapshot:
+		res, err := client.OfferSnapshot(ctx, r.OfferSnapshot)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseOfferSnapshot(res), nil
+	case *types.Request_LoadSnapshotChunk:
+		res, err := client.LoadSnapshotChunk(ctx, r.LoadSnapshotChunk)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseLoadSnapshotChunk(res), nil
+	case *types.Request_ApplySnapshotChunk:
+		res, err := client.ApplySnapshotChunk(ctx, r.ApplySnapshotChunk)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseApplySnapshotChunk(res), nil
+	case *types.Request_PrepareProposal:
+		res, err := client.PrepareProposal(ctx, r.PrepareProposal)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponsePrepareProposal(res), nil
+	case *types.Request_ProcessProposal:
+		res, err := client.ProcessProposal(ctx, r.ProcessProposal)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseProcessProposal(res), nil
+	case *types.Request_ExtendVote:
+		res, err := client.ExtendVote(ctx, r.ExtendVote)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseExtendVote(res), nil
+	case *types.Request_VerifyVoteExtension:
+		res, err := client.VerifyVoteExtension(ctx, r.VerifyVoteExtension)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseVerifyVoteExtension(res), nil
+	case *types.Request_FinalizeBlock:
+		res, err := client.FinalizeBlock(ctx, r.FinalizeBlock)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseFinalizeBlock(res), nil
+	default:
+		return nil, fmt.Errorf("unknown ABCI request type %T", req.Value)
+	}
+}
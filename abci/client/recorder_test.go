@@ -0,0 +1,64 @@
+package abcicli_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/abci/types"
+	auto "github.com/cometbft/cometbft/libs/autofile"
+)
+
+func TestRecordingClient(t *testing.T) {
+	ctx := context.Background()
+	headPath := filepath.Join(t.TempDir(), "consensus.rec")
+
+	c, err := abcicli.OpenRecordingClient(abcicli.NewLocalClient(nil, kvstore.NewInMemoryApplication()), headPath)
+	require.NoError(t, err)
+	c.SetResponseCallback(func(*types.Request, *types.Response) {})
+	require.NoError(t, c.Start())
+
+	_, err = c.Info(ctx, &types.RequestInfo{})
+	require.NoError(t, err)
+	_, err = c.FinalizeBlock(ctx, &types.RequestFinalizeBlock{Height: 1, Txs: [][]byte{[]byte("a=1")}})
+	require.NoError(t, err)
+	_, err = c.Commit(ctx, &types.RequestCommit{})
+	require.NoError(t, err)
+	reqRes, err := c.CheckTxAsync(ctx, &types.RequestCheckTx{Tx: []byte("b=2")})
+	require.NoError(t, err)
+	reqRes.Wait()
+	require.NoError(t, c.Stop())
+
+	group, err := auto.OpenGroup(headPath)
+	require.NoError(t, err)
+	defer group.Close()
+	gr, err := group.NewReader(group.MinIndex())
+	require.NoError(t, err)
+	defer gr.Close()
+
+	// Replaying the recording against a fresh application must yield the same
+	// responses.
+	replayClient := abcicli.NewLocalClient(nil, kvstore.NewInMemoryApplication())
+	rr := abcicli.NewRecordReader(bufio.NewReader(gr))
+	n := 0
+	for {
+		req, recorded, err := rr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		n++
+
+		replayed, err := abcicli.Call(ctx, replayClient, req)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(recorded, replayed), "request %v: recorded %v, replayed %v", req, recorded, replayed)
+	}
+	require.Equal(t, 4, n)
+}
@@ -0,0 +1,429 @@
+package abcicli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/abci/types"
+	cmtnet "github.com/cometbft/cometbft/libs/net"
+	"github.com/cometbft/cometbft/libs/service"
+)
+
+// muxSocketClient is the client side implementation of the multiplexed socket
+// protocol. Unlike socketClient, every request is tagged with an ID and the
+// server may answer requests out of order: CheckTx requests are handled in the
+// order they are sent, one at a time, while the other requests may be answered
+// ahead of them. This is mostly useful for the mempool connection, where many
+// CheckTx requests are in flight at the same time, as the server then holds
+// the application for one CheckTx at a time and the requests of the other
+// connections, e.g. FinalizeBlock, don't wait for the whole backlog.
+//
+// The connection starts with types.MuxPreamble, after which requests and
+// responses are written with types.WriteMuxMessage. A Flush request is a
+// barrier: the server answers it only once all requests sent before it have
+// been answered.
+//
+// This is goroutine-safe.
+type muxSocketClient struct {
+	service.BaseService
+
+	addr        string
+	mustConnect bool
+	conn        net.Conn
+
+	reqQueue chan *ReqRes
+
+	mtx     sync.Mutex
+	err     error
+	nextID  uint64
+	reqSent map[uint64]*ReqRes                    // requests sent, waiting for response
+	resCb   func(*types.Request, *types.Response) // called on all requests, if set.
+}
+
+var _ Client = (*muxSocketClient)(nil)
+
+// NewMuxSocketClient creates a new multiplexed socket client, which connects
+// to a given address. If mustConnect is true, the client will return an error
+// upon start if it fails to connect else it will continue to retry.
+func NewMuxSocketClient(addr string, mustConnect bool) Client {
+	cli := &muxSocketClient{
+		reqQueue:    make(chan *ReqRes, reqQueueSize),
+		mustConnect: mustConnect,
+
+		addr:    addr,
+		reqSent: make(map[uint64]*ReqRes),
+		resCb:   nil,
+	}
+	cli.BaseService = *service.NewBaseService(nil, "muxSocketClient", cli)
+	return cli
+}
+
+// OnStart implements Service by connecting to the server, switching it to the
+// multiplexed protocol and spawning reading and writing goroutines.
+func (cli *muxSocketClient) OnStart() error {
+	for {
+		conn, err := cmtnet.Connect(cli.addr)
+		if err == nil {
+			_, err = conn.Write(types.MuxPreamble)
+			if err != nil {
+				conn.Close()
+			}
+		}
+		if err != nil {
+			if cli.mustConnect {
+				return err
+			}
+			cli.Logger.Error(fmt.Sprintf("abci.muxSocketClient failed to connect to %v.  Retrying after %vs...",
+				cli.addr, dialRetryIntervalSeconds), "err", err)
+			time.Sleep(time.Second * dialRetryIntervalSeconds)
+			continue
+		}
+		cli.conn = conn
+
+		go cli.sendRequestsRoutine(conn)
+		go cli.recvResponseRoutine(conn)
+
+		return nil
+	}
+}
+
+// OnStop implements Service by closing connection and flushing all queues.
+func (cli *muxSocketClient) OnStop() {
+	if cli.conn != nil {
+		cli.conn.Close()
+	}
+
+	cli.flushQueue()
+}
+
+// Error returns an error if the client was stopped abruptly.
+func (cli *muxSocketClient) Error() error {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+	return cli.err
+}
+
+//----------------------------------------
+
+// SetResponseCallback sets a callback, which will be executed for each
+// non-error & non-empty response from the server.
+//
+// NOTE: callback may get flush responses.
+func (cli *muxSocketClient) SetResponseCallback(resCb Callback) {
+	cli.mtx.Lock()
+	cli.resCb = resCb
+	cli.mtx.Unlock()
+}
+
+func (cli *muxSocketClient) CheckTxAsync(ctx context.Context, req *types.RequestCheckTx) (*ReqRes, error) {
+	return cli.queueRequest(ctx, types.ToRequestCheckTx(req))
+}
+
+//----------------------------------------
+
+func (cli *muxSocketClient) sendRequestsRoutine(conn io.Writer) {
+	w := bufio.NewWriter(conn)
+	for {
+		select {
+		case reqres := <-cli.reqQueue:
+			// N.B. We must track the request before sending it out, otherwise
+			// the server may reply before we do it, and the receiver will fail
+			// for an unsolicited reply.
+			id, ok := cli.trackRequest(reqres)
+			if !ok {
+				return
+			}
+
+			if err := types.WriteMuxMessage(id, reqres.Request, w); err != nil {
+				cli.stopForError(fmt.Errorf("write to buffer: %w", err))
+				return
+			}
+
+			// Batch writes while there are more requests queued, and flush as
+			// soon as the queue is drained.
+			if len(cli.reqQueue) == 0 {
+				if err := w.Flush(); err != nil {
+					cli.stopForError(fmt.Errorf("flush buffer: %w", err))
+					return
+				}
+			}
+		case <-cli.Quit():
+			return
+		}
+	}
+}
+
+func (cli *muxSocketClient) recvResponseRoutine(conn io.Reader) {
+	r := bufio.NewReader(conn)
+	for {
+		if !cli.IsRunning() {
+			return
+		}
+
+		res := &types.Response{}
+		id, err := types.ReadMuxMessage(r, res)
+		if err != nil {
+			cli.stopForError(fmt.Errorf("read message: %w", err))
+			return
+		}
+
+		switch r := res.Value.(type) {
+		case *types.Response_Exception: // app responded with error
+			// XXX After setting cli.err, release waiters (e.g. reqres.Done())
+			cli.stopForError(errors.New(r.Exception.Error))
+			return
+		default:
+			if err := cli.didRecvResponse(id, res); err != nil {
+				cli.stopForError(err)
+				return
+			}
+		}
+	}
+}
+
+func (cli *muxSocketClient) trackRequest(reqres *ReqRes) (uint64, bool) {
+	// N.B. We must NOT hold the client state lock while checking this, or we
+	// may deadlock with shutdown.
+	if !cli.IsRunning() {
+		return 0, false
+	}
+
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+	id := cli.nextID
+	cli.nextID++
+	cli.reqSent[id] = reqres
+	return id, true
+}
+
+func (cli *muxSocketClient) didRecvResponse(id uint64, res *types.Response) error {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	reqres, ok := cli.reqSent[id]
+	if !ok {
+		return ErrUnexpectedResponse{Response: *res, Reason: fmt.Sprintf("no call was made with ID %d", id)}
+	}
+	if !resMatchesReq(reqres.Request, res) {
+		return ErrUnexpectedResponse{Response: *res, Reason: fmt.Sprintf("unexpected response to the request %T", reqres.Request.Value)}
+	}
+
+	reqres.Response = res
+	reqres.Done() // release waiters
+	delete(cli.reqSent, id)
+
+	// Notify client listener if set (global callback).
+	if cli.resCb != nil {
+		cli.resCb(reqres.Request, res)
+	}
+
+	// Notify reqRes listener if set (request specific callback).
+	//
+	// NOTE: It is possible this callback isn't set on the reqres object. At this
+	// point, in which case it will be called after, when it is set.
+	reqres.InvokeCallback()
+
+	return nil
+}
+
+//----------------------------------------
+
+// Flush waits until the responses to all the requests sent before it have
+// been received.
+func (cli *muxSocketClient) Flush(ctx context.Context) error {
+	_, err := cli.doRequest(ctx, types.ToRequestFlush())
+	return err
+}
+
+func (cli *muxSocketClient) Echo(ctx context.Context, msg string) (*types.ResponseEcho, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestEcho(msg))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetEcho(), cli.Error()
+}
+
+func (cli *muxSocketClient) Info(ctx context.Context, req *types.RequestInfo) (*types.ResponseInfo, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestInfo(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetInfo(), cli.Error()
+}
+
+func (cli *muxSocketClient) CheckTx(ctx context.Context, req *types.RequestCheckTx) (*types.ResponseCheckTx, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestCheckTx(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetCheckTx(), cli.Error()
+}
+
+func (cli *muxSocketClient) Query(ctx context.Context, req *types.RequestQuery) (*types.ResponseQuery, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestQuery(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetQuery(), cli.Error()
+}
+
+func (cli *muxSocketClient) Commit(ctx context.Context, _ *types.RequestCommit) (*types.ResponseCommit, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestCommit())
+	if err != nil {
+		return nil, err
+	}
+	return res.GetCommit(), cli.Error()
+}
+
+func (cli *muxSocketClient) InitChain(ctx context.Context, req *types.RequestInitChain) (*types.ResponseInitChain, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestInitChain(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetInitChain(), cli.Error()
+}
+
+func (cli *muxSocketClient) ListSnapshots(ctx context.Context, req *types.RequestListSnapshots) (*types.ResponseListSnapshots, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestListSnapshots(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetListSnapshots(), cli.Error()
+}
+
+func (cli *muxSocketClient) OfferSnapshot(ctx context.Context, req *types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestOfferSnapshot(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetOfferSnapshot(), cli.Error()
+}
+
+func (cli *muxSocketClient) LoadSnapshotChunk(ctx context.Context, req *types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestLoadSnapshotChunk(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetLoadSnapshotChunk(), cli.Error()
+}
+
+func (cli *muxSocketClient) ApplySnapshotChunk(ctx context.Context, req *types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestApplySnapshotChunk(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetApplySnapshotChunk(), cli.Error()
+}
+
+func (cli *muxSocketClient) PrepareProposal(ctx context.Context, req *types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestPrepareProposal(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetPrepareProposal(), cli.Error()
+}
+
+func (cli *muxSocketClient) ProcessProposal(ctx context.Context, req *types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestProcessProposal(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetProcessProposal(), cli.Error()
+}
+
+func (cli *muxSocketClient) ExtendVote(ctx context.Context, req *types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestExtendVote(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetExtendVote(), cli.Error()
+}
+
+func (cli *muxSocketClient) VerifyVoteExtension(ctx context.Context, req *types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestVerifyVoteExtension(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetVerifyVoteExtension(), cli.Error()
+}
+
+func (cli *muxSocketClient) FinalizeBlock(ctx context.Context, req *types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error) {
+	res, err := cli.doRequest(ctx, types.ToRequestFinalizeBlock(req))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetFinalizeBlock(), cli.Error()
+}
+
+// doRequest queues req and waits for its response. As requests are flushed
+// as soon as the queue is drained, there is no need for an explicit Flush.
+func (cli *muxSocketClient) doRequest(ctx context.Context, req *types.Request) (*types.Response, error) {
+	reqRes, err := cli.queueRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	reqRes.Wait()
+	if err := cli.Error(); err != nil {
+		return nil, err
+	}
+	return reqRes.Response, nil
+}
+
+func (cli *muxSocketClient) queueRequest(ctx context.Context, req *types.Request) (*ReqRes, error) {
+	reqres := NewReqRes(req)
+
+	select {
+	case cli.reqQueue <- reqres:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return reqres, nil
+}
+
+// flushQueue marks as complete and discards all remaining pending requests
+// from the queue.
+func (cli *muxSocketClient) flushQueue() {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	// mark all in-flight messages as resolved (they will get cli.Error())
+	for id, reqres := range cli.reqSent {
+		reqres.Done()
+		delete(cli.reqSent, id)
+	}
+
+	// mark all queued messages as resolved
+LOOP:
+	for {
+		select {
+		case reqres := <-cli.reqQueue:
+			reqres.Done()
+		default:
+			break LOOP
+		}
+	}
+}
+
+func (cli *muxSocketClient) stopForError(err error) {
+	if !cli.IsRunning() {
+		return
+	}
+
+	cli.mtx.Lock()
+	if cli.err == nil {
+		cli.err = err
+	}
+	cli.mtx.Unlock()
+
+	cli.Logger.Error(fmt.Sprintf("Stopping abci.muxSocketClient for error: %v", err.Error()))
+	if err := cli.Stop(); err != nil {
+		cli.Logger.Error("Error stopping abci.muxSocketClient", "err", err)
+	}
+}
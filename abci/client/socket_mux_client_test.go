@@ -0,0 +1,166 @@
+package abcicli_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/abci/server"
+	"github.com/cometbft/cometbft/abci/types"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+)
+
+func TestMuxSocketClient(t *testing.T) {
+	ctx := t.Context()
+
+	// some port between 20k and 30k
+	port := 20000 + cmtrand.Int32()%10000
+	addr := fmt.Sprintf("localhost:%d", port)
+
+	s := server.NewSocketServer(addr, kvstore.NewInMemoryApplication())
+	require.NoError(t, s.Start())
+	t.Cleanup(func() {
+		if err := s.Stop(); err != nil {
+			t.Log(err)
+		}
+	})
+
+	// A multiplexed and a regular client share the same server.
+	muxClient := abcicli.NewMuxSocketClient(addr, true)
+	require.NoError(t, muxClient.Start())
+	t.Cleanup(func() {
+		if err := muxClient.Stop(); err != nil {
+			t.Log(err)
+		}
+	})
+	c := abcicli.NewSocketClient(addr, true)
+	require.NoError(t, c.Start())
+	t.Cleanup(func() {
+		if err := c.Stop(); err != nil {
+			t.Log(err)
+		}
+	})
+
+	var globalCbs atomic.Int32
+	muxClient.SetResponseCallback(func(req *types.Request, _ *types.Response) {
+		if _, ok := req.Value.(*types.Request_CheckTx); ok {
+			globalCbs.Add(1)
+		}
+	})
+
+	const numTxs = 1000
+	var okTxs atomic.Int32
+	for i := 0; i < numTxs; i++ {
+		reqRes, err := muxClient.CheckTxAsync(ctx, &types.RequestCheckTx{Tx: []byte(fmt.Sprintf("k%d=v", i))})
+		require.NoError(t, err)
+		reqRes.SetCallback(func(res *types.Response) {
+			if res.GetCheckTx().Code == types.CodeTypeOK {
+				okTxs.Add(1)
+			}
+		})
+
+		if i == numTxs/2 {
+			// Synchronous calls on the other connection are not blocked.
+			_, err := c.FinalizeBlock(ctx, &types.RequestFinalizeBlock{Height: 1})
+			require.NoError(t, err)
+		}
+	}
+
+	// Flush returns only once all previous requests have been answered.
+	require.NoError(t, muxClient.Flush(ctx))
+	require.EqualValues(t, numTxs, globalCbs.Load())
+	require.EqualValues(t, numTxs, okTxs.Load())
+
+	res, err := muxClient.Echo(context.Background(), "hello")
+	require.NoError(t, err)
+	require.Equal(t, "hello", res.Message)
+
+	info, err := muxClient.Info(ctx, &types.RequestInfo{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, info.LastBlockHeight)
+}
+
+// slowCheckTxApp takes checkTxDuration to check a transaction, and records the
+// transactions it checked and how many were checked before FinalizeBlock.
+type slowCheckTxApp struct {
+	*types.BaseApplication
+
+	checked           chan []byte
+	numChecked        atomic.Int32
+	checkedAtFinalize atomic.Int32
+}
+
+const checkTxDuration = 2 * time.Millisecond
+
+func (app *slowCheckTxApp) CheckTx(_ context.Context, req *types.RequestCheckTx) (*types.ResponseCheckTx, error) {
+	time.Sleep(checkTxDuration)
+	app.checked <- req.Tx
+	app.numChecked.Add(1)
+	return &types.ResponseCheckTx{Code: types.CodeTypeOK}, nil
+}
+
+func (app *slowCheckTxApp) FinalizeBlock(context.Context, *types.RequestFinalizeBlock) (*types.ResponseFinalizeBlock, error) {
+	app.checkedAtFinalize.Store(app.numChecked.Load())
+	return &types.ResponseFinalizeBlock{}, nil
+}
+
+func TestMuxSocketClientFinalizeBlockUnderCheckTxLoad(t *testing.T) {
+	ctx := t.Context()
+
+	port := 20000 + cmtrand.Int32()%10000
+	addr := fmt.Sprintf("localhost:%d", port)
+
+	const numTxs = 300
+	app := &slowCheckTxApp{BaseApplication: types.NewBaseApplication(), checked: make(chan []byte, numTxs)}
+	s := server.NewSocketServer(addr, app)
+	require.NoError(t, s.Start())
+	t.Cleanup(func() {
+		if err := s.Stop(); err != nil {
+			t.Log(err)
+		}
+	})
+
+	muxClient := abcicli.NewMuxSocketClient(addr, true)
+	require.NoError(t, muxClient.Start())
+	t.Cleanup(func() {
+		if err := muxClient.Stop(); err != nil {
+			t.Log(err)
+		}
+	})
+	c := abcicli.NewSocketClient(addr, true)
+	require.NoError(t, c.Start())
+	t.Cleanup(func() {
+		if err := c.Stop(); err != nil {
+			t.Log(err)
+		}
+	})
+
+	// Saturate the mempool connection: far more CheckTx requests are queued than
+	// handled concurrently by the server.
+	for i := 0; i < numTxs; i++ {
+		_, err := muxClient.CheckTxAsync(ctx, &types.RequestCheckTx{Tx: []byte(fmt.Sprintf("tx%03d", i))})
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool { return app.numChecked.Load() >= 10 }, time.Second, time.Millisecond)
+
+	// FinalizeBlock on the consensus connection waits for the CheckTx being
+	// handled, not for the backlog of the mempool connection.
+	checkedBefore := app.numChecked.Load()
+	_, err := c.FinalizeBlock(ctx, &types.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	require.LessOrEqual(t, app.checkedAtFinalize.Load()-checkedBefore, int32(5))
+	require.Less(t, app.checkedAtFinalize.Load(), int32(numTxs/2))
+
+	// The application checked the transactions in the order they were sent.
+	require.NoError(t, muxClient.Flush(ctx))
+	require.EqualValues(t, numTxs, app.numChecked.Load())
+	for i := 0; i < numTxs; i++ {
+		require.Equal(t, fmt.Sprintf("tx%03d", i), string(<-app.checked))
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"os"
 	"strings"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
+	auto "github.com/cometbft/cometbft/libs/autofile"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtos "github.com/cometbft/cometbft/libs/os"
 
@@ -44,6 +46,9 @@ var (
 
 	// kvstore
 	flagPersist string
+
+	// replay
+	flagStopOnDiff bool
 )
 
 var RootCmd = &cobra.Command{
@@ -109,7 +114,7 @@ func addGlobalFlags() {
 		"",
 		"tcp://0.0.0.0:26658",
 		"address of application socket")
-	RootCmd.PersistentFlags().StringVarP(&flagAbci, "abci", "", "socket", "either socket or grpc")
+	RootCmd.PersistentFlags().StringVarP(&flagAbci, "abci", "", "socket", "either socket, socket_mux or grpc")
 	RootCmd.PersistentFlags().BoolVarP(&flagVerbose,
 		"verbose",
 		"v",
@@ -128,6 +133,14 @@ func addQueryFlags() {
 		"whether or not to return a merkle proof of the query result")
 }
 
+func addReplayFlags() {
+	replayCmd.PersistentFlags().BoolVarP(&flagStopOnDiff,
+		"stop-on-diff",
+		"",
+		false,
+		"stop replaying at the first response that differs from the recording")
+}
+
 func addKVStoreFlags() {
 	kvstoreCmd.PersistentFlags().StringVarP(&flagPersist, "persist", "", "", "directory to use for a database")
 }
@@ -146,6 +159,8 @@ func addCommands() {
 	addQueryFlags()
 	RootCmd.AddCommand(queryCmd)
 	RootCmd.AddCommand(finalizeBlockCmd)
+	addReplayFlags()
+	RootCmd.AddCommand(replayCmd)
 
 	// examples
 	addKVStoreFlags()
@@ -263,6 +278,21 @@ var queryCmd = &cobra.Command{
 	RunE:  cmdQuery,
 }
 
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "replay a recording of ABCI requests against an application",
+	Long: `replay a recording of ABCI requests against an application
+
+This command reads a recording written by a node with abci_record_dir set
+(e.g. <abci_record_dir>/consensus.rec), sends every recorded request to the
+application and reports every response that differs from the recorded one:
+
+    abci-cli replay ~/.cometbft/abci-records/consensus.rec
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: cmdReplay,
+}
+
 var kvstoreCmd = &cobra.Command{
 	Use:   "kvstore",
 	Short: "ABCI demo example",
@@ -694,6 +724,59 @@ func cmdProcessProposal(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// Replay a recording of ABCI requests and diff the responses
+func cmdReplay(cmd *cobra.Command, args []string) error {
+	group, err := auto.OpenGroup(args[0])
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+	gr, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	var (
+		rr    = abcicli.NewRecordReader(bufio.NewReader(gr))
+		total int
+		diffs int
+	)
+	for ; ; total++ {
+		req, recorded, err := rr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read request #%d: %w", total, err)
+		}
+
+		replayed, err := abcicli.Call(cmd.Context(), client, req)
+		if err != nil {
+			replayed = types.ToResponseException(err.Error())
+		}
+		if proto.Equal(recorded, replayed) {
+			continue
+		}
+
+		diffs++
+		fmt.Printf("-> request #%d (%T) differs\n", total, req.Value)
+		fmt.Printf("-> request: %v\n", req)
+		fmt.Printf("-> recorded: %v\n", recorded)
+		fmt.Printf("-> replayed: %v\n", replayed)
+		if flagStopOnDiff {
+			total++
+			break
+		}
+	}
+
+	fmt.Printf("-> replayed %d requests, %d responses differ\n", total, diffs)
+	if diffs > 0 {
+		return fmt.Errorf("%d of %d responses differ from the recording", diffs, total)
+	}
+	return nil
+}
+
 func cmdKVStore(*cobra.Command, []string) error {
 	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
 
@@ -3,7 +3,8 @@ Package server is used to start a new ABCI server.
 
 It contains two server implementation:
   - gRPC server
-  - socket server
+  - socket server, which also serves clients using the multiplexed socket
+    protocol
 */
 package server
 
@@ -18,7 +19,7 @@ func NewServer(protoAddr, transport string, app types.Application) (service.Serv
 	var s service.Service
 	var err error
 	switch transport {
-	case "socket":
+	case "socket", "socket_mux":
 		s = NewSocketServer(protoAddr, app)
 	case "grpc":
 		s = NewGRPCServer(protoAddr, app)
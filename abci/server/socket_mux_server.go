@@ -0,0 +1,155 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/cometbft/cometbft/abci/types"
+)
+
+// maxMuxInflightRequests bounds the number of requests of a multiplexed
+// connection that are handled concurrently, and the number of CheckTx requests
+// queued for handling.
+const maxMuxInflightRequests = 64
+
+type muxRequest struct {
+	id  uint64
+	req *types.Request
+}
+
+type muxResponse struct {
+	id  uint64
+	res *types.Response
+}
+
+// handleMuxRequests reads requests of the multiplexed socket protocol.
+//
+// CheckTx requests are handled one at a time, in the order they are read, by
+// handleMuxCheckTxs. The application therefore sees them in the order the
+// client sent them, which matters to applications relying on the sequence of
+// the transactions (e.g. nonces), and the connection holds the application
+// mutex for a single CheckTx at a time: the requests of the other
+// connections, e.g. FinalizeBlock on the consensus connection, wait for at
+// most one CheckTx instead of the whole backlog of the mempool.
+//
+// The other requests are handled each on their own goroutine, so that their
+// responses may be sent before the responses to the CheckTx requests queued
+// before them.
+//
+// Flush requests are barriers: they are answered once all the requests read
+// before them have been answered.
+func (s *SocketServer) handleMuxRequests(closeConn chan error, bufReader *bufio.Reader, responses chan<- muxResponse) {
+	var (
+		inflight sync.WaitGroup
+		sem      = make(chan struct{}, maxMuxInflightRequests)
+		checkTxs = make(chan muxRequest, maxMuxInflightRequests)
+	)
+	go s.handleMuxCheckTxs(checkTxs, responses, &inflight)
+	defer close(checkTxs)
+
+	for {
+		req := &types.Request{}
+		id, err := types.ReadMuxMessage(bufReader, req)
+		if err != nil {
+			if err == io.EOF {
+				closeConn <- err
+			} else {
+				closeConn <- fmt.Errorf("error reading message: %w", err)
+			}
+			return
+		}
+
+		switch req.Value.(type) {
+		case *types.Request_Flush:
+			inflight.Wait()
+			responses <- muxResponse{id: id, res: types.ToResponseFlush()}
+			continue
+		case *types.Request_CheckTx:
+			inflight.Add(1)
+			checkTxs <- muxRequest{id: id, req: req}
+			continue
+		}
+
+		sem <- struct{}{}
+		inflight.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				inflight.Done()
+			}()
+			responses <- muxResponse{id: id, res: s.handleMuxRequest(req)}
+		}()
+	}
+}
+
+// handleMuxCheckTxs handles the CheckTx requests of a multiplexed connection
+// sequentially, in the order they were read, until checkTxs is closed.
+func (s *SocketServer) handleMuxCheckTxs(checkTxs <-chan muxRequest, responses chan<- muxResponse, inflight *sync.WaitGroup) {
+	for r := range checkTxs {
+		responses <- muxResponse{id: r.id, res: s.handleMuxRequest(r.req)}
+		inflight.Done()
+	}
+}
+
+// handleMuxRequest calls the application and returns its response, or an
+// exception if the application returned an error or panicked.
+func (s *SocketServer) handleMuxRequest(req *types.Request) (res *types.Response) {
+	s.appMtx.Lock()
+	defer func() {
+		// make sure to recover from any app-related panics to allow proper socket
+		// cleanup. The exception is sent back to the client, which closes the
+		// connection.
+		if r := recover(); r != nil {
+			const size = 64 << 10
+			buf := make([]byte, size)
+			buf = buf[:runtime.Stack(buf, false)]
+			err := fmt.Errorf("recovered from panic: %v\n%s", r, buf)
+			if !s.isLoggerSet {
+				fmt.Fprintln(os.Stderr, err)
+			}
+			res = types.ToResponseException(err.Error())
+		}
+		s.appMtx.Unlock()
+	}()
+
+	resp, err := s.handleRequest(context.TODO(), req)
+	if err != nil {
+		// any error either from the application or because of an unknown request
+		// throws an exception back to the client. This will stop the server and
+		// should also halt the client.
+		return types.ToResponseException(err.Error())
+	}
+	return resp
+}
+
+// Pull responses from 'responses' and write them to conn, flushing the write
+// buffer whenever no more responses are pending.
+func (s *SocketServer) handleMuxResponses(closeConn chan error, conn io.Writer, responses <-chan muxResponse) {
+	bufWriter := bufio.NewWriter(conn)
+	for {
+		r := <-responses
+		if err := types.WriteMuxMessage(r.id, r.res, bufWriter); err != nil {
+			closeConn <- fmt.Errorf("error writing message: %w", err)
+			return
+		}
+		if len(responses) == 0 {
+			if err := bufWriter.Flush(); err != nil {
+				closeConn <- fmt.Errorf("error flushing write buffer: %w", err)
+				return
+			}
+		}
+
+		// If the application has responded with an exception, the server returns the error
+		// back to the client and closes the connection. The receiving CometBFT client should
+		// log the error and gracefully terminate
+		if e, ok := r.res.Value.(*types.Response_Exception); ok {
+			closeConn <- errors.New(e.Exception.Error)
+		}
+	}
+}
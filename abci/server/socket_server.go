@@ -128,13 +128,12 @@ func (s *SocketServer) acceptConnectionsRoutine() {
 
 		connID := s.addConn(conn)
 
-		closeConn := make(chan error, 2)                            // Push to signal connection closed
-		responses := make(chan *types.Response, responseBufferSize) // A channel to buffer responses
+		closeConn := make(chan error, 2) // Push to signal connection closed
 
-		// Read requests from conn and deal with them
-		go s.handleRequests(closeConn, conn, responses)
-		// Pull responses from 'responses' and write them to conn.
-		go s.handleResponses(closeConn, conn, responses)
+		// Read requests from conn and deal with them. Depending on the first
+		// message, this either serves the socket protocol or the multiplexed
+		// socket protocol.
+		go s.handleConn(closeConn, conn)
 
 		// Wait until signal to close connection
 		go s.waitForClose(closeConn, connID)
@@ -159,10 +158,45 @@ func (s *SocketServer) waitForClose(closeConn chan error, connID int) {
 	}
 }
 
-// Read requests from conn and deal with them
-func (s *SocketServer) handleRequests(closeConn chan error, conn io.Reader, responses chan<- *types.Response) {
+// handleConn reads the first message from conn and serves the connection with
+// the protocol selected by the client: a connection starting with
+// types.MuxPreamble uses the multiplexed socket protocol, any other connection
+// the regular socket protocol.
+func (s *SocketServer) handleConn(closeConn chan error, conn io.ReadWriter) {
 	bufReader := bufio.NewReader(conn)
 
+	req := &types.Request{}
+	if err := types.ReadMessage(bufReader, req); err != nil {
+		if err == io.EOF {
+			closeConn <- err
+		} else {
+			closeConn <- fmt.Errorf("error reading message: %w", err)
+		}
+		return
+	}
+
+	if req.Value == nil {
+		s.Logger.Info("Serving connection with the multiplexed socket protocol")
+		responses := make(chan muxResponse, responseBufferSize)
+		go s.handleMuxResponses(closeConn, conn, responses)
+		s.handleMuxRequests(closeConn, bufReader, responses)
+		return
+	}
+
+	responses := make(chan *types.Response, responseBufferSize) // A channel to buffer responses
+	// Pull responses from 'responses' and write them to conn.
+	go s.handleResponses(closeConn, conn, responses)
+	s.handleRequests(closeConn, bufReader, req, responses)
+}
+
+// Read requests from conn and deal with them, starting with the already read
+// request first.
+func (s *SocketServer) handleRequests(
+	closeConn chan error,
+	bufReader *bufio.Reader,
+	first *types.Request,
+	responses chan<- *types.Response,
+) {
 	defer func() {
 		// make sure to recover from any app-related panics to allow proper socket cleanup.
 		// In the case of a panic, we do not notify the client by passing an exception so
@@ -181,17 +215,18 @@ func (s *SocketServer) handleRequests(closeConn chan error, conn io.Reader, resp
 		}
 	}()
 
-	for {
-
-		req := &types.Request{}
-		err := types.ReadMessage(bufReader, req)
-		if err != nil {
-			if err == io.EOF {
-				closeConn <- err
-			} else {
-				closeConn <- fmt.Errorf("error reading message: %w", err)
+	for req := first; ; req = nil {
+		if req == nil {
+			req = &types.Request{}
+			err := types.ReadMessage(bufReader, req)
+			if err != nil {
+				if err == io.EOF {
+					closeConn <- err
+				} else {
+					closeConn <- fmt.Errorf("error reading message: %w", err)
+				}
+				return
 			}
-			return
 		}
 		s.appMtx.Lock()
 		resp, err := s.handleRequest(context.TODO(), req)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"io"
 	"math"
 
@@ -26,6 +27,43 @@ func ReadMessage(r io.Reader, msg proto.Message) error {
 	return err
 }
 
+// MuxPreamble is sent by a client as the first bytes of a connection to switch
+// the socket server to the multiplexed socket protocol. It is an empty
+// length-delimited message, which the regular socket protocol never sends.
+var MuxPreamble = []byte{0x00}
+
+// WriteMuxMessage writes a message of the multiplexed socket protocol: the
+// uvarint ID of the request followed by the varint length-delimited protobuf
+// message. Responses carry the ID of the request they answer.
+func WriteMuxMessage(id uint64, msg proto.Message, w io.Writer) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], id)
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	return WriteMessage(msg, w)
+}
+
+// ReadMuxMessage reads a message of the multiplexed socket protocol written by
+// WriteMuxMessage and returns its request ID.
+func ReadMuxMessage(r interface {
+	io.Reader
+	io.ByteReader
+}, msg proto.Message,
+) (uint64, error) {
+	id, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, err
+	}
+	if err := ReadMessage(r, msg); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
 //----------------------------------------
 
 func ToRequestEcho(message string) *Request {
@@ -155,6 +155,19 @@ func DeterministicExecTxResult(response *ExecTxResult) *ExecTxResult {
 	}
 }
 
+// DeterministicEvent strips the indexing hints off an Event, leaving only
+// the fields that can be committed to.
+func DeterministicEvent(event Event) *Event {
+	attrs := make([]EventAttribute, len(event.Attributes))
+	for i, attr := range event.Attributes {
+		attrs[i] = EventAttribute{Key: attr.Key, Value: attr.Value}
+	}
+	return &Event{
+		Type:       event.Type,
+		Attributes: attrs,
+	}
+}
+
 // MarshalTxResults encodes the TxResults as a list of byte
 // slices. It strips off the non-deterministic pieces of the TxResults
 // so that the resulting data can be used for hash comparisons and used
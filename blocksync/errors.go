@@ -14,6 +14,9 @@ var (
 	// ErrAlreadyEnabled is returned when block sync is already enabled
 	ErrAlreadyEnabled = errors.New("block sync is already enabled")
 
+	// ErrServeOnly is returned when enabling block sync on a serve-only reactor
+	ErrServeOnly = errors.New("block sync reactor only serves blocks")
+
 	// ErrPeerTimeout is returned when a peer does not send us anything
 	ErrPeerTimeout = errors.New("peer did not send us anything")
 )
@@ -64,6 +64,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: stdprometheus.ExponentialBucketsRange(0.1, 100, 8),
 		}, labels).With(labelsAndValues...),
+		CommitsVerifiedAhead: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commits_verified_ahead",
+			Help:      "CommitsVerifiedAhead blocks whose commit was verified ahead of execution",
+		}, labels).With(labelsAndValues...),
+		CommitsVerifiedInline: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commits_verified_inline",
+			Help:      "CommitsVerifiedInline blocks whose commit was verified right before execution",
+		}, labels).With(labelsAndValues...),
+		HedgedRequests: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "hedged_requests",
+			Help:      "HedgedRequests block requests sent to a second peer because the block was blocking the head",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -77,5 +95,8 @@ func NopMetrics() *Metrics {
 		AlreadyIncludedBlocks: discard.NewCounter(),
 		IngestedBlocks:        discard.NewCounter(),
 		IngestedBlockDuration: discard.NewHistogram(),
+		CommitsVerifiedAhead:  discard.NewCounter(),
+		CommitsVerifiedInline: discard.NewCounter(),
+		HedgedRequests:        discard.NewCounter(),
 	}
 }
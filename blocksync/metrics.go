@@ -1,8 +1,10 @@
 package blocksync
 
 import (
-	"github.com/cometbft/cometbft/types"
 	"github.com/go-kit/kit/metrics"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cometbft/cometbft/types"
 )
 
 const (
@@ -34,6 +36,20 @@ type Metrics struct {
 
 	// IngestedBlockDuration duration of ingesting a block
 	IngestedBlockDuration metrics.Histogram `metrics_buckettype:"exprange" metrics_bucketsizes:"0.1, 100, 8"`
+
+	// CommitsVerifiedAhead blocks whose commit was verified ahead of execution
+	CommitsVerifiedAhead metrics.Counter `metrics_name:"commits_verified_ahead"`
+
+	// CommitsVerifiedInline blocks whose commit was verified right before execution
+	CommitsVerifiedInline metrics.Counter `metrics_name:"commits_verified_inline"`
+
+	// HedgedRequests block requests sent to a second peer because the block was
+	// blocking the head
+	HedgedRequests metrics.Counter
+
+	// Peers are the metrics of the peers of the pool, see PeerMetrics. The
+	// peer metrics are not recorded if nil.
+	Peers *PeerMetrics
 }
 
 func (m *Metrics) recordBlockMetrics(block *types.Block) {
@@ -42,3 +58,79 @@ func (m *Metrics) recordBlockMetrics(block *types.Block) {
 	m.BlockSizeBytes.Set(float64(block.Size()))
 	m.LatestBlockHeight.Set(float64(block.Height))
 }
+
+// PeerMetrics contains the metrics of the peers of the block pool, labelled by
+// peer_id. Unlike Metrics, they're not generated by metricsgen, since the
+// go-kit gauges can't delete the series of the peers removed from the pool.
+type PeerMetrics struct {
+	labelsAndValues []string
+
+	// Measured rate of receiving blocks from a peer, in bytes/s.
+	recvRate *stdprometheus.GaugeVec
+	// Moving average of the latency of a peer's block requests, in seconds.
+	latency *stdprometheus.GaugeVec
+	// Number of block requests pending from a peer.
+	pendingRequests *stdprometheus.GaugeVec
+}
+
+// PrometheusPeerMetrics returns the PeerMetrics registered with the default
+// Prometheus registerer, with the same arguments as PrometheusMetrics.
+func PrometheusPeerMetrics(namespace string, labelsAndValues ...string) *PeerMetrics {
+	return newPeerMetrics(stdprometheus.DefaultRegisterer, namespace, labelsAndValues...)
+}
+
+func newPeerMetrics(reg stdprometheus.Registerer, namespace string, labelsAndValues ...string) *PeerMetrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	labels = append(labels, "peer_id")
+
+	gauge := func(name, help string) *stdprometheus.GaugeVec {
+		gv := stdprometheus.NewGaugeVec(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      name,
+			Help:      help,
+		}, labels)
+		reg.MustRegister(gv)
+		return gv
+	}
+
+	return &PeerMetrics{
+		labelsAndValues: labelsAndValues,
+		recvRate:        gauge("peer_recv_rate", "Measured rate of receiving blocks from a peer, in bytes/s."),
+		latency:         gauge("peer_latency", "Moving average of the latency of a peer's block requests, in seconds."),
+		pendingRequests: gauge("peer_pending_requests", "Number of block requests pending from a peer."),
+	}
+}
+
+func (m *PeerMetrics) labels(peerID string) stdprometheus.Labels {
+	labels := stdprometheus.Labels{"peer_id": peerID}
+	for i := 0; i+1 < len(m.labelsAndValues); i += 2 {
+		labels[m.labelsAndValues[i]] = m.labelsAndValues[i+1]
+	}
+	return labels
+}
+
+// record sets the metrics of the given peer.
+func (m *PeerMetrics) record(peerID string, recvRate, latency, pendingRequests float64) {
+	if m == nil {
+		return
+	}
+	labels := m.labels(peerID)
+	m.recvRate.With(labels).Set(recvRate)
+	m.latency.With(labels).Set(latency)
+	m.pendingRequests.With(labels).Set(pendingRequests)
+}
+
+// remove deletes the metrics of the given peer.
+func (m *PeerMetrics) remove(peerID string) {
+	if m == nil {
+		return
+	}
+	labels := m.labels(peerID)
+	m.recvRate.Delete(labels)
+	m.latency.Delete(labels)
+	m.pendingRequests.Delete(labels)
+}
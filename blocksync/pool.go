@@ -48,10 +48,13 @@ const (
 	// routine time to connect to peers.
 	peerConnWait = 3 * time.Second
 
-	// If we're within minBlocksForSingleRequest blocks of the pool's height, we
-	// send 2 parallel requests to 2 peers for the same block. If we're further
-	// away, we send a single request.
-	minBlocksForSingleRequest = 50
+	// initialBlockSizeEstimate is the block size assumed when scheduling
+	// requests before any block is received.
+	initialBlockSizeEstimate = 16 * 1024 // 16 KB
+
+	// statsSmoothing is the weight of a new sample in the exponential moving
+	// averages of block sizes and request latencies.
+	statsSmoothing = 0.2
 )
 
 var peerTimeout = 15 * time.Second // not const so we can override with tests
@@ -83,11 +86,15 @@ type BlockPool struct {
 	sortedPeers   []*bpPeer // sorted by curRate, highest first
 	maxPeerHeight int64     // the biggest reported height
 
+	avgBlockSize float64 // moving average of the received blocks' sizes
+
 	// atomic
 	numPending int32 // number of requests pending assignment or block response
 
 	requestsCh chan<- BlockRequest
 	errorsCh   chan<- peerError
+
+	metrics *Metrics
 }
 
 // BlockRequest stores a block request identified by the block Height and the PeerID
@@ -108,8 +115,12 @@ func NewBlockPool(start int64, requestsCh chan<- BlockRequest, errorsCh chan<- p
 		startHeight: start,
 		numPending:  0,
 
+		avgBlockSize: initialBlockSizeEstimate,
+
 		requestsCh: requestsCh,
 		errorsCh:   errorsCh,
+
+		metrics: NopMetrics(),
 	}
 	bp.BaseService = *service.NewBaseService(nil, "BlockPool", bp)
 	return bp
@@ -250,6 +261,28 @@ func (pool *BlockPool) PeekTwoBlocks() (first, second *types.Block, firstExtComm
 	return
 }
 
+// PeekBlocks returns up to n consecutive blocks starting at pool.height, along
+// with their extended commits. It stops at the first height whose block hasn't
+// been received yet.
+func (pool *BlockPool) PeekBlocks(n int) (blocks []*types.Block, extCommits []*types.ExtendedCommit) {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	for height := pool.height; height < pool.height+int64(n); height++ {
+		r := pool.requesters[height]
+		if r == nil {
+			break
+		}
+		block := r.getBlock()
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+		extCommits = append(extCommits, r.getExtendedCommit())
+	}
+	return blocks, extCommits
+}
+
 // PopRequest removes the requester at pool.height and increments pool.height.
 func (pool *BlockPool) PopRequest() {
 	pool.mtx.Lock()
@@ -266,10 +299,10 @@ func (pool *BlockPool) PopRequest() {
 	delete(pool.requesters, pool.height)
 	pool.height++
 
-	// Notify the next minBlocksForSingleRequest requesters about new height, so
-	// they can potentially request a block from the second peer.
-	for i := int64(0); i < minBlocksForSingleRequest && i < int64(len(pool.requesters)); i++ {
-		pool.requesters[pool.height+i].newHeight(pool.height)
+	// Notify the requester which is now blocking the head, so it can request
+	// the block from a second peer.
+	if r := pool.requesters[pool.height]; r != nil {
+		r.newHeight(pool.height)
 	}
 }
 
@@ -352,8 +385,11 @@ func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, extCommit *ty
 	}
 
 	atomic.AddInt32(&pool.numPending, -1)
+	pool.avgBlockSize += statsSmoothing * (float64(blockSize) - pool.avgBlockSize)
+
 	peer := pool.peers[peerID]
 	if peer != nil {
+		peer.observeBlock(block.Height)
 		peer.decrPending(blockSize)
 	}
 
@@ -439,6 +475,7 @@ func (pool *BlockPool) removePeer(peerID p2p.ID) {
 		if peer.timeout != nil {
 			peer.timeout.Stop()
 		}
+		peer.pool.metrics.Peers.remove(string(peerID))
 
 		delete(pool.peers, peerID)
 		for i, p := range pool.sortedPeers {
@@ -486,12 +523,19 @@ func (pool *BlockPool) banPeer(peerID p2p.ID) {
 	pool.bannedPeers[peerID] = cmttime.Now()
 }
 
-// Pick an available peer with the given height available.
+// Pick an available peer with the given height available, which is expected
+// to deliver the block the soonest given its measured receive rate, latency
+// and pending requests. Heights are thus assigned to peers proportionally to
+// their throughput.
 // If no peers are available, returns nil.
 func (pool *BlockPool) pickIncrAvailablePeer(height int64, excludePeerID p2p.ID) *bpPeer {
 	pool.mtx.Lock()
 	defer pool.mtx.Unlock()
 
+	var (
+		best      *bpPeer
+		bestDelay time.Duration
+	)
 	for _, peer := range pool.sortedPeers {
 		if peer.id == excludePeerID {
 			continue
@@ -506,11 +550,47 @@ func (pool *BlockPool) pickIncrAvailablePeer(height int64, excludePeerID p2p.ID)
 		if height < peer.base || height > peer.height {
 			continue
 		}
-		peer.incrPending()
-		return peer
+		// peers are sorted by rate, so the first one wins ties
+		if delay := peer.expectedDelay(pool.avgBlockSize); best == nil || delay < bestDelay {
+			best, bestDelay = peer, delay
+		}
 	}
 
-	return nil
+	if best != nil {
+		best.incrPending(height)
+	}
+
+	return best
+}
+
+// PeerStats holds the block sync statistics of a peer.
+type PeerStats struct {
+	Base            int64
+	Height          int64
+	PendingRequests int32
+	RecvRate        int64 // bytes/s
+	Latency         time.Duration
+	BlocksReceived  int64
+}
+
+// PeerStats returns the block sync statistics of the given peer.
+func (pool *BlockPool) PeerStats(peerID p2p.ID) (PeerStats, bool) {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	peer, ok := pool.peers[peerID]
+	if !ok {
+		return PeerStats{}, false
+	}
+
+	return PeerStats{
+		Base:            peer.base,
+		Height:          peer.height,
+		PendingRequests: peer.numPending,
+		RecvRate:        peer.curRate,
+		Latency:         peer.latency,
+		BlocksReceived:  peer.blocksReceived,
+	}, true
 }
 
 // Sort peers by curRate, highest first.
@@ -587,17 +667,23 @@ type bpPeer struct {
 
 	timeout *time.Timer
 
+	// request latency tracking
+	requestedAt    map[int64]time.Time
+	latency        time.Duration // moving average of the request latencies
+	blocksReceived int64
+
 	logger log.Logger
 }
 
 func newBPPeer(pool *BlockPool, peerID p2p.ID, base int64, height int64) *bpPeer {
 	peer := &bpPeer{
-		pool:       pool,
-		id:         peerID,
-		base:       base,
-		height:     height,
-		numPending: 0,
-		logger:     log.NewNopLogger(),
+		pool:        pool,
+		id:          peerID,
+		base:        base,
+		height:      height,
+		numPending:  0,
+		requestedAt: make(map[int64]time.Time),
+		logger:      log.NewNopLogger(),
 	}
 	return peer
 }
@@ -620,22 +706,71 @@ func (peer *bpPeer) resetTimeout() {
 	}
 }
 
-func (peer *bpPeer) incrPending() {
+func (peer *bpPeer) incrPending(height int64) {
 	if peer.numPending == 0 {
 		peer.resetMonitor()
 		peer.resetTimeout()
 	}
 	peer.numPending++
+	peer.requestedAt[height] = time.Now()
+	peer.recordMetrics()
 }
 
 func (peer *bpPeer) decrPending(recvSize int) {
 	peer.numPending--
 	if peer.numPending == 0 {
 		peer.timeout.Stop()
+		// the remaining requests were redone with other peers
+		clear(peer.requestedAt)
 	} else {
 		peer.recvMonitor.Update(recvSize)
+		peer.curRate = peer.recvMonitor.Status().CurRate
 		peer.resetTimeout()
 	}
+	peer.recordMetrics()
+}
+
+// observeBlock updates the peer's latency with the block at the given height
+// being received.
+func (peer *bpPeer) observeBlock(height int64) {
+	peer.blocksReceived++
+
+	requestedAt, ok := peer.requestedAt[height]
+	if !ok {
+		return
+	}
+	delete(peer.requestedAt, height)
+
+	sample := time.Since(requestedAt)
+	if peer.latency == 0 {
+		peer.latency = sample
+		return
+	}
+	peer.latency += time.Duration(statsSmoothing * float64(sample-peer.latency))
+}
+
+// expectedDelay estimates how long the peer would take to deliver a block of
+// the given size if it was requested now, given its latency, receive rate and
+// pending requests. The receive rate of a peer that hasn't sent any block yet
+// is assumed to be the one its monitor starts with.
+func (peer *bpPeer) expectedDelay(blockSize float64) time.Duration {
+	rate := float64(peer.curRate)
+	if rate == 0 {
+		rate = float64(minRecvRate) * math.E
+	}
+
+	transfer := float64(peer.numPending+1) * blockSize / rate
+
+	return peer.latency + time.Duration(transfer*float64(time.Second))
+}
+
+func (peer *bpPeer) recordMetrics() {
+	peer.pool.metrics.Peers.record(
+		string(peer.id),
+		float64(peer.curRate),
+		peer.latency.Seconds(),
+		float64(peer.numPending),
+	)
 }
 
 func (peer *bpPeer) onTimeout() {
@@ -651,12 +786,11 @@ func (peer *bpPeer) onTimeout() {
 
 // bpRequester requests a block from a peer.
 //
-// If the height is within minBlocksForSingleRequest blocks of the pool's
-// height, it will send an additional request to another peer. This is to avoid
-// a situation where blocksync is stuck because of a single slow peer. Note
-// that it's okay to send a single request when the requested height is far
-// from the pool's height. If the peer is slow, it will timeout and be replaced
-// with another peer.
+// If the height is the pool's height, i.e. the block is blocking the head, it
+// will send an additional (hedged) request to another peer. This is to avoid a
+// situation where blocksync is stuck because of a single slow peer. Note that
+// it's okay to send a single request for the other heights. If the peer is
+// slow, it will timeout and be replaced with another peer.
 type bpRequester struct {
 	service.BaseService
 
@@ -835,6 +969,7 @@ func (bpr *bpRequester) pickSecondPeerAndSendRequest() (picked bool) {
 		bpr.secondPeerID = secondPeer.id
 		bpr.mtx.Unlock()
 
+		bpr.pool.metrics.HedgedRequests.Add(1)
 		bpr.pool.sendRequest(bpr.height, secondPeer.id)
 		return true
 	}
@@ -859,8 +994,7 @@ OUTER_LOOP:
 	for {
 		bpr.pickPeerAndSendRequest()
 
-		poolHeight := bpr.pool.Height()
-		if bpr.height-poolHeight < minBlocksForSingleRequest {
+		if bpr.pool.Height() == bpr.height {
 			bpr.pickSecondPeerAndSendRequest()
 		}
 
@@ -897,7 +1031,7 @@ OUTER_LOOP:
 					continue OUTER_LOOP
 				}
 			case newHeight := <-bpr.newHeightCh:
-				if !gotBlock && bpr.height-newHeight < minBlocksForSingleRequest {
+				if !gotBlock && newHeight == bpr.height {
 					// The operation is a noop if the second peer is already set. The cost is checking a mutex.
 					//
 					// If the second peer was just set, reset the retryTimer to give the
@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -242,7 +244,9 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 	requestsCh := make(chan BlockRequest)
 	errorsCh := make(chan peerError)
 
+	reg := prometheus.NewRegistry()
 	pool := NewBlockPool(1, requestsCh, errorsCh)
+	pool.metrics.Peers = newPeerMetrics(reg, "cometbft", "chain_id", "test-chain")
 	pool.SetLogger(log.TestingLogger())
 	err := pool.Start()
 	require.NoError(t, err)
@@ -255,8 +259,12 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 	// add peers
 	for peerID, peer := range peers {
 		pool.SetPeerRange(peerID, peer.base, peer.height)
+		pool.mtx.Lock()
+		pool.peers[peerID].recordMetrics()
+		pool.mtx.Unlock()
 	}
 	assert.EqualValues(t, 10, pool.MaxPeerHeight())
+	assert.Equal(t, 10, testutil.CollectAndCount(reg, "cometbft_blocksync_peer_recv_rate"))
 
 	// remove not-existing peer
 	assert.NotPanics(t, func() { pool.RemovePeer(p2p.ID("Superman")) })
@@ -271,6 +279,60 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 	}
 
 	assert.EqualValues(t, 0, pool.MaxPeerHeight())
+
+	// the metrics of the removed peers are deleted
+	assert.Zero(t, testutil.CollectAndCount(reg))
+}
+
+func TestBlockPoolPicksPeersByThroughput(t *testing.T) {
+	pool := NewBlockPool(1, make(chan BlockRequest), make(chan peerError))
+	pool.SetLogger(log.TestingLogger())
+
+	pool.SetPeerRange("fast", 0, 100)
+	pool.SetPeerRange("slow", 0, 100)
+	t.Cleanup(func() {
+		pool.RemovePeer("fast")
+		pool.RemovePeer("slow")
+	})
+
+	pool.mtx.Lock()
+	pool.peers["fast"].curRate = 4 * minRecvRate
+	pool.peers["slow"].curRate = minRecvRate
+	pool.sortPeers()
+	pool.mtx.Unlock()
+
+	// heights are assigned proportionally to the peers' rates
+	picked := make(map[p2p.ID]int)
+	for height := int64(1); height <= 20; height++ {
+		peer := pool.pickIncrAvailablePeer(height, "")
+		require.NotNil(t, peer)
+		picked[peer.id]++
+	}
+	assert.Equal(t, 16, picked["fast"])
+	assert.Equal(t, 4, picked["slow"])
+
+	// a peer's latency delays its requests
+	pool.mtx.Lock()
+	pool.peers["fast"].latency = time.Minute
+	pool.mtx.Unlock()
+	peer := pool.pickIncrAvailablePeer(21, "")
+	require.NotNil(t, peer)
+	assert.EqualValues(t, "slow", peer.id)
+
+	// the excluded peer is never picked
+	peer = pool.pickIncrAvailablePeer(22, "slow")
+	require.NotNil(t, peer)
+	assert.EqualValues(t, "fast", peer.id)
+
+	stats, ok := pool.PeerStats("fast")
+	require.True(t, ok)
+	assert.EqualValues(t, 17, stats.PendingRequests)
+	assert.EqualValues(t, 4*minRecvRate, stats.RecvRate)
+	assert.Equal(t, time.Minute, stats.Latency)
+	assert.EqualValues(t, 100, stats.Height)
+
+	_, ok = pool.PeerStats("unknown")
+	assert.False(t, ok)
 }
 
 func TestBlockPoolMaliciousNode(t *testing.T) {
@@ -69,7 +69,12 @@ type Reactor struct {
 	// simultaneously (adaptive sync).
 	adaptiveSyncEnabled bool
 
+	// if set, the reactor only serves the blocks of the store to its peers,
+	// and never syncs, see NewServeOnlyReactor.
+	serveOnly bool
+
 	blockExec     *sm.BlockExecutor
+	stateStore    sm.Store
 	store         sm.BlockStore
 	pool          *BlockPool
 	localAddr     crypto.Address
@@ -84,9 +89,23 @@ type Reactor struct {
 	// interval for asking other peers their base (min) and height (max) blocks
 	intervalStatusUpdate time.Duration
 
+	// number of workers verifying commits ahead of execution, see verifier.go
+	verifyWorkers int
+	verifier      *commitVerifier
+
 	metrics *Metrics
 }
 
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
+// WithVerifyWorkers sets the number of workers verifying the commits of the
+// downloaded blocks ahead of their execution. 0 disables the verification
+// ahead of execution.
+func WithVerifyWorkers(n int) ReactorOption {
+	return func(r *Reactor) { r.verifyWorkers = n }
+}
+
 // NewReactorWithAddr returns new Reactor instance with local address
 func NewReactor(
 	enabled bool,
@@ -97,6 +116,7 @@ func NewReactor(
 	localAddr crypto.Address,
 	offlineStateSyncHeight int64,
 	metrics *Metrics,
+	options ...ReactorOption,
 ) *Reactor {
 	storeHeight := store.Height()
 	if storeHeight == 0 {
@@ -129,6 +149,7 @@ func NewReactor(
 		startHeight = state.InitialHeight
 	}
 	pool := NewBlockPool(startHeight, requestsCh, errorsCh)
+	pool.metrics = metrics
 
 	enabledFlag := &atomic.Bool{}
 	enabledFlag.Store(enabled)
@@ -141,6 +162,7 @@ func NewReactor(
 	r := &Reactor{
 		initialState:              state,
 		blockExec:                 blockExec,
+		stateStore:                blockExec.Store(),
 		store:                     store,
 		pool:                      pool,
 		enabled:                   enabledFlag,
@@ -155,6 +177,37 @@ func NewReactor(
 
 	r.BaseReactor = *p2p.NewBaseReactor("Blocksync", r)
 
+	for _, option := range options {
+		option(r)
+	}
+
+	return r
+}
+
+// NewServeOnlyReactor returns a Reactor that only serves the blocks of
+// blockStore to its peers, without ever syncing, e.g. to bootstrap other nodes
+// off the stores of a stopped node. Unlike NewReactor, it doesn't require the
+// stores to be consistent, as it never applies blocks.
+func NewServeOnlyReactor(stateStore sm.Store, blockStore sm.BlockStore, metrics *Metrics) *Reactor {
+	// the pool is never started, but tracks the peers' ranges for the stats
+	requestsCh := make(chan BlockRequest)
+	errorsCh := make(chan peerError)
+	pool := NewBlockPool(blockStore.Height()+1, requestsCh, errorsCh)
+	pool.metrics = metrics
+
+	r := &Reactor{
+		serveOnly:            true,
+		stateStore:           stateStore,
+		store:                blockStore,
+		pool:                 pool,
+		enabled:              &atomic.Bool{},
+		requestsCh:           requestsCh,
+		errorsCh:             errorsCh,
+		metrics:              metrics,
+		intervalStatusUpdate: defaultIntervalStatusUpdate,
+	}
+	r.BaseReactor = *p2p.NewBaseReactor("Blocksync", r)
+
 	return r
 }
 
@@ -164,6 +217,11 @@ func (r *Reactor) SetLogger(l log.Logger) {
 	r.pool.Logger = l
 }
 
+// PeerBlockSyncStats returns the block sync statistics of the given peer.
+func (r *Reactor) PeerBlockSyncStats(peerID p2p.ID) (PeerStats, bool) {
+	return r.pool.PeerStats(peerID)
+}
+
 // OnStart implements service.Service.
 func (r *Reactor) OnStart() error {
 	// noop
@@ -195,13 +253,22 @@ func (r *Reactor) runPool(stateSynced bool) error {
 		r.poolEventsRoutine(ticker)
 	})
 
+	var blockIngestor BlockIngestor
 	if r.adaptiveSyncEnabled {
-		// supply blocks to the consensus machine
-		blockIngestor, err := r.getBlockIngestor()
-		if err != nil {
+		var err error
+		if blockIngestor, err = r.getBlockIngestor(); err != nil {
 			return err
 		}
+	}
+
+	// verify commits ahead of execution
+	if r.verifyWorkers > 0 {
+		r.verifier = newCommitVerifier(r.initialState.ChainID, r.pool, r.verifyWorkers, r.adaptiveSyncEnabled, r.Logger)
+		run(func() { r.verifier.run(r.pool.Quit()) })
+	}
 
+	if r.adaptiveSyncEnabled {
+		// supply blocks to the consensus machine
 		run(func() {
 			r.blockIngestorRoutine(blockIngestor)
 		})
@@ -217,6 +284,9 @@ func (r *Reactor) runPool(stateSynced bool) error {
 
 // Enable is called by the state sync reactor when switching to block sync.
 func (r *Reactor) Enable(state sm.State) error {
+	if r.serveOnly {
+		return ErrServeOnly
+	}
 	if !r.enabled.CompareAndSwap(false, true) {
 		return ErrAlreadyEnabled
 	}
@@ -290,7 +360,7 @@ func (r *Reactor) respondToPeer(msg *bcproto.BlockRequest, src p2p.Peer) {
 		return
 	}
 
-	state, err := r.blockExec.Store().Load()
+	state, err := r.stateStore.Load()
 	if err != nil {
 		r.Logger.Error("Unable to load the state", "err", err)
 		return
@@ -358,6 +428,10 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 		// sends block response
 		r.respondToPeer(msg, e.Src)
 	case *bcproto.BlockResponse:
+		if r.serveOnly {
+			r.Logger.Debug("Ignoring block response in serve-only mode", "peer", e.Src, "height", msg.Block.Header.Height)
+			return
+		}
 		// adds block to the pool
 		go r.handlePeerResponse(msg, e.Src)
 	case *bcproto.StatusRequest:
@@ -417,6 +491,8 @@ func (r *Reactor) poolRoutine(stateSynced bool) {
 		lastRate     = 0.0
 	)
 
+	r.verifier.setValidators(state)
+
 FOR_LOOP:
 	for {
 		select {
@@ -540,14 +616,27 @@ FOR_LOOP:
 			// Try again quickly next loop.
 			didProcessCh <- struct{}{}
 
-			firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
-			if err != nil {
-				r.Logger.Error("Failed to make part set", "height", first.Height, "err", err.Error())
-				break FOR_LOOP
-			}
+			// The commit may have been verified ahead of execution, in which
+			// case its part set is already made as well.
+			verified := r.verifier.verified(first, second, extCommit)
 
-			firstPartSetHeader := firstParts.Header()
-			firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
+			var (
+				firstParts *types.PartSet
+				firstID    types.BlockID
+				err        error
+			)
+			if verified != nil {
+				firstParts, firstID = verified.parts, verified.blockID
+				r.metrics.CommitsVerifiedAhead.Add(1)
+			} else {
+				firstParts, err = first.MakePartSet(types.BlockPartSizeBytes)
+				if err != nil {
+					r.Logger.Error("Failed to make part set", "height", first.Height, "err", err.Error())
+					break FOR_LOOP
+				}
+				firstID = types.BlockID{Hash: first.Hash(), PartSetHeader: firstParts.Header()}
+				r.metrics.CommitsVerifiedInline.Add(1)
+			}
 
 			// vote extension validations
 			presentExtCommit := extCommit != nil
@@ -563,10 +652,12 @@ FOR_LOOP:
 			}
 
 			// Fully verify second.LastCommit to ensure all signatures are valid.
-			err = state.Validators.VerifyCommit(chainID, firstID, first.Height, second.LastCommit)
-			if err != nil {
-				r.handleValidationFailure(first, second, err)
-				continue FOR_LOOP
+			if verified == nil {
+				err = state.Validators.VerifyCommit(chainID, firstID, first.Height, second.LastCommit)
+				if err != nil {
+					r.handleValidationFailure(first, second, err)
+					continue FOR_LOOP
+				}
 			}
 
 			// Fully verify extended commit if present
@@ -580,13 +671,17 @@ FOR_LOOP:
 				// if vote extensions were required at this height, verify all
 				// signatures in the extended commit since it is persisted to
 				// the store.
-				if err = state.Validators.VerifyCommit(chainID, firstID, first.Height, extCommit.ToCommit()); err != nil {
-					r.handleValidationFailure(first, second, err)
-					continue FOR_LOOP
+				if verified == nil {
+					if err = state.Validators.VerifyCommit(chainID, firstID, first.Height, extCommit.ToCommit()); err != nil {
+						r.handleValidationFailure(first, second, err)
+						continue FOR_LOOP
+					}
 				}
 			}
 
-			// Validate the block before we persist it.
+			// Validate the block before we persist it. This also ensures that a
+			// commit verified ahead of execution was verified against
+			// state.Validators, as the block's ValidatorsHash must match it.
 			//
 			// For the first block synced, we must fully verify first.LastCommit
 			// since it was not verified as a prior second.LastCommit.
@@ -624,6 +719,7 @@ FOR_LOOP:
 				// TODO This is bad, are we zombie?
 				panic(fmt.Sprintf("Failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
 			}
+			r.verifier.setValidators(state)
 
 			r.metrics.recordBlockMetrics(first)
 			blocksSynced++
@@ -32,12 +32,16 @@ func (r *Reactor) getBlockIngestor() (BlockIngestor, error) {
 // ensures invariants, performs validation&verification using the light client and then passes it to BlockIngestor.
 // Influence on networking and block sharing: as consensus and blocksync reactors both point to the same BlockStore,
 // blocksync req/res always operate on the latest state --> no need to explicitly update blocksync's state.
+// As in poolRoutine, the commits may be verified ahead by the commit verifier, see verifier.go.
 func (r *Reactor) blockIngestorRoutine(blockIngestor BlockIngestor) {
 	r.Logger.Info("Starting blocksync block ingestor (adaptive sync)")
 
 	ticker := time.NewTicker(intervalAdaptiveSync)
 	defer ticker.Stop()
 
+	// the height of the state whose validators the commit verifier knows
+	verifierHeight := int64(-1)
+
 	for {
 		select {
 		case <-r.Quit():
@@ -61,8 +65,8 @@ func (r *Reactor) blockIngestorRoutine(blockIngestor BlockIngestor) {
 				))
 			}
 
-			// note this is a db call. consider caching in mem.
-			// right now it's the safest and the easiest way to fetch the latest state.
+			// the latest state is cached in memory by the state store, unless
+			// its caches are disabled.
 			state, err := r.blockExec.Store().Load()
 			if err != nil {
 				r.Logger.Error("Failed to load latest state. Halting blocksync", "err", err)
@@ -70,6 +74,10 @@ func (r *Reactor) blockIngestorRoutine(blockIngestor BlockIngestor) {
 			}
 
 			latestHeight := state.LastBlockHeight
+			if latestHeight != verifierHeight {
+				r.verifier.setValidators(state)
+				verifierHeight = latestHeight
+			}
 
 			// this means that CONSENSUS reactor has concurrently processed higher block(s).
 			// simply pop the current block and continue
@@ -100,11 +108,22 @@ func (r *Reactor) blockIngestorRoutine(blockIngestor BlockIngestor) {
 				return
 			}
 
-			blockParts, err := block.MakePartSet(types.BlockPartSizeBytes)
-			if err != nil {
-				// should not happen
-				r.Logger.Error("Failed to make part set. Halting blocksync", "height", block.Height, "err", err)
-				return
+			// The commit may have been verified ahead of execution, in which
+			// case its part set is already made as well.
+			verified := r.verifier.verified(block, nextBlock, extCommit)
+
+			var blockParts *types.PartSet
+			if verified != nil {
+				blockParts = verified.parts
+				r.metrics.CommitsVerifiedAhead.Add(1)
+			} else {
+				blockParts, err = block.MakePartSet(types.BlockPartSizeBytes)
+				if err != nil {
+					// should not happen
+					r.Logger.Error("Failed to make part set. Halting blocksync", "height", block.Height, "err", err)
+					return
+				}
+				r.metrics.CommitsVerifiedInline.Add(1)
 			}
 
 			// create ingest candidate block...
@@ -121,7 +140,11 @@ func (r *Reactor) blockIngestorRoutine(blockIngestor BlockIngestor) {
 				continue
 			}
 
-			// ... and verify it against the state
+			// ... and verify it against the state, which ensures that a commit
+			// verified ahead was verified against the state's validators
+			if verified != nil {
+				ic.SetCommitsVerified(verified.voteSet)
+			}
 			if err := ic.Verify(state); err != nil {
 				r.handleValidationFailure(block, nextBlock, fmt.Errorf("verify ingest candidate: %w", err))
 				continue
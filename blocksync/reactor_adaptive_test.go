@@ -136,6 +136,55 @@ func TestReactorAdaptive(t *testing.T) {
 		require.Equal(t, int64(4), follower.reactor.pool.Height())
 	})
 
+	t.Run("commitsVerifiedAhead", func(t *testing.T) {
+		// ARRANGE
+		ts := newAdaptiveSyncTestSuite(t, "blocksync_commits_verified_ahead")
+
+		var (
+			provider = newReactor(t, ts.logger, ts.genDoc, ts.privVals, 4, withDeterministicVoteTimes())
+			follower = newReactor(t, ts.logger, ts.genDoc, ts.privVals, 2, withDeterministicVoteTimes())
+		)
+		t.Cleanup(func() {
+			require.NoError(t, provider.app.Stop())
+			require.NoError(t, follower.app.Stop())
+		})
+
+		state, err := follower.reactor.blockExec.Store().Load()
+		require.NoError(t, err)
+
+		first := provider.reactor.store.LoadBlock(3)
+		second := provider.reactor.store.LoadBlock(4)
+		var extCommit *types.ExtendedCommit
+		if state.ConsensusParams.ABCI.VoteExtensionsEnabled(first.Height) {
+			extCommit = provider.reactor.store.LoadBlockExtendedCommit(first.Height)
+		}
+
+		verifier := newCommitVerifier(state.ChainID, follower.reactor.pool, 1, true, ts.logger)
+		verifier.setValidators(state)
+
+		// ACT
+		job, ok := verifier.nextJob(first, second, extCommit)
+		require.True(t, ok)
+		verifier.verify(job)
+		verified := verifier.verified(first, second, extCommit)
+
+		// ASSERT
+		require.NotNil(t, verified)
+		require.True(t, verified.voteSet.IsCommit())
+
+		// the consensus state accepts the commit verified ahead
+		ic, err := consensus.NewIngestCandidate(
+			first,
+			verified.parts,
+			second.LastCommit,
+			extCommit,
+			follower.reactor.blockExec.ValidateBlock,
+		)
+		require.NoError(t, err)
+		ic.SetCommitsVerified(verified.voteSet)
+		require.NoError(t, ic.Verify(state))
+	})
+
 	t.Run("peekTwoBlocksFailure", func(t *testing.T) {
 		// ARRANGE
 		ts := newAdaptiveSyncTestSuite(t, "blocksync_peek_two_blocks_failure")
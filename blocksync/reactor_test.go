@@ -10,6 +10,7 @@ import (
 
 	bcproto "github.com/cometbft/cometbft/proto/tendermint/blocksync"
 
+	"github.com/go-kit/kit/metrics/generic"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -69,6 +70,7 @@ type reactorOpts struct {
 	allAbsentExtCommitBlock int64
 	invalidExtCommitBlock   int64
 	deterministicVoteTimes  bool
+	verifyWorkers           int
 }
 
 type reactorOption func(*reactorOpts)
@@ -97,6 +99,12 @@ func withDeterministicVoteTimes() reactorOption {
 	}
 }
 
+func withVerifyWorkers(n int) reactorOption {
+	return func(o *reactorOpts) {
+		o.verifyWorkers = n
+	}
+}
+
 func newReactor(
 	t *testing.T,
 	logger log.Logger,
@@ -215,7 +223,8 @@ func newReactor(
 		}
 	}
 
-	r := NewReactor(blockSync, false, state.Copy(), blockExec, blockStore, nil, 0, NopMetrics())
+	r := NewReactor(blockSync, false, state.Copy(), blockExec, blockStore, nil, 0, NopMetrics(),
+		WithVerifyWorkers(options.verifyWorkers))
 	bcReactor := NewByzantineReactor(r)
 	bcReactor.corruptedBlock = options.corruptedBlock
 	bcReactor.absentExtCommitBlock = options.allAbsentExtCommitBlock
@@ -277,6 +286,93 @@ func TestNoBlockResponse(t *testing.T) {
 	}
 }
 
+func TestServeOnlyReactor(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := genesisDocWithValsPowers([]int64{30})
+
+	maxBlockHeight := int64(65)
+
+	// Given the stores of a stopped node, served by a serve-only reactor
+	source := newReactor(t, log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	defer func() { _ = source.app.Stop() }()
+	serveOnly := NewServeOnlyReactor(source.reactor.stateStore, source.reactor.store, NopMetrics())
+	serveOnly.SetLogger(log.TestingLogger().With("module", "blocksync"))
+
+	// Given a syncing node
+	syncing := newReactor(t, log.TestingLogger(), genDoc, privVals, 0)
+
+	reactors := []p2p.Reactor{serveOnly, syncing.reactor}
+	p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKSYNC", reactors[i])
+		return s
+	}, p2p.Connect2Switches)
+
+	defer func() {
+		_ = serveOnly.Stop()
+		_ = syncing.reactor.Stop()
+		_ = syncing.app.Stop()
+	}()
+
+	for !syncing.reactor.pool.IsCaughtUp() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the syncing node got the blocks, while the serve-only one didn't sync
+	assert.NotNil(t, syncing.reactor.store.LoadBlock(maxBlockHeight-2))
+	assert.Equal(t, maxBlockHeight, serveOnly.store.Height())
+	assert.ErrorIs(t, serveOnly.Enable(source.reactor.initialState), ErrServeOnly)
+}
+
+func TestVerifyCommitsAhead(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := genesisDocWithValsPowers([]int64{30, 10, 10})
+	genDoc.ConsensusParams.ABCI.VoteExtensionsEnableHeight = 40
+
+	maxBlockHeight := int64(80)
+
+	reactorPairs := make([]ReactorPair, 2)
+	reactorPairs[0] = newReactor(t, log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	reactorPairs[1] = newReactor(t, log.TestingLogger(), genDoc, privVals, 0, withVerifyWorkers(2))
+
+	metrics := NopMetrics()
+	metrics.CommitsVerifiedAhead = generic.NewCounter("commits_verified_ahead")
+	metrics.CommitsVerifiedInline = generic.NewCounter("commits_verified_inline")
+	reactorPairs[1].reactor.metrics = metrics
+
+	p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKSYNC", reactorPairs[i].reactor)
+		return s
+	}, p2p.Connect2Switches)
+
+	defer func() {
+		for _, r := range reactorPairs {
+			_ = r.reactor.Stop()
+			_ = r.app.Stop()
+		}
+	}()
+
+	// the pool is stopped when switching to consensus
+	require.Eventually(t, func() bool {
+		return reactorPairs[1].reactor.pool.IsCaughtUp() && !reactorPairs[1].reactor.pool.IsRunning()
+	}, 20*time.Second, 10*time.Millisecond)
+
+	const maxDiff = 3
+	height := reactorPairs[1].reactor.store.Height()
+	require.GreaterOrEqual(t, height, maxBlockHeight-maxDiff)
+
+	synced := metrics.CommitsVerifiedAhead.(*generic.Counter).Value() +
+		metrics.CommitsVerifiedInline.(*generic.Counter).Value()
+	assert.Equal(t, float64(height), synced)
+	assert.Positive(t, metrics.CommitsVerifiedAhead.(*generic.Counter).Value())
+
+	for h := int64(1); h <= height; h++ {
+		expected := reactorPairs[0].reactor.store.LoadBlockMeta(h).BlockID
+		require.Equal(t, expected, reactorPairs[1].reactor.store.LoadBlockMeta(h).BlockID, "height %d", h)
+	}
+}
+
 // NOTE: This is too hard to test without
 // an easy way to add test peer to switch
 // or without significant refactoring of the module.
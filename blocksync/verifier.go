@@ -0,0 +1,259 @@
+package blocksync
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// maxVerifyAhead is the number of heights past the pool's height whose commits
+// are verified ahead of execution.
+const maxVerifyAhead = 300
+
+// verifiedCommit is the result of verifying the commit of a block ahead of its
+// execution. The commit is second.LastCommit, along with the extended commit
+// of first if present.
+type verifiedCommit struct {
+	first     *types.Block
+	second    *types.Block
+	extCommit *types.ExtendedCommit
+
+	parts   *types.PartSet
+	blockID types.BlockID
+	voteSet *types.VoteSet // only with adaptive sync, see commitVerifier
+	err     error
+}
+
+// matches returns true if the result is for the given blocks.
+func (vc *verifiedCommit) matches(first, second *types.Block, extCommit *types.ExtendedCommit) bool {
+	return vc.first == first && vc.second == second && vc.extCommit == extCommit
+}
+
+// commitVerifier verifies the commits of the blocks downloaded by the pool on
+// worker goroutines, ahead of their execution by poolRoutine, or of their
+// ingestion by blockIngestorRoutine with adaptive sync, so that during catch-up
+// the execution is the only sequential step.
+//
+// The validator set of a height past the next one isn't known before the
+// previous blocks are executed. The verifier uses the latest known validator
+// sets (state.Validators and state.NextValidators), and only verifies a block's
+// commit if the block's ValidatorsHash matches one of them. Since poolRoutine
+// validates the ValidatorsHash against the state before applying the block, a
+// commit is never accepted based on a validator set other than the state's. The
+// same holds for IngestCandidate.Verify. The commits it can't verify are
+// verified inline.
+//
+// With adaptive sync, the verifier also builds the vote sets of the commits,
+// which are passed to the consensus state along with the blocks, see
+// consensus.IngestCandidate.SetCommitsVerified.
+type commitVerifier struct {
+	chainID  string
+	pool     *BlockPool
+	workers  int
+	voteSets bool
+	logger   log.Logger
+
+	mtx       sync.Mutex
+	valSets   []*types.ValidatorSet
+	valHashes [][]byte
+	results   map[int64]*verifiedCommit
+	inFlight  map[int64]struct{}
+}
+
+type verifyJob struct {
+	first     *types.Block
+	second    *types.Block
+	extCommit *types.ExtendedCommit
+	vals      *types.ValidatorSet
+}
+
+func newCommitVerifier(chainID string, pool *BlockPool, workers int, voteSets bool, logger log.Logger) *commitVerifier {
+	return &commitVerifier{
+		chainID:  chainID,
+		pool:     pool,
+		workers:  workers,
+		voteSets: voteSets,
+		logger:   logger,
+		results:  make(map[int64]*verifiedCommit),
+		inFlight: make(map[int64]struct{}),
+	}
+}
+
+// setValidators sets the validator sets known from the given state. It's
+// called whenever the state changes.
+func (v *commitVerifier) setValidators(state sm.State) {
+	if v == nil {
+		return
+	}
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	v.valSets = v.valSets[:0]
+	v.valHashes = v.valHashes[:0]
+	for _, vals := range []*types.ValidatorSet{state.Validators, state.NextValidators} {
+		if vals.IsNilOrEmpty() {
+			continue
+		}
+		v.valSets = append(v.valSets, vals.Copy())
+		v.valHashes = append(v.valHashes, vals.Hash())
+	}
+}
+
+// verified returns the result of verifying the commit of the given blocks
+// ahead of execution, or nil if it wasn't verified successfully.
+func (v *commitVerifier) verified(first, second *types.Block, extCommit *types.ExtendedCommit) *verifiedCommit {
+	if v == nil {
+		return nil
+	}
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	vc, ok := v.results[first.Height]
+	if !ok || !vc.matches(first, second, extCommit) || vc.err != nil {
+		return nil
+	}
+	delete(v.results, first.Height)
+
+	return vc
+}
+
+// run schedules the verification of the commits of the blocks downloaded by
+// the pool, lowest heights first, until quit is closed.
+func (v *commitVerifier) run(quit <-chan struct{}) {
+	jobs := make(chan verifyJob, v.workers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < v.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				v.verify(job)
+			}
+		}()
+	}
+	defer wg.Wait()
+	defer close(jobs)
+
+	ticker := time.NewTicker(intervalTrySync)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+		}
+
+		v.prune(v.pool.Height())
+
+		blocks, extCommits := v.pool.PeekBlocks(maxVerifyAhead)
+		for i := 0; i+1 < len(blocks); i++ {
+			job, ok := v.nextJob(blocks[i], blocks[i+1], extCommits[i])
+			if !ok {
+				continue
+			}
+			select {
+			case jobs <- job:
+			case <-quit:
+				return
+			}
+		}
+	}
+}
+
+// nextJob returns a job verifying the commit of the given blocks, unless it's
+// already verified or being verified, or the validator set of first isn't
+// known.
+func (v *commitVerifier) nextJob(first, second *types.Block, extCommit *types.ExtendedCommit) (verifyJob, bool) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if _, ok := v.inFlight[first.Height]; ok {
+		return verifyJob{}, false
+	}
+	if vc, ok := v.results[first.Height]; ok && vc.matches(first, second, extCommit) {
+		return verifyJob{}, false
+	}
+
+	for i, hash := range v.valHashes {
+		if bytes.Equal(hash, first.ValidatorsHash) {
+			v.inFlight[first.Height] = struct{}{}
+			job := verifyJob{
+				first:     first,
+				second:    second,
+				extCommit: extCommit,
+				vals:      v.valSets[i].Copy(),
+			}
+			return job, true
+		}
+	}
+
+	return verifyJob{}, false
+}
+
+func (v *commitVerifier) verify(job verifyJob) {
+	vc := &verifiedCommit{
+		first:     job.first,
+		second:    job.second,
+		extCommit: job.extCommit,
+	}
+
+	vc.parts, vc.err = job.first.MakePartSet(types.BlockPartSizeBytes)
+	if vc.err == nil {
+		vc.blockID = types.BlockID{Hash: job.first.Hash(), PartSetHeader: vc.parts.Header()}
+		vc.err = job.vals.VerifyCommit(v.chainID, vc.blockID, job.first.Height, job.second.LastCommit)
+	}
+	if vc.err == nil && job.extCommit != nil {
+		vc.err = job.vals.VerifyCommit(v.chainID, vc.blockID, job.first.Height, job.extCommit.ToCommit())
+	}
+	if vc.err == nil && v.voteSets {
+		vc.voteSet, vc.err = v.commitVoteSet(job)
+	}
+	if vc.err != nil {
+		v.logger.Debug("Failed to verify commit ahead of execution", "height", job.first.Height, "err", vc.err)
+	}
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	delete(v.inFlight, job.first.Height)
+	v.results[job.first.Height] = vc
+}
+
+// commitVoteSet builds the vote set of the extended commit of the job if
+// present, or of the commit, as the consensus state does when ingesting the
+// block.
+func (v *commitVerifier) commitVoteSet(job verifyJob) (voteSet *types.VoteSet, err error) {
+	// the vote set is built from the votes of the commit, panicking if one is
+	// invalid
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to build commit vote set: %v", r)
+		}
+	}()
+
+	if job.extCommit != nil {
+		return job.extCommit.ToExtendedVoteSet(v.chainID, job.vals), nil
+	}
+	return job.second.LastCommit.ToVoteSet(v.chainID, job.vals), nil
+}
+
+// prune removes the results below the given height.
+func (v *commitVerifier) prune(height int64) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	for h := range v.results {
+		if h < height {
+			delete(v.results, h)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package commands
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+var (
+	exportFromHeight    int64
+	exportToHeight      int64
+	exportOutput        string
+	importInput         string
+	importTrustedHeight int64
+	importTrustedHash   []byte
+)
+
+func init() {
+	ExportCmd.Flags().Int64Var(&exportFromHeight, "from", 0, "first height to export (default the blockstore base)")
+	ExportCmd.Flags().Int64Var(&exportToHeight, "to", 0, "last height to export (default the latest height)")
+	ExportCmd.Flags().StringVar(&exportOutput, "output", "", "file to write the archive to")
+	ImportCmd.Flags().StringVar(&importInput, "input", "", "file to read the archive from")
+	ImportCmd.Flags().Int64Var(&importTrustedHeight, "trusted-height", 0,
+		"height of the trusted block, the first one of the archive, when importing into empty stores")
+	ImportCmd.Flags().BytesHexVar(&importTrustedHash, "trusted-hash", []byte{},
+		"hash of the trusted block, the first one of the archive, when importing into empty stores")
+}
+
+// ExportCmd exports the chain data of a range of heights to an archive.
+var ExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export blocks, commits, validator sets, consensus params and results to an archive",
+	Long: `
+Export writes the chain data of the heights --from to --to to the archive file --output:
+for each height, its block, seen commit and extended commit, FinalizeBlock response, and
+the state after committing it, which holds the validator sets and consensus params. The
+archive is a versioned stream of length-prefixed protobuf messages. The node must be
+stopped. Note that the application state isn't exported.
+`,
+	Example: `cometbft export --from 1 --to 1000 --output chain.archive`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if exportOutput == "" {
+			return errors.New("--output is required")
+		}
+		from, to, err := ExportChain(config, exportFromHeight, exportToHeight, exportOutput)
+		if err != nil {
+			return fmt.Errorf("failed to export: %w", err)
+		}
+		fmt.Printf("Exported heights %d to %d to %s\n", from, to, exportOutput)
+		return nil
+	},
+}
+
+// ImportCmd imports the chain data of an archive written by ExportCmd.
+var ImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "import an archive written by export into the block and state stores",
+	Long: `
+Import loads the archive file --input, written by export, into the block and state stores
+of the configured db_backend, creating them if they don't exist. Each block is validated
+against the state of the previous height, and its commits are verified against its
+validator set. The stores must either hold the heights below the archive, or be empty.
+In the latter case, the first block of the archive must either be the block of
+--trusted-height and --trusted-hash, or be at the initial height of the genesis and
+agreed upon by the genesis validators; the state after it is then trusted. The next
+validators, consensus params and app hash of the state after the last height aren't
+checked by any block of the archive, and are trusted as-is. The node must be stopped. Note that the application state isn't imported: the application must be at
+the last imported height before the node is started.
+`,
+	Example: `cometbft import --input chain.archive
+cometbft import --input chain.archive --trusted-height 1000 --trusted-hash 6D8D4E...`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if importInput == "" {
+			return errors.New("--input is required")
+		}
+		st, err := ImportChain(config, importInput, importTrustedHeight, importTrustedHash)
+		if err != nil {
+			return fmt.Errorf("failed to import: %w", err)
+		}
+		fmt.Printf("Imported up to height %d and app hash %X\n", st.LastBlockHeight, st.AppHash)
+		return nil
+	},
+}
+
+// ExportChain writes the chain data of the heights from to to, inclusive, to
+// the given file. Zero heights stand for the blockstore base and the latest
+// height. Returns the exported heights.
+func ExportChain(config *cfg.Config, from, to int64, path string) (int64, int64, error) {
+	blockStore, stateStore, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	if from == 0 {
+		from = blockStore.Base()
+	}
+	if to == 0 {
+		latestState, err := stateStore.Load()
+		if err != nil {
+			return 0, 0, err
+		}
+		to = latestState.LastBlockHeight
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	if err := state.ExportArchive(w, blockStore, stateStore, from, to); err != nil {
+		return 0, 0, err
+	}
+	if err := w.Flush(); err != nil {
+		return 0, 0, err
+	}
+	return from, to, f.Sync()
+}
+
+// ImportChain loads the archive in the given file into the block and state
+// stores, creating them if they don't exist. When the stores are empty, the
+// first block of the archive is checked against the given trusted height and
+// hash, if any, or else against the genesis. Returns the state after the last
+// imported height.
+func ImportChain(config *cfg.Config, path string, trustedHeight int64, trustedHash []byte) (state.State, error) {
+	trust := state.ArchiveTrustOptions{Height: trustedHeight, Hash: trustedHash}
+	if len(trustedHash) == 0 {
+		genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+		if err != nil {
+			return state.State{}, err
+		}
+		trust.Genesis = genDoc
+	}
+
+	blockStore, err := store.LoadBlockStore(config, cfg.DefaultDBProvider, logger)
+	if err != nil {
+		return state.State{}, err
+	}
+	defer blockStore.Close()
+	stateDB, err := dbm.NewDB("state", dbm.BackendType(config.DBBackend), config.DBDir())
+	if err != nil {
+		return state.State{}, err
+	}
+	if _, err := state.MigrateDB(stateDB, logger); err != nil {
+		return state.State{}, err
+	}
+	stateStore := state.NewStore(stateDB, state.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+	})
+	defer stateStore.Close()
+
+	f, err := os.Open(path)
+	if err != nil {
+		return state.State{}, err
+	}
+	defer f.Close()
+	return state.ImportArchive(bufio.NewReader(f), blockStore, stateStore, trust)
+}
@@ -0,0 +1,411 @@
+package commands
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/evidence"
+	"github.com/cometbft/cometbft/internal/dbschema"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/store"
+)
+
+const (
+	// dbMigrateBatchSize is the number of keys copied per batch. The progress
+	// of a migration is saved after each batch.
+	dbMigrateBatchSize = 10000
+	// dbMigrateProgressFile is the file of the target directory the progress
+	// of a migration is saved to.
+	dbMigrateProgressFile = "migrate-progress.json"
+	// lightClientDB is the database of the light client, in its home directory.
+	lightClientDB = "light-client-db"
+)
+
+// dbMigrateDBs are the databases of the db directory copied by DBMigrateCmd.
+var dbMigrateDBs = []string{"blockstore", store.ColdDBName, "state", "tx_index", "evidence"}
+
+var (
+	dbMigrateFrom     string
+	dbMigrateTo       string
+	dbMigrateToDir    string
+	dbMigrateLightDir string
+	dbMigrateSamples  int
+)
+
+func init() {
+	DBMigrateCmd.Flags().StringVar(&dbMigrateFrom, "from", "",
+		"db backend to copy the databases from (default the configured db_backend)")
+	DBMigrateCmd.Flags().StringVar(&dbMigrateTo, "to", "", "db backend to copy the databases to")
+	DBMigrateCmd.Flags().StringVar(&dbMigrateToDir, "to-dir", "",
+		"directory to copy the databases to (default the db directory suffixed with the backend)")
+	DBMigrateCmd.Flags().StringVar(&dbMigrateLightDir, "light-home-dir", "",
+		"home directory of the light client, whose database is copied as well")
+	DBMigrateCmd.Flags().IntVar(&dbMigrateSamples, "samples", 1000,
+		"number of values of each database compared by the verify pass")
+	DBCmd.AddCommand(DBMigrateCmd, DBUpgradeCmd)
+}
+
+// DBCmd groups the commands managing the databases of the node.
+var DBCmd = &cobra.Command{
+	Use:   "db",
+	Short: "manage the databases of the node",
+}
+
+// DBMigrateCmd copies the databases of the node to another db backend.
+var DBMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "copy the databases of the node to another db backend",
+	Long: `
+Migrate copies the blockstore, blockstore-cold, state, tx_index and evidence databases of
+the db directory, and the database of the light client if --light-home-dir is set, from one
+db backend to another. The databases are copied to a new directory, and the original ones are left
+untouched. The node, or light client, must be stopped.
+
+The progress is saved in the new directory after each batch of keys, so that running the
+command again resumes an interrupted migration. Each copied database is then verified:
+the key counts of both databases and a checksum of the copied keys and values must
+match, along with a sample of the values.
+
+Once done, set db_backend and db_dir in the config to the new backend and directory. The
+light client uses the new ones with --db-backend and --home-dir. Note that backends
+other than goleveldb require the binary to be built with their build tag, e.g. pebbledb.
+`,
+	Example: `cometbft db migrate --to pebbledb`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if dbMigrateTo == "" {
+			return errors.New("--to is required")
+		}
+		from := dbm.BackendType(config.DBBackend)
+		if dbMigrateFrom != "" {
+			from = dbm.BackendType(dbMigrateFrom)
+		}
+		to := dbm.BackendType(dbMigrateTo)
+		toDir := dbMigrateToDir
+		if toDir == "" {
+			toDir = config.DBDir() + "-" + dbMigrateTo
+		}
+
+		if err := MigrateDBs(config, from, to, toDir, dbMigrateSamples); err != nil {
+			return fmt.Errorf("failed to migrate databases: %w", err)
+		}
+		fmt.Printf("Migrated the databases to %s, set db_backend = %q and db_dir = %q in the config\n",
+			toDir, to, toDir)
+		if dbMigrateLightDir != "" {
+			lightToDir := filepath.Clean(dbMigrateLightDir) + "-" + dbMigrateTo
+			if err := migrateDB(lightClientDB, from, dbMigrateLightDir, to, lightToDir, dbMigrateSamples); err != nil {
+				return fmt.Errorf("failed to migrate light client database: %w", err)
+			}
+			fmt.Printf("Migrated the light client database to %s, run the light client with --db-backend %s --home-dir %s\n",
+				lightToDir, to, lightToDir)
+		}
+		return nil
+	},
+}
+
+// DBUpgradeCmd upgrades the layouts of the databases of the node.
+var DBUpgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "upgrade the databases of the node to the current schema versions",
+	Long: `
+Upgrade runs the migrations of the layouts of the blockstore, blockstore-cold, state,
+tx_index and evidence databases written by previous versions, as the node does on startup. The keys of the
+blockstores are rewritten with the v2 layout if block_store_key_layout is set to v2. The
+node must be stopped. Databases written by a newer version of CometBFT are refused.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return UpgradeDBs(config)
+	},
+}
+
+// UpgradeDBs upgrades the databases of the node to their current schema
+// versions, and the block store to the configured key layout.
+func UpgradeDBs(config *cfg.Config) error {
+	schemas := []struct {
+		name    string
+		migrate func(dbm.DB, log.Logger) (uint64, error)
+	}{
+		{"blockstore", func(db dbm.DB, logger log.Logger) (uint64, error) {
+			return store.MigrateDB(db, config.Storage.BlockStoreKeyLayout, logger)
+		}},
+		{store.ColdDBName, func(db dbm.DB, logger log.Logger) (uint64, error) {
+			hotDB, err := dbm.NewDB("blockstore", dbm.BackendType(config.DBBackend), config.DBDir())
+			if err != nil {
+				return 0, err
+			}
+			defer hotDB.Close()
+			return store.MigrateColdDB(db, hotDB, logger)
+		}},
+		{"state", state.MigrateDB},
+		{"tx_index", kv.MigrateDB},
+		{"evidence", evidence.MigrateDB},
+	}
+	for _, schema := range schemas {
+		if !cmtos.FileExists(filepath.Join(config.DBDir(), schema.name+".db")) {
+			continue
+		}
+		db, err := dbm.NewDB(schema.name, dbm.BackendType(config.DBBackend), config.DBDir())
+		if err != nil {
+			return err
+		}
+		from, err := schema.migrate(db, logger)
+		if err != nil {
+			db.Close()
+			return err
+		}
+		version, err := dbschema.LoadVersion(db)
+		db.Close()
+		if err != nil {
+			return err
+		}
+		if from == version {
+			fmt.Printf("%s database is at schema version %d\n", schema.name, version)
+		} else {
+			fmt.Printf("Upgraded %s database from schema version %d to %d\n", schema.name, from, version)
+		}
+	}
+	return nil
+}
+
+// MigrateDBs copies the databases of the db directory from the backend from to
+// the backend to, in the directory toDir, and verifies them by comparing the
+// given number of sampled values. An interrupted migration is resumed. The
+// databases which don't exist are skipped.
+func MigrateDBs(config *cfg.Config, from, to dbm.BackendType, toDir string, samples int) error {
+	if from == to && filepath.Clean(toDir) == filepath.Clean(config.DBDir()) {
+		return errors.New("cannot migrate the databases onto themselves")
+	}
+	for _, name := range dbMigrateDBs {
+		if !cmtos.FileExists(filepath.Join(config.DBDir(), name+".db")) {
+			continue
+		}
+		if err := migrateDB(name, from, config.DBDir(), to, toDir, samples); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// dbMigration is the progress of the migration of a database.
+type dbMigration struct {
+	// Number of keys copied, and the last of them in order.
+	Keys    int64  `json:"keys"`
+	LastKey []byte `json:"last_key"`
+	// Chained hash of the keys and values copied.
+	Checksum []byte `json:"checksum"`
+	Copied   bool   `json:"copied"`
+	Verified bool   `json:"verified"`
+}
+
+// dbMigrationProgress is the progress of the migrations to a directory.
+type dbMigrationProgress struct {
+	From dbm.BackendType         `json:"from"`
+	To   dbm.BackendType         `json:"to"`
+	DBs  map[string]*dbMigration `json:"dbs"`
+}
+
+func loadDBMigrationProgress(dir string, from, to dbm.BackendType) (*dbMigrationProgress, error) {
+	progress := &dbMigrationProgress{From: from, To: to, DBs: make(map[string]*dbMigration)}
+	bz, err := os.ReadFile(filepath.Join(dir, dbMigrateProgressFile))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return progress, nil
+	case err != nil:
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, progress); err != nil {
+		return nil, fmt.Errorf("invalid migration progress: %w", err)
+	}
+	if progress.From != from || progress.To != to {
+		return nil, fmt.Errorf("%s holds a migration from %s to %s", dir, progress.From, progress.To)
+	}
+	return progress, nil
+}
+
+func (p *dbMigrationProgress) save(dir string) error {
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(filepath.Join(dir, dbMigrateProgressFile), bz, 0o600)
+}
+
+// migrateDB copies the database name from fromDir to toDir, resuming from the
+// saved progress, and verifies it.
+func migrateDB(name string, from dbm.BackendType, fromDir string, to dbm.BackendType, toDir string, samples int) error {
+	if err := cmtos.EnsureDir(toDir, 0o700); err != nil {
+		return err
+	}
+	progress, err := loadDBMigrationProgress(toDir, from, to)
+	if err != nil {
+		return err
+	}
+	m, ok := progress.DBs[name]
+	if !ok {
+		m = &dbMigration{}
+		progress.DBs[name] = m
+	}
+	if m.Verified {
+		return nil
+	}
+
+	src, err := dbm.NewDB(name, from, fromDir)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := dbm.NewDB(name, to, toDir)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	if !m.Copied {
+		if err := copyDB(src, dst, m, func() error { return progress.save(toDir) }); err != nil {
+			return err
+		}
+	}
+	if err := verifyDB(src, dst, m, samples); err != nil {
+		return err
+	}
+	m.Verified = true
+	return progress.save(toDir)
+}
+
+// copyDB copies the keys of src above the last copied one to dst, in batches,
+// calling saveProgress after each of them.
+func copyDB(src, dst dbm.DB, m *dbMigration, saveProgress func() error) error {
+	var start []byte
+	if m.LastKey != nil {
+		// the smallest key above the last copied one
+		start = append(slices.Clone(m.LastKey), 0)
+	}
+	it, err := src.Iterator(start, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := dst.NewBatch()
+	defer func() { _ = batch.Close() }()
+	n := 0
+	for ; it.Valid(); it.Next() {
+		key, value := it.Key(), it.Value()
+		if err := batch.Set(key, value); err != nil {
+			return err
+		}
+		m.Keys++
+		m.LastKey = slices.Clone(key)
+		m.Checksum = chainChecksum(m.Checksum, key, value)
+
+		if n++; n == dbMigrateBatchSize {
+			if err := batch.WriteSync(); err != nil {
+				return err
+			}
+			if err := saveProgress(); err != nil {
+				return err
+			}
+			_ = batch.Close()
+			batch, n = dst.NewBatch(), 0
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	m.Copied = true
+	return saveProgress()
+}
+
+// verifyDB checks that src and dst hold as many keys as were copied, that the
+// checksum of dst is the one of the copied keys and values, and that the given
+// number of values, sampled evenly, are the same in both.
+func verifyDB(src, dst dbm.DB, m *dbMigration, samples int) error {
+	srcKeys, _, err := countDB(src, nil)
+	if err != nil {
+		return err
+	}
+
+	stride := int64(1)
+	if samples > 0 && m.Keys > int64(samples) {
+		stride = m.Keys / int64(samples)
+	}
+	var sampleErr error
+	dstKeys, checksum, err := countDB(dst, func(i int64, key, value []byte) {
+		if samples <= 0 || i%stride != 0 || sampleErr != nil {
+			return
+		}
+		srcValue, err := src.Get(key)
+		switch {
+		case err != nil:
+			sampleErr = err
+		case !bytes.Equal(srcValue, value):
+			sampleErr = fmt.Errorf("value of key %X differs", key)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case srcKeys != m.Keys || dstKeys != m.Keys:
+		return fmt.Errorf("key counts differ: %d in the source, %d copied, %d in the target", srcKeys, m.Keys, dstKeys)
+	case !bytes.Equal(checksum, m.Checksum):
+		return errors.New("checksum of the target differs from the one of the copied keys")
+	case sampleErr != nil:
+		return sampleErr
+	}
+	return nil
+}
+
+// countDB iterates over db, calling visit for each key if not nil, and returns
+// the number of keys and their checksum.
+func countDB(db dbm.DB, visit func(i int64, key, value []byte)) (int64, []byte, error) {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer it.Close()
+
+	var (
+		n        int64
+		checksum []byte
+	)
+	for ; it.Valid(); it.Next() {
+		if visit != nil {
+			visit(n, it.Key(), it.Value())
+			checksum = chainChecksum(checksum, it.Key(), it.Value())
+		}
+		n++
+	}
+	return n, checksum, it.Error()
+}
+
+// chainChecksum returns the checksum of the keys and values hashed into
+// checksum, followed by the given key and value.
+func chainChecksum(checksum, key, value []byte) []byte {
+	h := sha256.New()
+	h.Write(checksum)
+	h.Write(binary.AppendUvarint(nil, uint64(len(key))))
+	h.Write(key)
+	h.Write(binary.AppendUvarint(nil, uint64(len(value))))
+	h.Write(value)
+	return h.Sum(nil)
+}
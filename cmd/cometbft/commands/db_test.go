@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+)
+
+func TestMigrateDBs(t *testing.T) {
+	config := cmtcfg.DefaultConfig()
+	config.SetRoot(t.TempDir())
+	src, err := dbm.NewDB("state", dbm.GoLevelDBBackend, config.DBDir())
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, src.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.NoError(t, src.Close())
+
+	toDir := t.TempDir()
+	require.NoError(t, MigrateDBs(config, dbm.GoLevelDBBackend, dbm.GoLevelDBBackend, toDir, 10))
+	// a verified migration is skipped
+	require.NoError(t, MigrateDBs(config, dbm.GoLevelDBBackend, dbm.GoLevelDBBackend, toDir, 10))
+
+	dst, err := dbm.NewDB("state", dbm.GoLevelDBBackend, toDir)
+	require.NoError(t, err)
+	defer dst.Close()
+	value, err := dst.Get([]byte("key042"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value42"), value)
+	has, err := dst.Has([]byte("key099"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	progress, err := loadDBMigrationProgress(toDir, dbm.GoLevelDBBackend, dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	require.Len(t, progress.DBs, 1)
+	require.True(t, progress.DBs["state"].Verified)
+	require.EqualValues(t, 100, progress.DBs["state"].Keys)
+
+	_, err = loadDBMigrationProgress(toDir, dbm.GoLevelDBBackend, dbm.PebbleDBBackend)
+	require.Error(t, err)
+	require.Error(t, MigrateDBs(config, dbm.GoLevelDBBackend, dbm.GoLevelDBBackend, config.DBDir(), 10))
+}
+
+func TestCopyDBResume(t *testing.T) {
+	src, dst := dbm.NewMemDB(), dbm.NewMemDB()
+	const keys = 2*dbMigrateBatchSize + 100
+	for i := 0; i < keys; i++ {
+		require.NoError(t, src.Set([]byte(fmt.Sprintf("key%06d", i)), []byte{byte(i)}))
+	}
+
+	// interrupted after the second batch
+	m := &dbMigration{}
+	saves := 0
+	err := copyDB(src, dst, m, func() error {
+		if saves++; saves == 2 {
+			return errors.New("interrupted")
+		}
+		return nil
+	})
+	require.Error(t, err)
+	require.False(t, m.Copied)
+	require.EqualValues(t, 2*dbMigrateBatchSize, m.Keys)
+
+	require.NoError(t, copyDB(src, dst, m, func() error { return nil }))
+	require.True(t, m.Copied)
+	require.EqualValues(t, keys, m.Keys)
+	require.NoError(t, verifyDB(src, dst, m, 100))
+
+	require.NoError(t, dst.Set([]byte("key000042"), []byte{0}))
+	require.ErrorContains(t, verifyDB(src, dst, m, 100), "checksum")
+	require.NoError(t, dst.Delete([]byte("key000042")))
+	require.NoError(t, src.Delete([]byte("key000042")))
+	require.ErrorContains(t, verifyDB(src, dst, m, 100), "key counts differ")
+}
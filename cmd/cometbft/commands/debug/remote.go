@@ -0,0 +1,117 @@
+package debug
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	rpccore "github.com/cometbft/cometbft/rpc/core"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+)
+
+var (
+	walEntries uint
+
+	flagWALEntries = "wal-entries"
+)
+
+var remoteCmd = &cobra.Command{
+	Use:   "remote [compressed-output-file]",
+	Short: "Fetch the debugging data of a CometBFT process over its unsafe RPC",
+	Long: `Fetch the debugging data of a CometBFT process over its RPC, without access to
+the node's host, e.g. in containerised deployments. The node must run with the unsafe
+RPC routes enabled (rpc.unsafe = true).
+
+The output file is a gzipped tarball with the goroutine and heap profiles, the
+recent logs (see rpc.debug_log_buffer_size), the config file, the node status,
+network info and consensus state, and the last entries of the consensus WAL.`,
+	Example: `$ cometbft debug remote debug.tar.gz --rpc-laddr=tcp://node:26657 --wal-entries=100`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    remoteCmdHandler,
+}
+
+func init() {
+	remoteCmd.Flags().UintVar(
+		&walEntries,
+		flagWALEntries,
+		rpccore.DefaultDebugBundleWALEntries,
+		"the number of the last consensus WAL entries to fetch",
+	)
+}
+
+func remoteCmdHandler(_ *cobra.Command, args []string) error {
+	outFile := args[0]
+	if outFile == "" {
+		return errors.New("invalid output file")
+	}
+
+	endpoint, err := debugBundleURL(nodeRPCAddr, walEntries)
+	if err != nil {
+		return err
+	}
+	client, err := rpcclient.DefaultHTTPClient(nodeRPCAddr)
+	if err != nil {
+		return fmt.Errorf("failed to create new http client: %w", err)
+	}
+
+	logger.Info("fetching node debugging data...", "rpc", nodeRPCAddr)
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to fetch debugging data: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		if resp.StatusCode == http.StatusNotFound {
+			return errors.New("failed to fetch debugging data: the node's unsafe RPC routes are disabled")
+		}
+		return fmt.Errorf("failed to fetch debugging data: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	f, err := os.Create(outFile)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write debugging data to %s: %w", outFile, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write debugging data to %s: %w", outFile, err)
+	}
+
+	logger.Info("saved debugging data", "file", outFile)
+	return nil
+}
+
+// debugBundleURL returns the URL of the debug bundle of the node with the RPC
+// address rpcAddr. The client of rpcclient.DefaultHTTPClient dials rpcAddr
+// itself, so the host of a unix socket is a placeholder.
+func debugBundleURL(rpcAddr string, walEntries uint) (string, error) {
+	if !strings.Contains(rpcAddr, "://") {
+		rpcAddr = "tcp://" + rpcAddr
+	}
+	u, err := url.Parse(rpcAddr)
+	if err != nil {
+		return "", fmt.Errorf("invalid RPC address %s: %w", rpcAddr, err)
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "unix":
+		u.Scheme, u.Host = "http", "localhost"
+	default:
+		u.Scheme = "http"
+	}
+	u.Path = "/unsafe_debug_bundle"
+	u.RawQuery = url.Values{"wal_entries": {strconv.FormatUint(uint64(walEntries), 10)}}.Encode()
+	return u.String(), nil
+}
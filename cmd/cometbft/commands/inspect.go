@@ -10,6 +10,7 @@ import (
 
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/inspect"
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer/block"
 	"github.com/cometbft/cometbft/store"
@@ -28,15 +29,28 @@ var InspectCmd = &cobra.Command{
 	CometBFT process. CometBFT will not start up while in this inconsistent state.
 	The inspect command can be used to query the block and state store using CometBFT
 	RPC calls to debug issues of inconsistent state.
+
+	With --serve-blocks, inspect also serves the blocks of the block store to
+	syncing peers, over the p2p transport of the config (CometBFT's p2p or
+	go-libp2p), with only the blocksync reactor. The node neither syncs nor runs
+	consensus, but its data can still be used to bootstrap other nodes.
 	`,
 
 	RunE: runInspect,
 }
 
+var inspectServeBlocks bool
+
 func init() {
 	InspectCmd.Flags().
 		String("rpc.laddr",
 			config.RPC.ListenAddress, "RPC listener address. Port required")
+	InspectCmd.Flags().
+		String("p2p.laddr",
+			config.P2P.ListenAddress, "node listen address, used with --serve-blocks")
+	InspectCmd.Flags().
+		BoolVar(&inspectServeBlocks, "serve-blocks", false,
+			"serve the blocks to syncing peers, with only the blocksync reactor")
 	InspectCmd.Flags().
 		String("db-backend",
 			config.DBBackend, "database backend: goleveldb | cleveldb | rocksdb | badgerdb")
@@ -55,17 +69,19 @@ func runInspect(cmd *cobra.Command, _ []string) error {
 		cancel()
 	}()
 
-	blockStoreDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "blockstore", Config: config})
+	blockStore, err := store.LoadBlockStore(config, cfg.DefaultDBProvider, logger)
 	if err != nil {
 		return err
 	}
-	blockStore := store.NewBlockStore(blockStoreDB)
 	defer blockStore.Close()
 
 	stateDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "state", Config: config})
 	if err != nil {
 		return err
 	}
+	if _, err := state.MigrateDB(stateDB, logger); err != nil {
+		return err
+	}
 	stateStore := state.NewStore(stateDB, state.StoreOptions{DiscardABCIResponses: false})
 	defer stateStore.Close()
 
@@ -77,7 +93,15 @@ func runInspect(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
-	ins := inspect.New(config.RPC, blockStore, stateStore, txIndexer, blockIndexer)
+	var options []inspect.Option
+	if inspectServeBlocks {
+		nodeKey, err := p2p.LoadNodeKey(config.NodeKeyFile())
+		if err != nil {
+			return err
+		}
+		options = append(options, inspect.WithBlocksync(config, nodeKey, genDoc))
+	}
+	ins := inspect.New(config.RPC, blockStore, stateStore, txIndexer, blockIndexer, options...)
 
 	logger.Info("starting inspect server")
 	return ins.Run(ctx)
@@ -59,6 +59,7 @@ var (
 	witnessAddrsJoined string
 	chainID            string
 	home               string
+	dbBackend          string
 	maxOpenConnections int
 
 	sequential     bool
@@ -82,6 +83,8 @@ func init() {
 		"CometBFT nodes to cross-check the primary node, comma-separated")
 	LightCmd.Flags().StringVar(&home, "home-dir", os.ExpandEnv(filepath.Join("$HOME", ".cometbft-light")),
 		"specify the home directory")
+	LightCmd.Flags().StringVar(&dbBackend, "db-backend", string(dbm.GoLevelDBBackend),
+		"db backend of the light client database")
 	LightCmd.Flags().IntVar(
 		&maxOpenConnections,
 		"max-open-connections",
@@ -119,10 +122,13 @@ func runProxy(_ *cobra.Command, args []string) error {
 		witnessesAddrs = strings.Split(witnessAddrsJoined, ",")
 	}
 
-	db, err := dbm.NewGoLevelDB("light-client-db", home)
+	db, err := dbm.NewDB(lightClientDB, dbm.BackendType(dbBackend), home)
 	if err != nil {
 		return fmt.Errorf("can't create a db: %w", err)
 	}
+	if _, err := dbs.MigrateDB(db, logger); err != nil {
+		return err
+	}
 
 	if primaryAddr == "" { // check to see if we can start from an existing state
 		var err error
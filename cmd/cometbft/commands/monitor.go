@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/monitor"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+)
+
+// MonitorCmd is the command for watching nodes for misbehaviour.
+var MonitorCmd = &cobra.Command{
+	Use:   "monitor",
+	Short: "Watch several nodes for misbehaviour of the validators and submit evidence of it",
+	Long: `
+	monitor subscribes to the votes and the new block headers of several nodes over
+	RPC, and cross-checks them to detect equivocation of the validators:
+
+	* votes signed by the same validator for different blocks at the same height,
+	  round and step are submitted to all nodes as duplicate vote evidence.
+	* different headers committed at the same height are submitted as light client
+	  attack evidence to the nodes which committed the other header.
+
+	It doesn't require running a node, nor being a validator.
+	`,
+	Example: `monitor --nodes tcp://node0:26657,tcp://node1:26657,tcp://node2:26657`,
+	RunE:    runMonitor,
+}
+
+var (
+	monitorNodes   string
+	monitorChainID string
+)
+
+func init() {
+	MonitorCmd.Flags().StringVar(&monitorNodes, "nodes", "",
+		"RPC addresses of the nodes to watch, comma-separated")
+	MonitorCmd.Flags().StringVar(&monitorChainID, "chain-id", "",
+		"chain ID of the nodes. If empty, it's retrieved from the first node")
+}
+
+func runMonitor(cmd *cobra.Command, _ []string) error {
+	if monitorNodes == "" {
+		return errors.New("no nodes to watch. Please provide their RPC addresses (using --nodes)")
+	}
+
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		<-c
+		cancel()
+	}()
+
+	var clients []rpcclient.RemoteClient
+	for _, addr := range strings.Split(monitorNodes, ",") {
+		client, err := rpchttp.New(strings.TrimSpace(addr), "/websocket")
+		if err != nil {
+			return err
+		}
+		client.SetLogger(logger.With("module", "rpc", "node", addr))
+		if err := client.Start(); err != nil {
+			return err
+		}
+		defer client.Stop() //nolint:errcheck
+		clients = append(clients, client)
+	}
+
+	chainID := monitorChainID
+	if chainID == "" {
+		status, err := clients[0].Status(ctx)
+		if err != nil {
+			return err
+		}
+		chainID = status.NodeInfo.Network
+	}
+
+	m := monitor.New(chainID, clients, logger.With("module", "monitor"))
+	return m.Run(ctx)
+}
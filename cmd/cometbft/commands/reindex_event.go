@@ -121,6 +121,9 @@ func loadEventSinks(cfg *cmtcfg.Config, chainID string) (indexer.BlockIndexer, t
 		if err != nil {
 			return nil, nil, err
 		}
+		if _, err := kv.MigrateDB(store, logger); err != nil {
+			return nil, nil, err
+		}
 
 		txIndexer := kv.NewTxIndex(store)
 		blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
@@ -1,23 +1,36 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	dbm "github.com/cometbft/cometbft-db"
 
 	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/evidence"
 	"github.com/cometbft/cometbft/libs/os"
 	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/store"
 )
 
-var removeBlock = false
+var (
+	removeBlock      = false
+	rollbackToHeight int64
+	rollbackDryRun   bool
+)
 
 func init() {
 	RollbackStateCmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	RollbackStateCmd.Flags().Int64Var(&rollbackToHeight, "to-height", 0,
+		"roll back to the given height, along with the indexers and the evidence pool")
+	RollbackStateCmd.Flags().BoolVar(&rollbackDryRun, "dry-run", false,
+		"report what rolling back to --to-height removes, without modifying anything")
 }
 
 var RollbackStateCmd = &cobra.Command{
@@ -31,8 +44,27 @@ The application should also roll back to height n - 1. If the --hard flag is not
 no blocks will be removed so upon restarting CometBFT the transactions in block n will be 
 re-executed against the application. Using --hard will also remove block n. This can
 be done multiple times.
+
+Using --to-height h rolls back to height h at once: the state is overwritten with the
+state at height h, and the blocks above h + 1 (or above h, with --hard) are removed,
+along with the transactions and blocks indexed above h. The evidence committed above h
+is pending again. The application should also roll back to height h. Using --dry-run
+only reports what would be removed. Note that the last signed height of the private
+validator isn't rolled back, to prevent double signing.
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if rollbackToHeight > 0 {
+			plan, err := RollbackStateTo(config, rollbackToHeight, removeBlock, rollbackDryRun)
+			if err != nil {
+				return fmt.Errorf("failed to rollback state: %w", err)
+			}
+			printRollbackPlan(plan, rollbackDryRun)
+			return nil
+		}
+		if rollbackDryRun {
+			return errors.New("--dry-run requires --to-height")
+		}
+
 		height, hash, err := RollbackState(config, removeBlock)
 		if err != nil {
 			return fmt.Errorf("failed to rollback state: %w", err)
@@ -66,6 +98,85 @@ func RollbackState(config *cfg.Config, removeBlock bool) (int64, []byte, error)
 	return state.Rollback(blockStore, stateStore, removeBlock)
 }
 
+// RollbackStateTo overwrites the state with the state at the given height, and
+// removes what was stored above it: blocks, indexed transactions and blocks,
+// and committed evidence, which is pending again. Nothing is modified if
+// dryRun is true. Returns what is, or would be, removed.
+func RollbackStateTo(config *cfg.Config, height int64, removeBlock, dryRun bool) (state.RollbackPlan, error) {
+	blockStore, stateStore, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return state.RollbackPlan{}, err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	plan, err := state.PlanRollback(blockStore, stateStore, height, removeBlock)
+	if err != nil || dryRun {
+		return plan, err
+	}
+
+	// Open the stores derived from the state before modifying any of them.
+	var blockIndexer indexer.BlockIndexer
+	var txIndexer txindex.TxIndexer
+	if !strings.EqualFold(config.TxIndex.Indexer, "null") {
+		latestState, err := stateStore.Load()
+		if err != nil {
+			return plan, err
+		}
+		blockIndexer, txIndexer, err = loadEventSinks(config, latestState.ChainID)
+		if err != nil {
+			return plan, err
+		}
+	}
+	evidenceDB, err := dbm.NewDB("evidence", dbm.BackendType(config.DBBackend), config.DBDir())
+	if err != nil {
+		return plan, err
+	}
+	defer evidenceDB.Close()
+	if _, err := evidence.MigrateDB(evidenceDB, logger); err != nil {
+		return plan, err
+	}
+	evidencePool, err := evidence.NewPool(evidenceDB, stateStore, blockStore)
+	if err != nil {
+		return plan, err
+	}
+
+	// Roll back what is derived from the state first, so that the rollback
+	// can be run again if it fails midway.
+	if txIndexer != nil {
+		if err := txIndexer.DeleteAboveHeight(height); err != nil {
+			return plan, fmt.Errorf("failed to remove indexed transactions: %w", err)
+		}
+		if err := blockIndexer.DeleteAboveHeight(height); err != nil {
+			return plan, fmt.Errorf("failed to remove indexed blocks: %w", err)
+		}
+	}
+	if err := evidencePool.Rollback(height, plan.Evidence); err != nil {
+		return plan, fmt.Errorf("failed to rollback evidence: %w", err)
+	}
+
+	_, err = state.RollbackTo(blockStore, stateStore, height, removeBlock)
+	return plan, err
+}
+
+func printRollbackPlan(plan state.RollbackPlan, dryRun bool) {
+	if dryRun {
+		fmt.Printf("Rolling back from height %d to height %d would remove:\n", plan.FromHeight, plan.ToHeight)
+	} else {
+		fmt.Printf("Rolled back from height %d to height %d, removing:\n", plan.FromHeight, plan.ToHeight)
+	}
+	fmt.Printf("  states:             heights %d to %d\n", plan.ToHeight+1, plan.FromHeight)
+	if plan.FirstRemovedBlock > 0 {
+		fmt.Printf("  blocks:             heights %d to %d\n", plan.FirstRemovedBlock, plan.LastRemovedBlock)
+	} else {
+		fmt.Println("  blocks:             none")
+	}
+	fmt.Printf("  indexed txs:        %d\n", plan.Txs)
+	fmt.Printf("  committed evidence: %d\n", len(plan.Evidence))
+}
+
 func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store, error) {
 	dbType := dbm.BackendType(config.DBBackend)
 
@@ -74,11 +185,10 @@ func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store,
 	}
 
 	// Get BlockStore
-	blockStoreDB, err := dbm.NewDB("blockstore", dbType, config.DBDir())
+	blockStore, err := store.LoadBlockStore(config, cfg.DefaultDBProvider, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	blockStore := store.NewBlockStore(blockStoreDB)
 
 	if !os.FileExists(filepath.Join(config.DBDir(), "state.db")) {
 		return nil, nil, fmt.Errorf("no statestore found in %v", config.DBDir())
@@ -89,6 +199,9 @@ func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store,
 	if err != nil {
 		return nil, nil, err
 	}
+	if _, err := state.MigrateDB(stateDB, logger); err != nil {
+		return nil, nil, err
+	}
 	stateStore := state.NewStore(stateDB, state.StoreOptions{
 		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
 	})
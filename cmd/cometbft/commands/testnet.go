@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -13,6 +14,7 @@ import (
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/bytes"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/lp2p"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/types"
@@ -34,12 +36,32 @@ var (
 	hostnames               []string
 	p2pPort                 int
 	randomMonikers          bool
+
+	libp2pEnabled   bool
+	libp2pTransport string
+	libp2pScaler    string
+	libp2pLimits    string
 )
 
 const (
 	nodeDirPerm = 0o755
 )
 
+// The presets of the libp2p scaler and resource limits of the testnet nodes.
+const (
+	// libp2pPresetDefault keeps the defaults of the config.
+	libp2pPresetDefault = "default"
+	// libp2pPresetLocal suits testnets whose nodes share a single machine:
+	// fewer workers per reactor, and at most one peer per other node.
+	libp2pPresetLocal = "local"
+	// libp2pPresetDisabled disables the resource limits.
+	libp2pPresetDisabled = "disabled"
+)
+
+// localMaxPeerStreams is the maximum number of concurrent streams per peer of
+// the local limits preset.
+const localMaxPeerStreams = 256
+
 func init() {
 	TestnetFilesCmd.Flags().IntVar(&nValidators, "v", 4,
 		"number of validators to initialize the testnet with")
@@ -74,6 +96,17 @@ func init() {
 		"P2P Port")
 	TestnetFilesCmd.Flags().BoolVar(&randomMonikers, "random-monikers", false,
 		"randomize the moniker for each generated node")
+
+	TestnetFilesCmd.Flags().BoolVar(&libp2pEnabled, "libp2p", false,
+		"use go-libp2p for networking (with --populate-persistent-peers, the other nodes are"+
+			" bootstrap peers instead of persistent peers)")
+	TestnetFilesCmd.Flags().StringVar(&libp2pTransport, "libp2p-transport", cfg.LibP2PTransportQUIC,
+		"libp2p transport (\"quic\" or \"tcp\")")
+	TestnetFilesCmd.Flags().StringVar(&libp2pScaler, "libp2p-scaler", libp2pPresetDefault,
+		"libp2p scaler preset (\"default\" or \"local\" for fewer workers per reactor)")
+	TestnetFilesCmd.Flags().StringVar(&libp2pLimits, "libp2p-limits", libp2pPresetDefault,
+		"libp2p resource limits preset (\"default\", \"disabled\" or \"local\" to allow one peer per"+
+			" other node)")
 }
 
 // TestnetFilesCmd allows initialisation of files for a CometBFT testnet.
@@ -87,9 +120,13 @@ Note, strict routability for addresses is turned off in the config file.
 
 Optionally, it will fill in persistent_peers list in config file using either hostnames or IPs.
 
+With --libp2p, the nodes use go-libp2p for networking, and the other nodes are
+filled in the bootstrap_peers list instead, identified by their libp2p peer IDs.
+
 Example:
 
 	cometbft testnet --v 4 --o ./output --populate-persistent-peers --starting-ip-address 192.168.10.2
+	cometbft testnet --v 4 --o ./output --libp2p --libp2p-transport tcp --libp2p-scaler local
 	`,
 	RunE: testnetFiles,
 }
@@ -102,6 +139,10 @@ func testnetFiles(*cobra.Command, []string) error {
 		)
 	}
 
+	if err := validateLibP2PFlags(); err != nil {
+		return err
+	}
+
 	config := cfg.DefaultConfig()
 
 	// overwrite default config if set and valid
@@ -198,14 +239,18 @@ func testnetFiles(*cobra.Command, []string) error {
 	// Gather persistent peer addresses.
 	var (
 		persistentPeers string
+		bootstrapPeers  []cfg.LibP2PBootstrapPeer
 		err             error
 	)
-	if populatePersistentPeers {
+	switch {
+	case populatePersistentPeers && libp2pEnabled:
+		bootstrapPeers, err = libp2pBootstrapPeers(config)
+	case populatePersistentPeers:
 		persistentPeers, err = persistentPeersString(config)
-		if err != nil {
-			_ = os.RemoveAll(outputDir)
-			return err
-		}
+	}
+	if err != nil {
+		_ = os.RemoveAll(outputDir)
+		return err
 	}
 
 	// Overwrite default config.
@@ -217,6 +262,9 @@ func testnetFiles(*cobra.Command, []string) error {
 		if populatePersistentPeers {
 			config.P2P.PersistentPeers = persistentPeers
 		}
+		if libp2pEnabled {
+			setLibP2PConfig(&config.P2P.LibP2PConfig, bootstrapPeers, i)
+		}
 		config.Moniker = moniker(i)
 
 		cfg.WriteConfigFile(filepath.Join(nodeDir, "config", "config.toml"), config)
@@ -272,6 +320,90 @@ func persistentPeersString(config *cfg.Config) (string, error) {
 	return strings.Join(persistentPeers, ","), nil
 }
 
+// libp2pBootstrapPeers returns the libp2p bootstrap peers of all the nodes.
+func libp2pBootstrapPeers(config *cfg.Config) ([]cfg.LibP2PBootstrapPeer, error) {
+	bootstrapPeers := make([]cfg.LibP2PBootstrapPeer, nValidators+nNonValidators)
+	for i := 0; i < nValidators+nNonValidators; i++ {
+		nodeDir := filepath.Join(outputDir, fmt.Sprintf("%s%d", nodeDirPrefix, i))
+		config.SetRoot(nodeDir)
+		nodeKey, err := p2p.LoadNodeKey(config.NodeKeyFile())
+		if err != nil {
+			return nil, err
+		}
+		id, err := lp2p.IDFromPrivateKey(nodeKey.PrivKey)
+		if err != nil {
+			return nil, err
+		}
+		bootstrapPeers[i] = cfg.LibP2PBootstrapPeer{
+			Host:       fmt.Sprintf("%s:%d", hostnameOrIP(i), p2pPort),
+			ID:         id.String(),
+			Persistent: true,
+		}
+	}
+	return bootstrapPeers, nil
+}
+
+// setLibP2PConfig enables libp2p in the config of node i, with the other nodes
+// as bootstrap peers, and the transport and presets of the flags.
+func setLibP2PConfig(config *cfg.LibP2PConfig, bootstrapPeers []cfg.LibP2PBootstrapPeer, i int) {
+	config.Enabled = true
+	config.Transport = libp2pTransport
+
+	config.BootstrapPeers = make([]cfg.LibP2PBootstrapPeer, 0, len(bootstrapPeers))
+	for j, peer := range bootstrapPeers {
+		if j != i {
+			config.BootstrapPeers = append(config.BootstrapPeers, peer)
+		}
+	}
+
+	switch libp2pScaler {
+	case libp2pPresetDefault:
+		config.Scaler = cfg.DefaultLibP2PScaler()
+	case libp2pPresetLocal:
+		config.Scaler = cfg.LibP2PScaler{
+			MinWorkers:       1,
+			MaxWorkers:       8,
+			ThresholdLatency: 100 * time.Millisecond,
+			Overrides: []cfg.LibP2PScalerOverride{
+				{
+					Reactor:          "MEMPOOL",
+					MinWorkers:       2,
+					MaxWorkers:       64,
+					ThresholdLatency: 500 * time.Millisecond,
+				},
+			},
+		}
+	}
+
+	switch libp2pLimits {
+	case libp2pPresetDefault:
+		config.Limits = cfg.DefaultLibP2PLimits()
+	case libp2pPresetDisabled:
+		config.Limits = cfg.LibP2PLimits{Mode: cfg.LibP2PLimitsModeDisabled}
+	case libp2pPresetLocal:
+		config.Limits = cfg.LibP2PLimits{
+			Mode:           cfg.LibP2PLimitsModeCustom,
+			MaxPeers:       max(nValidators+nNonValidators-1, 1),
+			MaxPeerStreams: localMaxPeerStreams,
+		}
+	}
+}
+
+func validateLibP2PFlags() error {
+	if libp2pTransport != cfg.LibP2PTransportQUIC && libp2pTransport != cfg.LibP2PTransportTCP {
+		return fmt.Errorf("unknown libp2p transport %q, must be quic or tcp", libp2pTransport)
+	}
+	if libp2pScaler != libp2pPresetDefault && libp2pScaler != libp2pPresetLocal {
+		return fmt.Errorf("unknown libp2p scaler preset %q, must be default or local", libp2pScaler)
+	}
+	switch libp2pLimits {
+	case libp2pPresetDefault, libp2pPresetDisabled, libp2pPresetLocal:
+		return nil
+	default:
+		return fmt.Errorf("unknown libp2p limits preset %q, must be default, disabled or local", libp2pLimits)
+	}
+}
+
 func moniker(i int) string {
 	if randomMonikers {
 		return randomMoniker()
@@ -29,8 +29,12 @@ func main() {
 		cmd.GenNodeKeyCmd,
 		cmd.VersionCmd,
 		cmd.RollbackStateCmd,
+		cmd.ExportCmd,
+		cmd.ImportCmd,
 		cmd.CompactGoLevelDBCmd,
+		cmd.DBCmd,
 		cmd.InspectCmd,
+		cmd.MonitorCmd,
 		debug.DebugCmd,
 		cli.NewCompletionCmd(rootCmd, true),
 	)
@@ -50,6 +50,9 @@ const (
 	LibP2PLimitsModeDefault  = "default"
 	LibP2PLimitsModeCustom   = "custom"
 
+	LibP2PTransportQUIC = "quic"
+	LibP2PTransportTCP  = "tcp"
+
 	v0 = "v0"
 	v1 = "v1"
 	v2 = "v2"
@@ -160,6 +163,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return ErrInSection{Section: "consensus", Err: err}
 	}
+	if err := cfg.Storage.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "storage", Err: err}
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return ErrInSection{Section: "instrumentation", Err: err}
 	}
@@ -238,9 +244,18 @@ type BaseConfig struct {
 	// A JSON file containing the private key to use for p2p authenticated encryption
 	NodeKey string `mapstructure:"node_key_file"`
 
-	// Mechanism to connect to the ABCI application: socket | grpc
+	// Mechanism to connect to the ABCI application: socket | socket_mux | grpc
+	// socket_mux uses the multiplexed socket protocol for the mempool
+	// connection, whose CheckTx backlog then doesn't delay the other
+	// connections, e.g. FinalizeBlock, and the socket protocol for the other
+	// connections.
 	ABCI string `mapstructure:"abci"`
 
+	// Directory to record the ABCI requests and responses of the consensus
+	// and mempool connections to, for replaying them later with
+	// `abci-cli replay`. Recording is disabled if empty.
+	ABCIRecordDir string `mapstructure:"abci_record_dir"`
+
 	// If true, query the ABCI app on connecting to a new peer
 	// so the app can decide if we should keep the connection or not
 	FilterPeers bool `mapstructure:"filter_peers"` // false
@@ -298,6 +313,15 @@ func (cfg BaseConfig) DBDir() string {
 	return rootify(cfg.DBPath, cfg.RootDir)
 }
 
+// ABCIRecordPath returns the full path to the ABCI recording directory, or an
+// empty string if recording is disabled.
+func (cfg BaseConfig) ABCIRecordPath() string {
+	if cfg.ABCIRecordDir == "" {
+		return ""
+	}
+	return rootify(cfg.ABCIRecordDir, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg BaseConfig) ValidateBasic() error {
@@ -351,6 +375,11 @@ type RPCConfig struct {
 	// Activate unsafe RPC commands like /dial_persistent_peers and /unsafe_flush_mempool
 	Unsafe bool `mapstructure:"unsafe"`
 
+	// Number of the most recent info, warn and error log lines kept in memory
+	// and served in the debug bundle of /unsafe_debug_bundle. Only used if
+	// Unsafe is true. 0 - disabled.
+	DebugLogBufferSize int `mapstructure:"debug_log_buffer_size"`
+
 	// Maximum number of simultaneous connections (including WebSocket).
 	// Does not include gRPC connections. See grpc_max_open_connections
 	// If you want to accept a larger number than the default, make sure
@@ -443,6 +472,7 @@ func DefaultRPCConfig() *RPCConfig {
 		GRPCMaxOpenConnections: 900,
 
 		Unsafe:             false,
+		DebugLogBufferSize: 1000,
 		MaxOpenConnections: 900,
 
 		MaxSubscriptionClients:    100,
@@ -475,6 +505,9 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.GRPCMaxOpenConnections < 0 {
 		return errors.New("grpc_max_open_connections can't be negative")
 	}
+	if cfg.DebugLogBufferSize < 0 {
+		return cmterrors.ErrNegativeField{Field: "debug_log_buffer_size"}
+	}
 	if cfg.MaxOpenConnections < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_open_connections"}
 	}
@@ -625,6 +658,9 @@ type LibP2PConfig struct {
 	// Enabled set true to use go-libp2p for networking
 	Enabled bool `mapstructure:"enabled"`
 
+	// Transport used to connect to peers: quic or tcp
+	Transport string `mapstructure:"transport"`
+
 	// BootstrapPeers list of peers to bootstrap the libp2p host
 	BootstrapPeers []LibP2PBootstrapPeer `mapstructure:"bootstrap_peers"`
 
@@ -770,6 +806,7 @@ func (cfg *P2PConfig) LibP2PEnabled() bool {
 func DefaultLibP2PConfig() LibP2PConfig {
 	return LibP2PConfig{
 		Enabled:        false,
+		Transport:      LibP2PTransportQUIC,
 		BootstrapPeers: []LibP2PBootstrapPeer{},
 		Scaler:         DefaultLibP2PScaler(),
 		Limits:         DefaultLibP2PLimits(),
@@ -781,7 +818,16 @@ func (cfg *LibP2PConfig) ValidateBasic() error {
 		return fmt.Sprintf("p2p.libp2p.%s", fmt.Sprintf(msg, args...))
 	}
 
-	// 1. validate bootstrap peers
+	// 1. validate transport
+	switch cfg.Transport {
+	case "":
+		return cmterrors.ErrRequiredField{Field: key("transport")}
+	case LibP2PTransportQUIC, LibP2PTransportTCP:
+	default:
+		return cmterrors.ErrInvalidField{Field: key("transport"), Reason: "must be one of: quic, tcp"}
+	}
+
+	// 2. validate bootstrap peers
 	for i, bp := range cfg.BootstrapPeers {
 		if bp.Host == "" {
 			return cmterrors.ErrRequiredField{Field: key("bootstrap_peers.%d.host", i)}
@@ -791,12 +837,12 @@ func (cfg *LibP2PConfig) ValidateBasic() error {
 		}
 	}
 
-	// 2. validate scaler
+	// 3. validate scaler
 	if err := cfg.Scaler.ValidateBasic(); err != nil {
 		return err
 	}
 
-	// 3. validate limits
+	// 4. validate limits
 	if err := cfg.Limits.ValidateBasic(); err != nil {
 		return err
 	}
@@ -1165,13 +1211,18 @@ func (cfg *StateSyncConfig) ValidateBasic() error {
 type BlockSyncConfig struct {
 	Version      string `mapstructure:"version"`
 	AdaptiveSync bool   `mapstructure:"adaptive_sync"`
+
+	// Number of workers verifying the commits of downloaded blocks ahead of
+	// their execution. 0 verifies each commit right before its execution.
+	VerifyWorkers int `mapstructure:"verify_workers"`
 }
 
 // DefaultBlockSyncConfig returns a default configuration for the block sync service
 func DefaultBlockSyncConfig() *BlockSyncConfig {
 	return &BlockSyncConfig{
-		Version:      "v0",
-		AdaptiveSync: false,
+		Version:       "v0",
+		AdaptiveSync:  false,
+		VerifyWorkers: 4,
 	}
 }
 
@@ -1182,6 +1233,10 @@ func TestBlockSyncConfig() *BlockSyncConfig {
 
 // ValidateBasic performs basic validation.
 func (cfg *BlockSyncConfig) ValidateBasic() error {
+	if cfg.VerifyWorkers < 0 {
+		return cmterrors.ErrNegativeField{Field: "verify_workers"}
+	}
+
 	switch cfg.Version {
 	case v0:
 		return nil
@@ -1223,6 +1278,21 @@ type ConsensusConfig struct {
 	// Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 	SkipTimeoutCommit bool `mapstructure:"skip_timeout_commit"`
 
+	// AdaptiveTimeouts replaces the timeouts above, at round 0, by ones learnt
+	// from the arrival times of the proposals and the votes of the latest
+	// heights, bounded by the minimums and maximums below. The deltas still
+	// apply to the later rounds.
+	AdaptiveTimeouts bool `mapstructure:"adaptive_timeouts"`
+	// Bounds of the adaptive timeout_propose
+	TimeoutProposeMin time.Duration `mapstructure:"timeout_propose_min"`
+	TimeoutProposeMax time.Duration `mapstructure:"timeout_propose_max"`
+	// Bounds of the adaptive timeout_prevote and timeout_precommit
+	TimeoutVoteMin time.Duration `mapstructure:"timeout_vote_min"`
+	TimeoutVoteMax time.Duration `mapstructure:"timeout_vote_max"`
+	// Bounds of the adaptive timeout_commit
+	TimeoutCommitMin time.Duration `mapstructure:"timeout_commit_min"`
+	TimeoutCommitMax time.Duration `mapstructure:"timeout_commit_max"`
+
 	// EmptyBlocks mode and possible interval between empty blocks
 	CreateEmptyBlocks         bool          `mapstructure:"create_empty_blocks"`
 	CreateEmptyBlocksInterval time.Duration `mapstructure:"create_empty_blocks_interval"`
@@ -1235,6 +1305,15 @@ type ConsensusConfig struct {
 
 	// BlockTimeTolerance is the maximum allowed difference between the proposed block time and wall-clock time.
 	BlockTimeTolerance time.Duration `mapstructure:"block_time_tolerance"`
+
+	// CompactBlocks enables gossiping proposed blocks as compact blocks, i.e.
+	// the block with its txs replaced by their keys, which peers reconstruct
+	// from their mempool.
+	CompactBlocks bool `mapstructure:"compact_blocks"`
+
+	// CompactBlockTimeout is how long to wait for a peer to reconstruct a
+	// compact block before falling back to gossiping the block parts.
+	CompactBlockTimeout time.Duration `mapstructure:"compact_block_timeout"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
@@ -1249,12 +1328,21 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		TimeoutPrecommitDelta:       500 * time.Millisecond,
 		TimeoutCommit:               1000 * time.Millisecond,
 		SkipTimeoutCommit:           false,
+		AdaptiveTimeouts:            false,
+		TimeoutProposeMin:           1000 * time.Millisecond,
+		TimeoutProposeMax:           6000 * time.Millisecond,
+		TimeoutVoteMin:              250 * time.Millisecond,
+		TimeoutVoteMax:              2000 * time.Millisecond,
+		TimeoutCommitMin:            500 * time.Millisecond,
+		TimeoutCommitMax:            2000 * time.Millisecond,
 		CreateEmptyBlocks:           true,
 		CreateEmptyBlocksInterval:   0 * time.Second,
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
 		DoubleSignCheckHeight:       int64(0),
 		BlockTimeTolerance:          60 * time.Second,
+		CompactBlocks:               false,
+		CompactBlockTimeout:         1 * time.Second,
 	}
 }
 
@@ -1273,6 +1361,7 @@ func TestConsensusConfig() *ConsensusConfig {
 	cfg.PeerGossipSleepDuration = 5 * time.Millisecond
 	cfg.PeerQueryMaj23SleepDuration = 250 * time.Millisecond
 	cfg.DoubleSignCheckHeight = int64(0)
+	cfg.CompactBlockTimeout = 200 * time.Millisecond
 	return cfg
 }
 
@@ -1345,6 +1434,24 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.TimeoutCommit < 0 {
 		return cmterrors.ErrNegativeField{Field: "timeout_commit"}
 	}
+	if cfg.TimeoutProposeMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_propose_min"}
+	}
+	if cfg.TimeoutProposeMax < cfg.TimeoutProposeMin {
+		return errors.New("timeout_propose_max can't be less than timeout_propose_min")
+	}
+	if cfg.TimeoutVoteMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_vote_min"}
+	}
+	if cfg.TimeoutVoteMax < cfg.TimeoutVoteMin {
+		return errors.New("timeout_vote_max can't be less than timeout_vote_min")
+	}
+	if cfg.TimeoutCommitMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_commit_min"}
+	}
+	if cfg.TimeoutCommitMax < cfg.TimeoutCommitMin {
+		return errors.New("timeout_commit_max can't be less than timeout_commit_min")
+	}
 	if cfg.CreateEmptyBlocksInterval < 0 {
 		return cmterrors.ErrNegativeField{Field: "create_empty_blocks_interval"}
 	}
@@ -1360,6 +1467,9 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.BlockTimeTolerance <= 0 {
 		return errors.New("block_time_tolerance must be positive")
 	}
+	if cfg.CompactBlockTimeout < 0 {
+		return cmterrors.ErrNegativeField{Field: "compact_block_timeout"}
+	}
 	return nil
 }
 
@@ -1373,6 +1483,31 @@ type StorageConfig struct {
 	// required for `/block_results` RPC queries, and to reindex events in the
 	// command-line tool.
 	DiscardABCIResponses bool `mapstructure:"discard_abci_responses"`
+
+	// The number of most recent heights for which validator sets and consensus
+	// params are kept when blocks are pruned, so that they can still be
+	// queried via RPC. 0 keeps only what is needed for evidence verification.
+	HistoryRetainHeights int64 `mapstructure:"history_retain_heights"`
+
+	// The layout of the keys of the block store database: "v1" encodes the
+	// heights as decimal strings, "v2" with orderedcode, sorting the keys by
+	// height. Setting "v2" rewrites the keys of an existing v1 block store on
+	// startup, which can't be undone.
+	BlockStoreKeyLayout string `mapstructure:"block_store_key_layout"`
+
+	// The blocks older than this number of heights below the latest one are
+	// moved in the background from the block store database to a cold store
+	// one, which is only deleted from when blocks are pruned or rolled back,
+	// and can be compacted and backed up separately. 0 disables the cold store.
+	ColdBlockStoreDepth int64 `mapstructure:"cold_block_store_depth"`
+
+	// The number of block metas, commits and validator sets kept in memory in
+	// LRU caches, along with the latest state. 0 disables the caches.
+	CacheSize int `mapstructure:"cache_size"`
+
+	// The number of recent blocks kept in memory in an LRU cache. 0 disables
+	// the cache.
+	BlockCacheSize int `mapstructure:"block_cache_size"`
 }
 
 // DefaultStorageConfig returns the default configuration options relating to
@@ -1380,6 +1515,9 @@ type StorageConfig struct {
 func DefaultStorageConfig() *StorageConfig {
 	return &StorageConfig{
 		DiscardABCIResponses: false,
+		BlockStoreKeyLayout:  "v1",
+		CacheSize:            100,
+		BlockCacheSize:       10,
 	}
 }
 
@@ -1388,7 +1526,33 @@ func DefaultStorageConfig() *StorageConfig {
 func TestStorageConfig() *StorageConfig {
 	return &StorageConfig{
 		DiscardABCIResponses: false,
+		BlockStoreKeyLayout:  "v1",
+		CacheSize:            100,
+		BlockCacheSize:       10,
+	}
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *StorageConfig) ValidateBasic() error {
+	if cfg.HistoryRetainHeights < 0 {
+		return errors.New("history_retain_heights can't be negative")
 	}
+	if cfg.ColdBlockStoreDepth < 0 {
+		return errors.New("cold_block_store_depth can't be negative")
+	}
+	if cfg.CacheSize < 0 {
+		return errors.New("cache_size can't be negative")
+	}
+	if cfg.BlockCacheSize < 0 {
+		return errors.New("block_cache_size can't be negative")
+	}
+	switch cfg.BlockStoreKeyLayout {
+	case "v1", "v2":
+	default:
+		return fmt.Errorf("unknown block_store_key_layout %q, must be v1 or v2", cfg.BlockStoreKeyLayout)
+	}
+	return nil
 }
 
 // -----------------------------------------------------------------------------
@@ -1452,16 +1616,31 @@ type InstrumentationConfig struct {
 
 	// Instrumentation namespace.
 	Namespace string `mapstructure:"namespace"`
+
+	// Number of latest heights over which the signed, absent and nil commit
+	// signatures of each validator are counted, and served under
+	// /validator_uptime. The counts of the local validator are reported in the
+	// metrics.
+	// 0 - disabled.
+	ValidatorUptimeWindow int64 `mapstructure:"validator_uptime_window"`
+
+	// Log a warning whenever the local validator misses a multiple of this
+	// number of consecutive blocks. Requires the uptime tracking to be
+	// enabled.
+	// 0 - disabled.
+	MissedBlocksWarnThreshold int64 `mapstructure:"missed_blocks_warn_threshold"`
 }
 
 // DefaultInstrumentationConfig returns a default configuration for metrics
 // reporting.
 func DefaultInstrumentationConfig() *InstrumentationConfig {
 	return &InstrumentationConfig{
-		Prometheus:           false,
-		PrometheusListenAddr: ":26660",
-		MaxOpenConnections:   3,
-		Namespace:            "cometbft",
+		Prometheus:                false,
+		PrometheusListenAddr:      ":26660",
+		MaxOpenConnections:        3,
+		Namespace:                 "cometbft",
+		ValidatorUptimeWindow:     0,
+		MissedBlocksWarnThreshold: 0,
 	}
 }
 
@@ -1477,6 +1656,12 @@ func (cfg *InstrumentationConfig) ValidateBasic() error {
 	if cfg.MaxOpenConnections < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_open_connections"}
 	}
+	if cfg.ValidatorUptimeWindow < 0 {
+		return cmterrors.ErrNegativeField{Field: "validator_uptime_window"}
+	}
+	if cfg.MissedBlocksWarnThreshold < 0 {
+		return cmterrors.ErrNegativeField{Field: "missed_blocks_warn_threshold"}
+	}
 	return nil
 }
 
@@ -76,6 +76,7 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 
 	fieldsToTest := []string{
 		"GRPCMaxOpenConnections",
+		"DebugLogBufferSize",
 		"MaxOpenConnections",
 		"MaxSubscriptionClients",
 		"MaxSubscriptionsPerClient",
@@ -143,6 +144,26 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 					cfg.LibP2PConfig.Scaler = config.LibP2PScaler{}
 				},
 			},
+			{
+				name: "allowsTCPTransport",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Transport = config.LibP2PTransportTCP
+				},
+			},
+			{
+				name: "requiresTransport",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Transport = ""
+				},
+				errContains: "p2p.libp2p.transport is required",
+			},
+			{
+				name: "rejectsUnknownTransport",
+				mutate: func(cfg *config.P2PConfig) {
+					cfg.LibP2PConfig.Transport = "websocket"
+				},
+				errContains: "invalid field p2p.libp2p.transport must be one of: quic, tcp",
+			},
 			{
 				name: "requiresBootstrapPeerHost",
 				mutate: func(cfg *config.P2PConfig) {
@@ -289,6 +310,11 @@ func TestBlockSyncConfigValidateBasic(t *testing.T) {
 
 	cfg.Version = "invalid"
 	assert.Error(t, cfg.ValidateBasic())
+
+	// tamper with verify workers
+	cfg = config.TestBlockSyncConfig()
+	cfg.VerifyWorkers = -1
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestConsensusConfig_ValidateBasic(t *testing.T) {
@@ -311,6 +337,12 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"TimeoutPrecommitDelta negative":       {func(c *config.ConsensusConfig) { c.TimeoutPrecommitDelta = -1 }, true},
 		"TimeoutCommit":                        {func(c *config.ConsensusConfig) { c.TimeoutCommit = time.Second }, false},
 		"TimeoutCommit negative":               {func(c *config.ConsensusConfig) { c.TimeoutCommit = -1 }, true},
+		"TimeoutProposeMin negative":           {func(c *config.ConsensusConfig) { c.TimeoutProposeMin = -1 }, true},
+		"TimeoutProposeMax below min":          {func(c *config.ConsensusConfig) { c.TimeoutProposeMax = c.TimeoutProposeMin - 1 }, true},
+		"TimeoutVoteMin negative":              {func(c *config.ConsensusConfig) { c.TimeoutVoteMin = -1 }, true},
+		"TimeoutVoteMax below min":             {func(c *config.ConsensusConfig) { c.TimeoutVoteMax = c.TimeoutVoteMin - 1 }, true},
+		"TimeoutCommitMin negative":            {func(c *config.ConsensusConfig) { c.TimeoutCommitMin = -1 }, true},
+		"TimeoutCommitMax below min":           {func(c *config.ConsensusConfig) { c.TimeoutCommitMax = c.TimeoutCommitMin - 1 }, true},
 		"PeerGossipSleepDuration":              {func(c *config.ConsensusConfig) { c.PeerGossipSleepDuration = time.Second }, false},
 		"PeerGossipSleepDuration negative":     {func(c *config.ConsensusConfig) { c.PeerGossipSleepDuration = -1 }, true},
 		"PeerQueryMaj23SleepDuration":          {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
@@ -319,6 +351,7 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"BlockTimeTolerance":                   {func(c *config.ConsensusConfig) { c.BlockTimeTolerance = time.Second }, false},
 		"BlockTimeTolerance zero":              {func(c *config.ConsensusConfig) { c.BlockTimeTolerance = 0 }, true},
 		"BlockTimeTolerance negative":          {func(c *config.ConsensusConfig) { c.BlockTimeTolerance = -1 }, true},
+		"CompactBlockTimeout negative":         {func(c *config.ConsensusConfig) { c.CompactBlockTimeout = -1 }, true},
 	}
 	for desc, tc := range testcases {
 		// appease linter
@@ -343,4 +376,8 @@ func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	// tamper with maximum open connections
 	cfg.MaxOpenConnections = -1
 	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = config.TestInstrumentationConfig()
+	cfg.ValidatorUptimeWindow = -1
+	assert.Error(t, cfg.ValidateBasic())
 }
@@ -133,9 +133,19 @@ priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey }}"
 
-# Mechanism to connect to the ABCI application: socket | grpc
+# Mechanism to connect to the ABCI application: socket | socket_mux | grpc
+# socket_mux uses the multiplexed socket protocol for the mempool connection,
+# whose CheckTx backlog then doesn't delay the other connections, e.g.
+# FinalizeBlock, and the socket protocol for the other connections.
 abci = "{{ .BaseConfig.ABCI }}"
 
+# Directory to record the ABCI requests and responses of the consensus and
+# mempool connections to, for debugging the application offline with
+# "abci-cli replay". Recording is disabled if empty.
+# WARNING: recordings grow with every block and transaction; only enable this
+# while debugging.
+abci_record_dir = "{{ .BaseConfig.ABCIRecordDir }}"
+
 # If true, query the ABCI app on connecting to a new peer
 # so the app can decide if we should keep the connection or not
 filter_peers = {{ .BaseConfig.FilterPeers }}
@@ -180,6 +190,12 @@ grpc_max_open_connections = {{ .RPC.GRPCMaxOpenConnections }}
 # Activate unsafe RPC commands like /dial_seeds and /unsafe_flush_mempool
 unsafe = {{ .RPC.Unsafe }}
 
+# Number of the most recent info, warn and error log lines kept in memory and
+# served in the debug bundle of /unsafe_debug_bundle (see "cometbft debug remote").
+# Only used if unsafe is true.
+# 0 - disabled.
+debug_log_buffer_size = {{ .RPC.DebugLogBufferSize }}
+
 # Maximum number of simultaneous connections (including WebSocket).
 # Does not include gRPC connections. See grpc_max_open_connections
 # If you want to accept a larger number than the default, make sure
@@ -336,6 +352,12 @@ dial_timeout = "{{ .P2P.DialTimeout }}"
 # Enabled set true to use go-libp2p for networking instead of CometBFT's p2p.
 enabled = {{ .P2P.LibP2PConfig.Enabled }}
 
+# Transport used to listen and to connect to peers:
+# - quic: QUIC over UDP (default)
+# - tcp: TCP, secured with TLS or Noise and multiplexed with yamux
+# All the peers of a network must use the same transport.
+transport = "{{ .P2P.LibP2PConfig.Transport }}"
+
 # Bootstrap peers to connect to
 # format: { host, id, private (opt), persistent (opt), unconditional (opt) }
 {{- $bps := .P2P.LibP2PConfig.BootstrapPeers -}}
@@ -540,6 +562,11 @@ version = "{{ .BlockSync.Version }}"
 # Run both BLOCKSYNC and CONSENSUS for improved liveness, connectivity, and performance.
 adaptive_sync = {{ .BlockSync.AdaptiveSync }}
 
+# Number of workers verifying the commits of downloaded blocks ahead of their
+# execution, so that the execution is the only sequential step while catching up.
+# Set to 0 to verify each commit right before its block is executed.
+verify_workers = {{ .BlockSync.VerifyWorkers }}
+
 #######################################################
 ###         Consensus Configuration Options         ###
 #######################################################
@@ -573,6 +600,20 @@ double_sign_check_height = {{ .Consensus.DoubleSignCheckHeight }}
 # Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 skip_timeout_commit = {{ .Consensus.SkipTimeoutCommit }}
 
+# Replace the timeouts above, at round 0, by ones learnt from the arrival times
+# of the proposals and the votes of the latest heights, bounded by the
+# minimums and maximums below. The deltas still apply to the later rounds.
+adaptive_timeouts = {{ .Consensus.AdaptiveTimeouts }}
+# Bounds of the adaptive timeout_propose
+timeout_propose_min = "{{ .Consensus.TimeoutProposeMin }}"
+timeout_propose_max = "{{ .Consensus.TimeoutProposeMax }}"
+# Bounds of the adaptive timeout_prevote and timeout_precommit
+timeout_vote_min = "{{ .Consensus.TimeoutVoteMin }}"
+timeout_vote_max = "{{ .Consensus.TimeoutVoteMax }}"
+# Bounds of the adaptive timeout_commit
+timeout_commit_min = "{{ .Consensus.TimeoutCommitMin }}"
+timeout_commit_max = "{{ .Consensus.TimeoutCommitMax }}"
+
 # EmptyBlocks mode and possible interval between empty blocks
 create_empty_blocks = {{ .Consensus.CreateEmptyBlocks }}
 create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
@@ -584,6 +625,14 @@ peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 # Maximum allowed difference between proposed block time and wall-clock time.
 block_time_tolerance = "{{ .Consensus.BlockTimeTolerance }}"
 
+# Gossip proposed blocks as compact blocks: the block header plus the keys of
+# its txs, which peers look up in their mempool to reconstruct the block,
+# fetching only the missing txs. Peers fall back to gossiping block parts if
+# a compact block isn't reconstructed within compact_block_timeout.
+# All peers must run a version supporting compact blocks.
+compact_blocks = {{ .Consensus.CompactBlocks }}
+compact_block_timeout = "{{ .Consensus.CompactBlockTimeout }}"
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -595,6 +644,37 @@ block_time_tolerance = "{{ .Consensus.BlockTimeTolerance }}"
 # reindex events in the command-line tool.
 discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 
+# The number of most recent heights for which validator sets and consensus
+# params are kept when blocks are pruned, so that they remain available via the
+# /validator_set, /consensus_params_proof and /validator_set_changes RPC
+# endpoints. 0 keeps only what is needed for evidence verification.
+history_retain_heights = {{ .Storage.HistoryRetainHeights }}
+
+# The layout of the keys of the block store database:
+#   1) "v1" (default) - the heights are encoded as decimal strings.
+#   2) "v2" - the keys are encoded with orderedcode, so that they are sorted by
+#   height, which makes pruning faster.
+# Setting "v2" rewrites the keys of an existing v1 block store on the next
+# startup, which can take a while for large block stores and can't be undone.
+block_store_key_layout = "{{ .Storage.BlockStoreKeyLayout }}"
+
+# The blocks older than this number of heights below the latest one are moved
+# in the background from the blockstore database to the blockstore-cold one,
+# which is only deleted from when blocks are pruned or rolled back, and can be
+# compacted, backed up or put on a cheaper disk separately. Blocks keep being
+# loaded and served from both. 0 disables the cold store; the blocks
+# moved before are still loaded from it.
+cold_block_store_depth = {{ .Storage.ColdBlockStoreDepth }}
+
+# The number of block metas, commits and validator sets kept in memory in LRU
+# caches, along with the latest state. 0 disables the caches.
+cache_size = {{ .Storage.CacheSize }}
+
+# The number of recent blocks kept in memory in an LRU cache, e.g. to serve them
+# to peers block syncing. Blocks can be up to the max_bytes consensus param. 0
+# disables the cache.
+block_cache_size = {{ .Storage.BlockCacheSize }}
+
 #######################################################
 ###   Transaction Indexer Configuration Options     ###
 #######################################################
@@ -638,4 +718,15 @@ max_open_connections = {{ .Instrumentation.MaxOpenConnections }}
 
 # Instrumentation namespace
 namespace = "{{ .Instrumentation.Namespace }}"
+
+# Number of latest heights over which the signed, absent and nil commit
+# signatures of each validator are counted, and served under /validator_uptime.
+# The counts of the local validator are reported in the metrics.
+# 0 - disabled.
+validator_uptime_window = {{ .Instrumentation.ValidatorUptimeWindow }}
+
+# Log a warning whenever the local validator misses a multiple of this number
+# of consecutive blocks. Requires the uptime tracking to be enabled.
+# 0 - disabled.
+missed_blocks_warn_threshold = {{ .Instrumentation.MissedBlocksWarnThreshold }}
 `
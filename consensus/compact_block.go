@@ -0,0 +1,512 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/libs/bits"
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Compact blocks
+//
+// When compact blocks are enabled, a peer holding a complete proposal block
+// sends a CompactBlockMessage to peers which have the proposal but none of its
+// parts, instead of gossiping the parts. The message carries the block with its
+// txs replaced by their keys. The receiver looks the txs up in its mempool,
+// requests the missing ones from the sender, reconstructs the block and feeds
+// its parts to the consensus state as if they were gossiped. It then sends a
+// HasCompactBlockMessage, so that the sender doesn't gossip the parts.
+//
+// A compact block is only accepted once it matches the proposal of the
+// receiver's round state: the peer isn't marked as having the block parts
+// before. A compact block received before the proposal is kept until the
+// proposal is set.
+//
+// If the receiver doesn't reconstruct the block within the configured
+// timeout, e.g. because the reconstructed block doesn't match the proposal,
+// the sender falls back to gossiping the parts.
+//
+// The compact block messages are sent on CompactBlockChannel, and only to the
+// peers advertising it: older peers don't know the messages, and would
+// disconnect on receiving them.
+
+// maxCompactBlockTxsBytes bounds the size of the txs sent in a
+// CompactBlockTxsMessage, leaving room for the rest of the message.
+const maxCompactBlockTxsBytes = maxMsgSize - 1024
+
+// ReactorMempool sets the mempool used to reconstruct compact blocks. Compact
+// blocks are neither sent nor reconstructed without a mempool.
+func ReactorMempool(mempool mempl.Mempool) ReactorOption {
+	return func(conR *Reactor) { conR.mempool = mempool }
+}
+
+// compactBlocksEnabled returns true if compact blocks are sent to peers.
+func (conR *Reactor) compactBlocksEnabled() bool {
+	return conR.conS.config.CompactBlocks && conR.mempool != nil
+}
+
+// peerSupportsCompactBlocks returns true if the peer advertises
+// CompactBlockChannel.
+func peerSupportsCompactBlocks(peer p2p.Peer) bool {
+	ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && ni.HasChannel(CompactBlockChannel)
+}
+
+// gossipCompactBlock sends the compact block of the current proposal to the
+// peer if it has the proposal but none of its parts. Returns true if it was
+// sent.
+func (conR *Reactor) gossipCompactBlock(
+	logger log.Logger,
+	rs *cstypes.RoundState,
+	ps *PeerState,
+	prs *cstypes.PeerRoundState,
+) bool {
+	if rs.Height != prs.Height || rs.Round != prs.Round || !prs.Proposal {
+		return false
+	}
+	if rs.ProposalBlock == nil || !rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) ||
+		!rs.ProposalBlockParts.IsComplete() {
+		return false
+	}
+	if prs.ProposalBlockParts == nil || !prs.ProposalBlockParts.IsEmpty() || ps.compactBlockSent(rs.Height, rs.Round) {
+		return false
+	}
+	if !peerSupportsCompactBlocks(ps.peer) {
+		return false
+	}
+
+	msg := conR.getCompactBlock(rs)
+	if msg == nil {
+		return false
+	}
+
+	logger.Debug("Sending compact block", "height", rs.Height, "round", rs.Round)
+	if !ps.peer.Send(p2p.Envelope{ChannelID: CompactBlockChannel, Message: msg}) {
+		return false
+	}
+	ps.setCompactBlockSent(rs.Height, rs.Round)
+	conR.Metrics.CompactBlocksSent.Add(1)
+
+	return true
+}
+
+// getCompactBlock returns the compact block of the round state's proposal
+// block, or nil if it doesn't fit in a message. It's cached, since it's sent
+// to all peers.
+func (conR *Reactor) getCompactBlock(rs *cstypes.RoundState) *cmtcons.CompactBlock {
+	psh := rs.ProposalBlockParts.Header()
+
+	conR.compactMtx.Lock()
+	defer conR.compactMtx.Unlock()
+
+	if c := conR.compactBlock; c.height == rs.Height && c.round == rs.Round && c.partSetHeader.Equals(psh) {
+		return c.msg
+	}
+
+	block := rs.ProposalBlock
+	txKeys := make([]types.TxKey, len(block.Txs))
+	for i, tx := range block.Txs {
+		txKeys[i] = tx.Key()
+	}
+	var msg *cmtcons.CompactBlock
+	pb, err := MsgToProto(&CompactBlockMessage{
+		Height:             rs.Height,
+		Round:              rs.Round,
+		BlockPartSetHeader: psh,
+		Header:             block.Header,
+		Evidence:           block.Evidence,
+		LastCommit:         block.LastCommit,
+		TxKeys:             txKeys,
+	})
+	if err != nil {
+		conR.Logger.Error("Failed to build compact block", "height", rs.Height, "err", err)
+	} else if pb.(*cmtcons.CompactBlock).Size() < maxMsgSize {
+		msg = pb.(*cmtcons.CompactBlock)
+	}
+
+	conR.compactBlock = compactBlockCache{
+		height:        rs.Height,
+		round:         rs.Round,
+		partSetHeader: psh,
+		msg:           msg,
+	}
+	return msg
+}
+
+// compactBlockCache caches the compact block of the latest proposal block.
+type compactBlockCache struct {
+	height        int64
+	round         int32
+	partSetHeader types.PartSetHeader
+	msg           *cmtcons.CompactBlock // nil if it doesn't fit in a message
+}
+
+// handleCompactBlock reconstructs a compact block received from a peer, or
+// requests the txs missing from the mempool. Compact blocks which are not the
+// block of the current proposal are ignored.
+func (conR *Reactor) handleCompactBlock(msg *CompactBlockMessage, src p2p.Peer, ps *PeerState) {
+	if conR.mempool == nil {
+		return
+	}
+	rs := conR.getRoundState()
+	if rs.Height == msg.Height && rs.Round == msg.Round && rs.Proposal == nil {
+		// the proposal is received on the data channel, and may not be
+		// processed yet: the compact block is handled once it is, see
+		// handleEarlyCompactBlock
+		ps.setEarlyCompactBlock(msg)
+		return
+	}
+	if err := checkCompactBlockMatchesProposal(msg, &rs); err != nil {
+		conR.Logger.Debug("Ignoring compact block", "peer", src, "height", msg.Height, "round", msg.Round, "err", err)
+		return
+	}
+
+	// the peer has the whole block
+	ps.setHasAllProposalBlockParts(msg.Height, msg.Round, msg.BlockPartSetHeader)
+
+	if rs.ProposalBlockParts.HasHeader(msg.BlockPartSetHeader) && rs.ProposalBlockParts.IsComplete() {
+		src.TrySend(p2p.Envelope{
+			ChannelID: CompactBlockChannel,
+			Message:   &cmtcons.HasCompactBlock{Height: msg.Height, Round: msg.Round},
+		})
+		return
+	}
+
+	cb := newCompactBlock(msg, conR.mempool)
+	if missing := cb.missingTxs(); len(missing) > 0 {
+		conR.Logger.Debug("Requesting compact block txs missing from the mempool",
+			"height", msg.Height, "round", msg.Round, "missing", len(missing), "peer", src)
+		conR.Metrics.CompactBlockMissingTxs.Add(float64(len(missing)))
+		ps.setPendingCompactBlock(cb)
+		src.TrySend(p2p.Envelope{
+			ChannelID: CompactBlockChannel,
+			Message:   &cmtcons.CompactBlockTxsRequest{Height: msg.Height, Round: msg.Round, Indexes: missing},
+		})
+		return
+	}
+
+	conR.completeCompactBlock(cb, src)
+}
+
+// handleEarlyCompactBlock handles the compact block received from the peer
+// before the proposal, once the proposal is set.
+func (conR *Reactor) handleEarlyCompactBlock(rs *cstypes.RoundState, ps *PeerState) {
+	if msg := ps.takeEarlyCompactBlock(rs); msg != nil {
+		conR.handleCompactBlock(msg, ps.peer, ps)
+	}
+}
+
+// checkCompactBlockMatchesProposal returns an error unless the compact block
+// is the block of the proposal of the round state.
+func checkCompactBlockMatchesProposal(msg *CompactBlockMessage, rs *cstypes.RoundState) error {
+	if rs.Height != msg.Height || rs.Round != msg.Round {
+		return fmt.Errorf("expected height %d round %d", rs.Height, rs.Round)
+	}
+	if rs.Proposal == nil {
+		return errors.New("no proposal")
+	}
+	blockID := rs.Proposal.BlockID
+	if !blockID.PartSetHeader.Equals(msg.BlockPartSetHeader) {
+		return fmt.Errorf("block part set header %v doesn't match the proposal's %v",
+			msg.BlockPartSetHeader, blockID.PartSetHeader)
+	}
+	if hash := msg.Header.Hash(); !bytes.Equal(hash, blockID.Hash) {
+		return fmt.Errorf("block hash %X doesn't match the proposal's %X", hash, blockID.Hash)
+	}
+	return nil
+}
+
+// handleCompactBlockTxs adds the txs received from a peer to the compact block
+// pending from it, and reconstructs the block if it's complete.
+func (conR *Reactor) handleCompactBlockTxs(msg *CompactBlockTxsMessage, src p2p.Peer, ps *PeerState) {
+	cb := ps.getPendingCompactBlock(msg.Height, msg.Round)
+	if cb == nil {
+		return
+	}
+
+	if err := cb.addTxs(msg.Indexes, msg.Txs); err != nil {
+		conR.Logger.Error("Peer sent invalid compact block txs", "peer", src, "err", err)
+		conR.Metrics.CompactBlocksReceived.With("status", "failed").Add(1)
+		ps.setPendingCompactBlock(nil)
+		return
+	}
+
+	if missing := cb.missingTxs(); len(missing) > 0 {
+		src.TrySend(p2p.Envelope{
+			ChannelID: CompactBlockChannel,
+			Message:   &cmtcons.CompactBlockTxsRequest{Height: msg.Height, Round: msg.Round, Indexes: missing},
+		})
+		return
+	}
+
+	ps.setPendingCompactBlock(nil)
+	conR.completeCompactBlock(cb, src)
+}
+
+// handleCompactBlockTxsRequest sends the requested txs of the current
+// proposal block to the peer, as many as fit in a message.
+func (conR *Reactor) handleCompactBlockTxsRequest(msg *CompactBlockTxsRequestMessage, src p2p.Peer) {
+	rs := conR.getRoundState()
+	if rs.Height != msg.Height || rs.Round != msg.Round || rs.ProposalBlock == nil {
+		return
+	}
+
+	txs := rs.ProposalBlock.Txs
+	resp := &CompactBlockTxsMessage{Height: msg.Height, Round: msg.Round}
+	size := 0
+	for _, index := range msg.Indexes {
+		if int(index) >= len(txs) {
+			conR.Logger.Debug("Peer requested an unknown compact block tx", "peer", src, "index", index)
+			return
+		}
+		tx := txs[index]
+		// varint length prefixes and index
+		size += len(tx) + 16
+		if size > maxCompactBlockTxsBytes {
+			break
+		}
+		resp.Indexes = append(resp.Indexes, index)
+		resp.Txs = append(resp.Txs, tx)
+	}
+	if len(resp.Txs) == 0 {
+		return
+	}
+
+	pb, err := MsgToProto(resp)
+	if err != nil {
+		conR.Logger.Error("Failed to convert compact block txs to proto", "err", err)
+		return
+	}
+	src.TrySend(p2p.Envelope{ChannelID: CompactBlockChannel, Message: pb})
+}
+
+// completeCompactBlock reconstructs the block and feeds its parts to the
+// consensus state.
+func (conR *Reactor) completeCompactBlock(cb *compactBlock, src p2p.Peer) {
+	parts, err := cb.reconstruct()
+	if err != nil {
+		conR.Logger.Info("Failed to reconstruct compact block, waiting for block parts",
+			"height", cb.msg.Height, "round", cb.msg.Round, "peer", src, "err", err)
+		conR.Metrics.CompactBlocksReceived.With("status", "failed").Add(1)
+		return
+	}
+	conR.Metrics.CompactBlocksReceived.With("status", "reconstructed").Add(1)
+
+	for i := 0; i < int(parts.Total()); i++ {
+		conR.conS.peerMsgQueue <- msgInfo{&BlockPartMessage{
+			Height: cb.msg.Height,
+			Round:  cb.msg.Round,
+			Part:   parts.GetPart(i),
+		}, src.ID()}
+	}
+
+	src.TrySend(p2p.Envelope{
+		ChannelID: CompactBlockChannel,
+		Message:   &cmtcons.HasCompactBlock{Height: cb.msg.Height, Round: cb.msg.Round},
+	})
+}
+
+// compactBlock is a compact block being reconstructed.
+type compactBlock struct {
+	msg *CompactBlockMessage
+	txs types.Txs // nil entries are missing
+}
+
+// newCompactBlock looks up the txs of a compact block in the mempool.
+func newCompactBlock(msg *CompactBlockMessage, mempool mempl.Mempool) *compactBlock {
+	txs := make(types.Txs, len(msg.TxKeys))
+	for i, key := range msg.TxKeys {
+		if tx, ok := mempool.GetTxByKey(key); ok {
+			txs[i] = tx
+		}
+	}
+	return &compactBlock{msg: msg, txs: txs}
+}
+
+// missingTxs returns the indexes of the txs missing from the block.
+func (cb *compactBlock) missingTxs() []uint32 {
+	var missing []uint32
+	for i, tx := range cb.txs {
+		if tx == nil {
+			missing = append(missing, uint32(i))
+		}
+	}
+	return missing
+}
+
+// addTxs adds txs to the block, verifying that they match its tx keys.
+func (cb *compactBlock) addTxs(indexes []uint32, txs types.Txs) error {
+	for i, index := range indexes {
+		if int(index) >= len(cb.txs) {
+			return fmt.Errorf("tx index %d out of range", index)
+		}
+		if txs[i].Key() != cb.msg.TxKeys[index] {
+			return fmt.Errorf("tx %d doesn't match its key", index)
+		}
+		cb.txs[index] = txs[i]
+	}
+	return nil
+}
+
+// reconstruct returns the parts of the block, once all its txs are known.
+func (cb *compactBlock) reconstruct() (*types.PartSet, error) {
+	if len(cb.missingTxs()) > 0 {
+		return nil, errors.New("missing txs")
+	}
+
+	block := &types.Block{
+		Header:     cb.msg.Header,
+		Data:       types.Data{Txs: cb.txs},
+		Evidence:   cb.msg.Evidence,
+		LastCommit: cb.msg.LastCommit,
+	}
+	if err := block.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid block: %w", err)
+	}
+
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !parts.HasHeader(cb.msg.BlockPartSetHeader) {
+		return nil, fmt.Errorf("block part set header mismatch: expected %v, got %v",
+			cb.msg.BlockPartSetHeader, parts.Header())
+	}
+
+	return parts, nil
+}
+
+// compactBlockSentState records the compact block sent to a peer.
+type compactBlockSentState struct {
+	height  int64
+	round   int32
+	sentAt  time.Time
+	pending bool // waiting for the peer to reconstruct it
+}
+
+// setCompactBlockSent records that the compact block at the given height and
+// round was sent to the peer.
+func (ps *PeerState) setCompactBlockSent(height int64, round int32) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactSent = compactBlockSentState{height: height, round: round, sentAt: time.Now(), pending: true}
+}
+
+// compactBlockSent returns true if the compact block at the given height and
+// round was sent to the peer.
+func (ps *PeerState) compactBlockSent(height int64, round int32) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	return ps.compactSent.height == height && ps.compactSent.round == round && !ps.compactSent.sentAt.IsZero()
+}
+
+// compactBlockPending returns true while the peer may reconstruct the compact
+// block at the given height and round, i.e. until it acknowledges it or the
+// timeout expires. expired is true the first time the timeout is observed.
+func (ps *PeerState) compactBlockPending(height int64, round int32, timeout time.Duration) (pending, expired bool) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	sent := &ps.compactSent
+	if !sent.pending || sent.height != height || sent.round != round {
+		return false, false
+	}
+	if time.Since(sent.sentAt) < timeout {
+		return true, false
+	}
+	sent.pending = false
+	return false, true
+}
+
+// setHasCompactBlock marks the peer as having reconstructed the compact block
+// at the given height and round.
+func (ps *PeerState) setHasCompactBlock(height int64, round int32) {
+	ps.mtx.Lock()
+	psh := ps.PRS.ProposalBlockPartSetHeader
+	if ps.compactSent.height == height && ps.compactSent.round == round {
+		ps.compactSent.pending = false
+	}
+	ps.mtx.Unlock()
+
+	ps.setHasAllProposalBlockParts(height, round, psh)
+}
+
+// setHasAllProposalBlockParts marks the peer as having all the parts of the
+// given proposal block.
+func (ps *PeerState) setHasAllProposalBlockParts(height int64, round int32, psh types.PartSetHeader) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.PRS.Height != height || ps.PRS.Round != round || psh.IsZero() {
+		return
+	}
+	if ps.PRS.ProposalBlockParts != nil && !ps.PRS.ProposalBlockPartSetHeader.Equals(psh) {
+		return
+	}
+
+	ps.PRS.ProposalBlockPartSetHeader = psh
+	ps.PRS.ProposalBlockParts = bits.NewBitArray(int(psh.Total)).Not()
+}
+
+// setPendingCompactBlock sets the compact block received from the peer which
+// is waiting for missing txs, if any.
+func (ps *PeerState) setPendingCompactBlock(cb *compactBlock) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactPending = cb
+}
+
+// getPendingCompactBlock returns the compact block received from the peer at
+// the given height and round which is waiting for missing txs, if any.
+func (ps *PeerState) getPendingCompactBlock(height int64, round int32) *compactBlock {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	cb := ps.compactPending
+	if cb == nil || cb.msg.Height != height || cb.msg.Round != round {
+		return nil
+	}
+	return cb
+}
+
+// setEarlyCompactBlock sets the compact block received from the peer before
+// the proposal.
+func (ps *PeerState) setEarlyCompactBlock(msg *CompactBlockMessage) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactEarly = msg
+}
+
+// takeEarlyCompactBlock returns the compact block received from the peer before
+// the proposal, once the round state has the proposal, and clears it. A
+// compact block of another height or round is dropped.
+func (ps *PeerState) takeEarlyCompactBlock(rs *cstypes.RoundState) *CompactBlockMessage {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	msg := ps.compactEarly
+	if msg == nil {
+		return nil
+	}
+	if msg.Height != rs.Height || msg.Round != rs.Round {
+		ps.compactEarly = nil
+		return nil
+	}
+	if rs.Proposal == nil {
+		return nil
+	}
+	ps.compactEarly = nil
+	return msg
+}
@@ -0,0 +1,178 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/crypto"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
+	"github.com/cometbft/cometbft/p2p"
+	p2pmock "github.com/cometbft/cometbft/p2p/mock"
+	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestCompactBlockReconstruct(t *testing.T) {
+	txs := types.Txs{types.Tx("tx1"), types.Tx("tx2"), types.Tx("tx3")}
+	block := types.MakeBlock(1, txs, &types.Commit{}, nil)
+	block.ChainID = "test-chain"
+	block.ProposerAddress = cmtrand.Bytes(crypto.AddressSize)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+
+	txKeys := make([]types.TxKey, len(txs))
+	for i, tx := range txs {
+		txKeys[i] = tx.Key()
+	}
+	pb, err := MsgToProto(&CompactBlockMessage{
+		Height:             1,
+		Round:              0,
+		BlockPartSetHeader: parts.Header(),
+		Header:             block.Header,
+		Evidence:           block.Evidence,
+		LastCommit:         block.LastCommit,
+		TxKeys:             txKeys,
+	})
+	require.NoError(t, err)
+	decoded, err := MsgFromProto(pb)
+	require.NoError(t, err)
+	msg := decoded.(*CompactBlockMessage)
+
+	// the mempool has all txs but the second one
+	mempool := &mpmocks.Mempool{}
+	mempool.On("GetTxByKey", txKeys[0]).Return(txs[0], true)
+	mempool.On("GetTxByKey", txKeys[1]).Return(nil, false)
+	mempool.On("GetTxByKey", txKeys[2]).Return(txs[2], true)
+
+	t.Run("MissingTxs", func(t *testing.T) {
+		cb := newCompactBlock(msg, mempool)
+		assert.Equal(t, []uint32{1}, cb.missingTxs())
+		_, err := cb.reconstruct()
+		require.Error(t, err)
+
+		require.Error(t, cb.addTxs([]uint32{1}, types.Txs{types.Tx("other")}))
+		require.Error(t, cb.addTxs([]uint32{3}, types.Txs{txs[1]}))
+		require.NoError(t, cb.addTxs([]uint32{1}, types.Txs{txs[1]}))
+		assert.Empty(t, cb.missingTxs())
+
+		reconstructed, err := cb.reconstruct()
+		require.NoError(t, err)
+		assert.Equal(t, parts.Header(), reconstructed.Header())
+	})
+
+	t.Run("PartSetHeaderMismatch", func(t *testing.T) {
+		wrongMsg := *msg
+		wrongMsg.BlockPartSetHeader = types.PartSetHeader{Total: 1, Hash: make([]byte, 32)}
+		cb := newCompactBlock(&wrongMsg, mempool)
+		require.NoError(t, cb.addTxs([]uint32{1}, types.Txs{txs[1]}))
+
+		_, err := cb.reconstruct()
+		require.Error(t, err)
+	})
+}
+
+func TestCheckCompactBlockMatchesProposal(t *testing.T) {
+	block := types.MakeBlock(1, types.Txs{types.Tx("tx1")}, &types.Commit{}, nil)
+	block.ChainID = "test-chain"
+	block.ValidatorsHash = cmtrand.Bytes(32)
+	block.ProposerAddress = cmtrand.Bytes(crypto.AddressSize)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+
+	msg := &CompactBlockMessage{
+		Height:             1,
+		Round:              1,
+		BlockPartSetHeader: parts.Header(),
+		Header:             block.Header,
+		LastCommit:         block.LastCommit,
+	}
+	rs := &cstypes.RoundState{
+		Height:   1,
+		Round:    1,
+		Proposal: types.NewProposal(1, 1, -1, blockID),
+	}
+	require.NoError(t, checkCompactBlockMatchesProposal(msg, rs))
+
+	wrongRound := *msg
+	wrongRound.Round = 0
+	require.Error(t, checkCompactBlockMatchesProposal(&wrongRound, rs))
+
+	wrongParts := *msg
+	wrongParts.BlockPartSetHeader = types.PartSetHeader{Total: 1, Hash: make([]byte, 32)}
+	require.Error(t, checkCompactBlockMatchesProposal(&wrongParts, rs))
+
+	wrongHeader := *msg
+	wrongHeader.Header.ChainID = "other-chain"
+	require.Error(t, checkCompactBlockMatchesProposal(&wrongHeader, rs))
+
+	noProposal := *rs
+	noProposal.Proposal = nil
+	require.Error(t, checkCompactBlockMatchesProposal(msg, &noProposal))
+
+	// a compact block received before the proposal is kept until it's set
+	ps := NewPeerState(p2pmock.NewPeer(nil))
+	ps.setEarlyCompactBlock(msg)
+	assert.Nil(t, ps.takeEarlyCompactBlock(&noProposal))
+	assert.Equal(t, msg, ps.takeEarlyCompactBlock(rs))
+	assert.Nil(t, ps.takeEarlyCompactBlock(rs))
+
+	// or dropped once the round moves on
+	ps.setEarlyCompactBlock(msg)
+	nextRound := *rs
+	nextRound.Round = 2
+	assert.Nil(t, ps.takeEarlyCompactBlock(&nextRound))
+	assert.Nil(t, ps.takeEarlyCompactBlock(rs))
+}
+
+func TestPeerSupportsCompactBlocks(t *testing.T) {
+	for _, tc := range []struct {
+		channels []byte
+		want     bool
+	}{
+		{[]byte{StateChannel, DataChannel, VoteChannel, VoteSetBitsChannel}, false},
+		{[]byte{StateChannel, DataChannel, VoteChannel, VoteSetBitsChannel, CompactBlockChannel}, true},
+	} {
+		peer := &p2pmocks.Peer{}
+		peer.On("NodeInfo").Return(p2p.DefaultNodeInfo{Channels: tc.channels})
+		assert.Equal(t, tc.want, peerSupportsCompactBlocks(peer))
+	}
+}
+
+func TestPeerStateCompactBlockPending(t *testing.T) {
+	ps := NewPeerState(p2pmock.NewPeer(nil))
+	psh := types.PartSetHeader{Total: 3, Hash: make([]byte, 32)}
+	ps.PRS.Height = 1
+	ps.PRS.Round = 0
+	ps.InitProposalBlockParts(psh)
+
+	pending, expired := ps.compactBlockPending(1, 0, time.Hour)
+	assert.False(t, pending)
+	assert.False(t, expired)
+
+	ps.setCompactBlockSent(1, 0)
+	assert.True(t, ps.compactBlockSent(1, 0))
+	pending, _ = ps.compactBlockPending(1, 0, time.Hour)
+	assert.True(t, pending)
+
+	// the timeout expires once
+	pending, expired = ps.compactBlockPending(1, 0, 0)
+	assert.False(t, pending)
+	assert.True(t, expired)
+	pending, expired = ps.compactBlockPending(1, 0, 0)
+	assert.False(t, pending)
+	assert.False(t, expired)
+	assert.True(t, ps.compactBlockSent(1, 0))
+
+	// acknowledged
+	ps.setCompactBlockSent(1, 0)
+	ps.setHasCompactBlock(1, 0)
+	pending, _ = ps.compactBlockPending(1, 0, time.Hour)
+	assert.False(t, pending)
+	assert.True(t, ps.GetRoundState().ProposalBlockParts.IsFull())
+}
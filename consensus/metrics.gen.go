@@ -130,6 +130,30 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "block_parts",
 			Help:      "Number of block parts transmitted by each peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		CompactBlocksSent: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_blocks_sent",
+			Help:      "Number of compact blocks sent to peers.",
+		}, labels).With(labelsAndValues...),
+		CompactBlocksReceived: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_blocks_received",
+			Help:      "Number of compact blocks received from peers, by whether they were reconstructed or not.",
+		}, append(labels, "status")).With(labelsAndValues...),
+		CompactBlockMissingTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_block_missing_txs",
+			Help:      "Number of compact block txs missing from the mempool, which were requested from peers.",
+		}, labels).With(labelsAndValues...),
+		CompactBlockFallbacks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_block_fallbacks",
+			Help:      "Number of times block parts were gossiped to a peer which didn't reconstruct a compact block in time.",
+		}, labels).With(labelsAndValues...),
 		DuplicateBlockPart: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -228,6 +252,26 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "round_increment_total",
 			Help:      "RoundIncrementTotal is the number of times that the consensus reactor has incremented above the initial round in a step.",
 		}, append(labels, "step")).With(labelsAndValues...),
+		ProposalLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "proposal_latency_seconds",
+			Help:      "Histogram of the time elapsed between the start of a round and the reception of its proposal.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.01, 10, 10),
+		}, labels).With(labelsAndValues...),
+		ValidatorVoteLatencySeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_vote_latency_seconds",
+			Help:      "Time elapsed between the start of a round and the reception of the latest vote of a validator in that round, labeled by vote type.",
+		}, append(labels, "validator_address", "vote_type")).With(labelsAndValues...),
+		TimeoutSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "timeout_seconds",
+			Help:      "Duration of the timeouts at round 0 for the current height, by step. They differ from the configured ones if adaptive timeouts are enabled.",
+		}, append(labels, "step")).With(labelsAndValues...),
 	}
 }
 
@@ -252,6 +296,10 @@ func NopMetrics() *Metrics {
 		TotalTxs:                    discard.NewGauge(),
 		CommittedHeight:             discard.NewGauge(),
 		BlockParts:                  discard.NewCounter(),
+		CompactBlocksSent:           discard.NewCounter(),
+		CompactBlocksReceived:       discard.NewCounter(),
+		CompactBlockMissingTxs:      discard.NewCounter(),
+		CompactBlockFallbacks:       discard.NewCounter(),
 		DuplicateBlockPart:          discard.NewCounter(),
 		DuplicateVote:               discard.NewCounter(),
 		StepDurationSeconds:         discard.NewHistogram(),
@@ -268,5 +316,8 @@ func NopMetrics() *Metrics {
 		LateVotes:                   discard.NewCounter(),
 		PeerHeight:                  discard.NewGauge(),
 		RoundIncrementTotal:         discard.NewCounter(),
+		ProposalLatencySeconds:      discard.NewHistogram(),
+		ValidatorVoteLatencySeconds: discard.NewGauge(),
+		TimeoutSeconds:              discard.NewGauge(),
 	}
 }
@@ -67,6 +67,18 @@ type Metrics struct {
 	// Number of block parts transmitted by each peer.
 	BlockParts metrics.Counter `metrics_labels:"peer_id"`
 
+	// Number of compact blocks sent to peers.
+	CompactBlocksSent metrics.Counter
+	// Number of compact blocks received from peers, by whether they were
+	// reconstructed or not.
+	CompactBlocksReceived metrics.Counter `metrics_labels:"status"`
+	// Number of compact block txs missing from the mempool, which were
+	// requested from peers.
+	CompactBlockMissingTxs metrics.Counter
+	// Number of times block parts were gossiped to a peer which didn't
+	// reconstruct a compact block in time.
+	CompactBlockFallbacks metrics.Counter
+
 	// Number of times we received a duplicate block part
 	DuplicateBlockPart metrics.Counter
 
@@ -152,6 +164,18 @@ type Metrics struct {
 	// RoundIncrementTotal is the number of times that the consensus reactor
 	// has incremented above the initial round in a step.
 	RoundIncrementTotal metrics.Counter `metrics_labels:"step"`
+
+	// Histogram of the time elapsed between the start of a round and the
+	// reception of its proposal.
+	ProposalLatencySeconds metrics.Histogram `metrics_buckettype:"exprange" metrics_bucketsizes:"0.01, 10, 10"`
+
+	// Time elapsed between the start of a round and the reception of the
+	// latest vote of a validator in that round, labeled by vote type.
+	ValidatorVoteLatencySeconds metrics.Gauge `metrics_labels:"validator_address, vote_type"`
+
+	// Duration of the timeouts at round 0 for the current height, by step.
+	// They differ from the configured ones if adaptive timeouts are enabled.
+	TimeoutSeconds metrics.Gauge `metrics_labels:"step"`
 }
 
 func (m *Metrics) MarkRoundIncremented(step cstypes.RoundStepType) {
@@ -198,6 +222,18 @@ func (m *Metrics) MarkLateVote(vt cmtproto.SignedMsgType) {
 	m.LateVotes.With("vote_type", n).Add(1)
 }
 
+func (m *Metrics) MarkVoteLatency(vote *types.Vote, latency time.Duration) {
+	n := types.SignedMsgTypeToShortString(vote.Type)
+	m.ValidatorVoteLatencySeconds.With("validator_address", vote.ValidatorAddress.String(), "vote_type", n).Set(latency.Seconds())
+}
+
+func (m *Metrics) MarkTimeouts(to *timeouts) {
+	m.TimeoutSeconds.With("step", "propose").Set(to.Propose(0).Seconds())
+	m.TimeoutSeconds.With("step", "prevote").Set(to.Prevote(0).Seconds())
+	m.TimeoutSeconds.With("step", "precommit").Set(to.Precommit(0).Seconds())
+	m.TimeoutSeconds.With("step", "commit").Set(to.commitTimeout().Seconds())
+}
+
 func (m *Metrics) MarkStep(s cstypes.RoundStepType) {
 	if !m.stepStart.IsZero() {
 		stepTime := time.Since(m.stepStart).Seconds()
@@ -110,6 +110,46 @@ func MsgToProto(msg Message) (proto.Message, error) {
 
 		pb = vsb
 
+	case *CompactBlockMessage:
+		evidence, err := msg.Evidence.ToProto()
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "Evidence", Err: err}
+		}
+		txKeys := make([][]byte, len(msg.TxKeys))
+		for i, key := range msg.TxKeys {
+			txKeys[i] = key[:]
+		}
+		pb = &cmtcons.CompactBlock{
+			Height:             msg.Height,
+			Round:              msg.Round,
+			BlockPartSetHeader: msg.BlockPartSetHeader.ToProto(),
+			Header:             *msg.Header.ToProto(),
+			Evidence:           *evidence,
+			LastCommit:         msg.LastCommit.ToProto(),
+			TxKeys:             txKeys,
+		}
+
+	case *CompactBlockTxsRequestMessage:
+		pb = &cmtcons.CompactBlockTxsRequest{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+		}
+
+	case *CompactBlockTxsMessage:
+		pb = &cmtcons.CompactBlockTxs{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     msg.Txs.ToSliceOfBytes(),
+		}
+
+	case *HasCompactBlockMessage:
+		pb = &cmtcons.HasCompactBlock{
+			Height: msg.Height,
+			Round:  msg.Round,
+		}
+
 	default:
 		return nil, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -225,6 +265,57 @@ func MsgFromProto(p proto.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *cmtcons.CompactBlock:
+		psh, err := types.PartSetHeaderFromProto(&msg.BlockPartSetHeader)
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "BlockPartSetHeader", Err: err}
+		}
+		header, err := types.HeaderFromProto(&msg.Header)
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "Header", Err: err}
+		}
+		var evidence types.EvidenceData
+		if err := evidence.FromProto(&msg.Evidence); err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "Evidence", Err: err}
+		}
+		lastCommit, err := types.CommitFromProto(msg.LastCommit)
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "LastCommit", Err: err}
+		}
+		txKeys := make([]types.TxKey, len(msg.TxKeys))
+		for i, key := range msg.TxKeys {
+			if len(key) != types.TxKeySize {
+				return nil, cmterrors.ErrInvalidField{Field: "TxKeys", Reason: "invalid tx key size"}
+			}
+			copy(txKeys[i][:], key)
+		}
+		pb = &CompactBlockMessage{
+			Height:             msg.Height,
+			Round:              msg.Round,
+			BlockPartSetHeader: *psh,
+			Header:             header,
+			Evidence:           evidence,
+			LastCommit:         lastCommit,
+			TxKeys:             txKeys,
+		}
+	case *cmtcons.CompactBlockTxsRequest:
+		pb = &CompactBlockTxsRequestMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+		}
+	case *cmtcons.CompactBlockTxs:
+		pb = &CompactBlockTxsMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     types.ToTxs(msg.Txs),
+		}
+	case *cmtcons.HasCompactBlock:
+		pb = &HasCompactBlockMessage{
+			Height: msg.Height,
+			Round:  msg.Round,
+		}
 	default:
 		return nil, ErrConsensusMessageNotRecognized{msg}
 	}
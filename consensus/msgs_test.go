@@ -186,6 +186,45 @@ func TestMsgToProto(t *testing.T) {
 
 			false,
 		},
+		{
+			"successful CompactBlockTxsRequest", &CompactBlockTxsRequestMessage{
+				Height:  1,
+				Round:   1,
+				Indexes: []uint32{0, 2},
+			}, &cmtcons.CompactBlockTxsRequest{
+				Height:  1,
+				Round:   1,
+				Indexes: []uint32{0, 2},
+			},
+
+			false,
+		},
+		{
+			"successful CompactBlockTxs", &CompactBlockTxsMessage{
+				Height:  1,
+				Round:   1,
+				Indexes: []uint32{0, 2},
+				Txs:     types.Txs{types.Tx("tx0"), types.Tx("tx2")},
+			}, &cmtcons.CompactBlockTxs{
+				Height:  1,
+				Round:   1,
+				Indexes: []uint32{0, 2},
+				Txs:     [][]byte{[]byte("tx0"), []byte("tx2")},
+			},
+
+			false,
+		},
+		{
+			"successful HasCompactBlock", &HasCompactBlockMessage{
+				Height: 1,
+				Round:  1,
+			}, &cmtcons.HasCompactBlock{
+				Height: 1,
+				Round:  1,
+			},
+
+			false,
+		},
 		{"failure", nil, &cmtcons.Message{}, true},
 	}
 	for _, tt := range testsCases {
@@ -15,6 +15,7 @@ import (
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
 	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -28,6 +29,9 @@ const (
 	DataChannel        = byte(0x21)
 	VoteChannel        = byte(0x22)
 	VoteSetBitsChannel = byte(0x23)
+	// CompactBlockChannel carries the compact block messages. Peers advertising
+	// it support compact blocks, see compact_block.go.
+	CompactBlockChannel = byte(0x24)
 
 	maxMsgSize = 1048576 // 1MB; NOTE/TODO: keep in sync with types.PartSet sizes.
 
@@ -52,6 +56,11 @@ type Reactor struct {
 
 	consensusParams atomic.Pointer[types.ConsensusParams] // copy of latest blocks consensus params
 
+	// compact blocks, see compact_block.go
+	mempool      mempl.Mempool
+	compactMtx   sync.Mutex
+	compactBlock compactBlockCache
+
 	Metrics *Metrics
 }
 
@@ -192,6 +201,14 @@ func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			RecvMessageCapacity: maxMsgSize,
 			MessageType:         &cmtcons.Message{},
 		},
+		{
+			ID:                  CompactBlockChannel,
+			Priority:            10,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &cmtcons.Message{},
+		},
 	}
 }
 
@@ -353,6 +370,24 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
 
+	case CompactBlockChannel:
+		if conR.WaitSync() {
+			conR.Logger.Info("Ignoring message received during sync", "msg", msg)
+			return
+		}
+		switch msg := msg.(type) {
+		case *CompactBlockMessage:
+			conR.handleCompactBlock(msg, e.Src, ps)
+		case *CompactBlockTxsRequestMessage:
+			conR.handleCompactBlockTxsRequest(msg, e.Src)
+		case *CompactBlockTxsMessage:
+			conR.handleCompactBlockTxs(msg, e.Src, ps)
+		case *HasCompactBlockMessage:
+			ps.setHasCompactBlock(msg.Height, msg.Round)
+		default:
+			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+		}
+
 	case VoteChannel:
 		if conR.WaitSync() {
 			conR.Logger.Info("Ignoring message received during sync", "msg", msg)
@@ -620,6 +655,29 @@ OUTER_LOOP:
 		rs := conR.getRoundState()
 		prs := ps.GetRoundState()
 
+		// --------------------
+		// Send compact block?
+		// (If compact blocks are enabled, wait for the peer to reconstruct it
+		// before falling back to sending block parts)
+		// --------------------
+
+		conR.handleEarlyCompactBlock(&rs, ps)
+		if conR.compactBlocksEnabled() {
+			if conR.gossipCompactBlock(logger, &rs, ps, prs) {
+				continue OUTER_LOOP
+			}
+			pending, expired := ps.compactBlockPending(rs.Height, rs.Round, conR.conS.config.CompactBlockTimeout)
+			if expired {
+				logger.Debug("Peer didn't reconstruct compact block, sending block parts",
+					"height", rs.Height, "round", rs.Round)
+				conR.Metrics.CompactBlockFallbacks.Add(1)
+			}
+			if pending {
+				time.Sleep(conR.conS.config.PeerGossipSleepDuration)
+				continue OUTER_LOOP
+			}
+		}
+
 		// --------------------
 		// Send block part?
 		// (Note these can match on hash so round doesn't matter)
@@ -1086,6 +1144,11 @@ type PeerState struct {
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
 	Stats *peerStateStats        `json:"stats"`       // Exposed.
+
+	// compact blocks, see compact_block.go
+	compactSent    compactBlockSentState
+	compactPending *compactBlock
+	compactEarly   *CompactBlockMessage
 }
 
 // peerStateStats holds internal statistics for a peer.
@@ -1667,6 +1730,10 @@ func init() {
 	cmtjson.RegisterType(&HasVoteMessage{}, "tendermint/HasVote")
 	cmtjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	cmtjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	cmtjson.RegisterType(&CompactBlockMessage{}, "tendermint/CompactBlock")
+	cmtjson.RegisterType(&CompactBlockTxsRequestMessage{}, "tendermint/CompactBlockTxsRequest")
+	cmtjson.RegisterType(&CompactBlockTxsMessage{}, "tendermint/CompactBlockTxs")
+	cmtjson.RegisterType(&HasCompactBlockMessage{}, "tendermint/HasCompactBlock")
 }
 
 //-------------------------------------
@@ -2013,3 +2080,133 @@ func (m *HasProposalBlockPartMessage) ValidateBasic() error {
 func (m *HasProposalBlockPartMessage) String() string {
 	return fmt.Sprintf("[HasProposalBlockPart PI:%v HR:{%v/%02d}]", m.Index, m.Height, m.Round)
 }
+
+//-------------------------------------
+
+// CompactBlockMessage is sent instead of the parts of a proposed block when
+// compact blocks are enabled. It carries the block with its txs replaced by
+// their keys.
+type CompactBlockMessage struct {
+	Height             int64
+	Round              int32
+	BlockPartSetHeader types.PartSetHeader
+	Header             types.Header
+	Evidence           types.EvidenceData
+	LastCommit         *types.Commit
+	TxKeys             []types.TxKey
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if err := m.BlockPartSetHeader.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "BlockPartSetHeader", Err: err}
+	}
+	if m.BlockPartSetHeader.IsZero() {
+		return cmterrors.ErrRequiredField{Field: "BlockPartSetHeader"}
+	}
+	if err := m.Header.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "Header", Err: err}
+	}
+	if m.Header.Height != m.Height {
+		return cmterrors.ErrInvalidField{Field: "Header", Reason: "height doesn't match the message height"}
+	}
+	if m.LastCommit == nil {
+		return cmterrors.ErrRequiredField{Field: "LastCommit"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockMessage) String() string {
+	return fmt.Sprintf("[CompactBlock H:%v R:%v PSH:%v Txs:%v]", m.Height, m.Round, m.BlockPartSetHeader, len(m.TxKeys))
+}
+
+//-------------------------------------
+
+// CompactBlockTxsRequestMessage is sent to request the txs of a compact block
+// which are missing from the mempool.
+type CompactBlockTxsRequestMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []uint32
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsRequestMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if len(m.Indexes) == 0 {
+		return cmterrors.ErrRequiredField{Field: "Indexes"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsRequestMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxsRequest H:%v R:%v Txs:%v]", m.Height, m.Round, len(m.Indexes))
+}
+
+//-------------------------------------
+
+// CompactBlockTxsMessage is sent in response to a
+// CompactBlockTxsRequestMessage, with the requested txs that fit in a message.
+type CompactBlockTxsMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []uint32
+	Txs     types.Txs
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if len(m.Indexes) != len(m.Txs) {
+		return cmterrors.ErrInvalidField{Field: "Txs", Reason: "number of txs doesn't match the number of indexes"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxs H:%v R:%v Txs:%v]", m.Height, m.Round, len(m.Txs))
+}
+
+//-------------------------------------
+
+// HasCompactBlockMessage is sent once a compact block has been reconstructed,
+// so that its sender doesn't gossip the parts of the block.
+type HasCompactBlockMessage struct {
+	Height int64
+	Round  int32
+}
+
+// ValidateBasic performs basic validation.
+func (m *HasCompactBlockMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *HasCompactBlockMessage) String() string {
+	return fmt.Sprintf("[HasCompactBlock H:%v R:%v]", m.Height, m.Round)
+}
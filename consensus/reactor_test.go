@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/generic"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -50,6 +52,16 @@ func startConsensusNet(t *testing.T, css []*State, n int) (
 	[]*Reactor,
 	[]types.Subscription,
 	[]*types.EventBus,
+) {
+	return startConsensusNetWithOptions(t, css, n, func(int) []ReactorOption { return nil })
+}
+
+// startConsensusNetWithOptions starts a consensus network, creating the i-th
+// reactor with the options returned by reactorOpts(i).
+func startConsensusNetWithOptions(t *testing.T, css []*State, n int, reactorOpts func(i int) []ReactorOption) (
+	[]*Reactor,
+	[]types.Subscription,
+	[]*types.EventBus,
 ) {
 	reactors := make([]*Reactor, n)
 	blocksSubs := make([]types.Subscription, 0)
@@ -57,7 +69,7 @@ func startConsensusNet(t *testing.T, css []*State, n int) (
 	for i := 0; i < n; i++ {
 		/*logger, err := cmtflags.ParseLogLevel("consensus:info,*:error", logger, "info")
 		if err != nil {	t.Fatal(err)}*/
-		reactors[i] = NewReactor(css[i], true) // so we dont start the consensus states
+		reactors[i] = NewReactor(css[i], true, reactorOpts(i)...) // so we dont start the consensus states
 		reactors[i].SetLogger(css[i].Logger)
 
 		// eventBus is already started with the cs
@@ -436,6 +448,61 @@ func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	assert.Equal(t, true, ps.BlockPartsSent() > 0, "number of votes sent should have increased")
 }
 
+// Ensure blocks are gossiped as compact blocks, fetching the txs missing from
+// mempools, and that peers which can't reconstruct them still get the blocks.
+func TestReactorCompactBlocks(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(t, N, "consensus_reactor_test", newMockTickerFunc(true), newKVStore,
+		func(c *cfg.Config) {
+			c.Consensus.CompactBlocks = true
+		})
+	defer cleanup()
+
+	csMetrics := NopMetrics()
+	csMetrics.CompactBlocksSent = sharedCounter{generic.NewCounter("compact_blocks_sent")}
+	csMetrics.CompactBlocksReceived = sharedCounter{generic.NewCounter("compact_blocks_received")}
+	csMetrics.CompactBlockMissingTxs = sharedCounter{generic.NewCounter("compact_block_missing_txs")}
+	reactors, blocksSubs, eventBuses := startConsensusNetWithOptions(t, css, N, func(i int) []ReactorOption {
+		opts := []ReactorOption{ReactorMetrics(csMetrics)}
+		// the last node can't reconstruct compact blocks
+		if i < N-1 {
+			opts = append(opts, ReactorMempool(assertMempool(css[i].txNotifier)))
+		}
+		return opts
+	})
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	// all nodes have the first tx, only the first node has the second one
+	txs := [][]byte{kvstore.NewTxFromID(1), kvstore.NewTxFromID(2)}
+	for i := 0; i < N; i++ {
+		err := assertMempool(css[i].txNotifier).CheckTx(txs[0], nil, mempl.TxInfo{})
+		require.NoError(t, err)
+	}
+	err := assertMempool(css[0].txNotifier).CheckTx(txs[1], nil, mempl.TxInfo{})
+	require.NoError(t, err)
+
+	timeoutWaitGroup(N, func(j int) {
+		committed := 0
+		for committed < len(txs) {
+			msg := <-blocksSubs[j].Out()
+			committed += len(msg.Data().(types.EventDataNewBlock).Block.Txs)
+		}
+	})
+
+	assert.Positive(t, csMetrics.CompactBlocksSent.(sharedCounter).Value())
+	assert.Positive(t, csMetrics.CompactBlocksReceived.(sharedCounter).Value())
+	assert.Positive(t, csMetrics.CompactBlockMissingTxs.(sharedCounter).Value())
+}
+
+// sharedCounter is a counter whose label values share its value.
+type sharedCounter struct {
+	*generic.Counter
+}
+
+func (c sharedCounter) With(...string) metrics.Counter {
+	return c
+}
+
 //-------------------------------------------------------------
 // ensure we can make blocks despite cycling a validator set
 
@@ -284,18 +284,22 @@ func (pb *playback) replayConsoleLoop() int {
 // convenience for replay mode
 func newConsensusStateForReplay(config cfg.BaseConfig, csConfig *cfg.ConsensusConfig) *State {
 	dbType := dbm.BackendType(config.DBBackend)
-	// Get BlockStore
-	blockStoreDB, err := dbm.NewDB("blockstore", dbType, config.DBDir())
+	// Get BlockStore. The key layout of an existing block store is kept, and
+	// no block is moved to its cold store.
+	blockStore, err := store.LoadBlockStore(&cfg.Config{BaseConfig: config, Storage: cfg.DefaultStorageConfig()},
+		cfg.DefaultDBProvider, log.NewNopLogger())
 	if err != nil {
 		cmtos.Exit(err.Error())
 	}
-	blockStore := store.NewBlockStore(blockStoreDB)
 
 	// Get State
 	stateDB, err := dbm.NewDB("state", dbType, config.DBDir())
 	if err != nil {
 		cmtos.Exit(err.Error())
 	}
+	if _, err := sm.MigrateDB(stateDB, log.NewNopLogger()); err != nil {
+		cmtos.Exit(err.Error())
+	}
 	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
 		DiscardABCIResponses: false,
 	})
@@ -133,6 +133,12 @@ type State struct {
 	// for reporting metrics
 	metrics *Metrics
 
+	// timings of the steps, proposals and votes of the latest heights
+	timings *timingHistory
+
+	// durations of the timeouts, possibly learnt from the timings
+	timeouts *timeouts
+
 	// offline state sync height indicating to which height the node synced offline
 	offlineStateSyncHeight int64
 }
@@ -165,6 +171,8 @@ func NewState(
 		evpool:           evpool,
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		timings:          newTimingHistory(),
+		timeouts:         newTimeouts(config),
 	}
 	for _, option := range options {
 		option(cs)
@@ -256,6 +264,15 @@ func (cs *State) getRoundState() cstypes.RoundState {
 	return cs.RoundState // copy
 }
 
+// GetTimings returns the timings of the steps, proposals and votes of the
+// latest heights, oldest first. The last one is the height in progress.
+// This function is thread-safe.
+func (cs *State) GetTimings() []cstypes.HeightTiming {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.timings.heights()
+}
+
 // GetRoundStateJSON returns a json of RoundState.
 func (cs *State) GetRoundStateJSON() ([]byte, error) {
 	cs.mtx.RLock()
@@ -545,6 +562,7 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 		if cs.Step != step {
 			cs.metrics.MarkStep(cs.Step)
 		}
+		cs.timings.enterStep(cs.Height, round, step, cmttime.Now())
 	}
 	cs.Round = round
 	cs.Step = step
@@ -716,6 +734,11 @@ func (cs *State) updateToState(state sm.State) {
 	// RoundState fields
 	cs.updateHeight(height)
 	cs.updateRoundStep(0, cstypes.RoundStepNewHeight)
+	cs.timeouts.setParams(state.ConsensusParams.Timeout)
+	if !cs.replayMode {
+		cs.timeouts.learn(cs.timings.pastHeights())
+		cs.metrics.MarkTimeouts(cs.timeouts)
+	}
 
 	if cs.CommitTime.IsZero() {
 		// "Now" makes it easier to sync up dev nodes.
@@ -723,9 +746,9 @@ func (cs *State) updateToState(state sm.State) {
 		// to be gathered for the first block.
 		// And alternative solution that relies on clocks:
 		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
-		cs.StartTime = cs.config.Commit(cmttime.Now())
+		cs.StartTime = cs.timeouts.Commit(cmttime.Now())
 	} else {
-		cs.StartTime = cs.config.Commit(cs.CommitTime)
+		cs.StartTime = cs.timeouts.Commit(cs.CommitTime)
 	}
 
 	cs.Validators = validators
@@ -1192,7 +1215,7 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.timeouts.Propose(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1458,7 +1481,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.timeouts.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1617,7 +1640,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.timeouts.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block
@@ -1973,6 +1996,12 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 		cs.ProposalBlockParts = types.NewPartSetFromHeader(proposal.BlockID.PartSetHeader)
 	}
 
+	if !cs.replayMode {
+		if latency, ok := cs.timings.proposal(proposal.Round, cmttime.Now()); ok {
+			cs.metrics.ProposalLatencySeconds.Observe(latency.Seconds())
+		}
+	}
+
 	cs.Logger.Info("received proposal", "proposal", proposal, "proposer", pubKey.Address())
 	return nil
 }
@@ -2047,6 +2076,9 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 		}
 
 		cs.ProposalBlock = block
+		if !cs.replayMode && cs.Proposal != nil {
+			cs.timings.proposalBlock(cs.Proposal.Round, cmttime.Now())
+		}
 
 		// NOTE: it's possible to receive complete proposal blocks for future rounds without having the proposal
 		cs.Logger.Info("received complete proposal block", "height", cs.ProposalBlock.Height, "hash", cs.ProposalBlock.Hash())
@@ -2145,6 +2177,17 @@ func (cs *State) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, error) {
 	return added, nil
 }
 
+// recordVoteTiming records when the vote was received, and its latency
+// relative to the start of its round.
+func (cs *State) recordVoteTiming(vote *types.Vote) {
+	if cs.replayMode {
+		return
+	}
+	if latency, ok := cs.timings.vote(vote, cmttime.Now()); ok {
+		cs.metrics.MarkVoteLatency(vote, latency)
+	}
+}
+
 func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error) {
 	cs.Logger.Debug(
 		"Adding vote",
@@ -2179,6 +2222,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			return added, err
 		}
 
+		cs.recordVoteTiming(vote)
 		cs.Logger.Debug("added vote to last precommits", "last_commit", cs.LastCommit.StringShort())
 		if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
 			return added, err
@@ -2265,6 +2309,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		}
 		return added, err
 	}
+	cs.recordVoteTiming(vote)
 	if vote.Round == cs.Round {
 		vals := cs.state.Validators
 		_, val := vals.GetByIndex(vote.ValidatorIndex)
@@ -20,6 +20,10 @@ type IngestCandidate struct {
 
 	blockValidator func(state.State, *types.Block) error
 
+	// set if the caller verified the commits ahead, see SetCommitsVerified
+	commitsVerified    bool
+	verifiedCommitVote *types.VoteSet
+
 	// fields that are set only after successful verification
 	verified      bool
 	commitRound   int32
@@ -121,6 +125,20 @@ func (ic *IngestCandidate) ValidateBasic() error {
 	}
 }
 
+// SetCommitsVerified marks the commit and the extended commit as verified by the
+// caller against the validator set whose hash is the block's ValidatorsHash,
+// e.g. by workers ahead of execution. voteSet is the vote set of the extended
+// commit if present, or of the commit, built with that validator set; see
+// types.ExtendedCommit.ToExtendedVoteSet and types.Commit.ToVoteSet.
+//
+// Verify then skips verifying their signatures and building the vote set: it
+// still validates the block against the state, which ensures that the block's
+// ValidatorsHash is the hash of the state's validators.
+func (ic *IngestCandidate) SetCommitsVerified(voteSet *types.VoteSet) {
+	ic.commitsVerified = true
+	ic.verifiedCommitVote = voteSet
+}
+
 // Verify verifies the block against provided state using light client verification.
 func (ic *IngestCandidate) Verify(state state.State) error {
 	var (
@@ -140,9 +158,11 @@ func (ic *IngestCandidate) Verify(state state.State) error {
 
 	// Fully verify ic.commit (the next block's LastCommit) to ensure all
 	// signatures are valid.
-	err := state.Validators.VerifyCommit(chainID, blockID, height, ic.commit)
-	if err != nil {
-		return fmt.Errorf("verify commit: %w", err)
+	if !ic.commitsVerified {
+		err := state.Validators.VerifyCommit(chainID, blockID, height, ic.commit)
+		if err != nil {
+			return fmt.Errorf("verify commit: %w", err)
+		}
 	}
 
 	// validate block
@@ -152,21 +172,23 @@ func (ic *IngestCandidate) Verify(state state.State) error {
 
 	// verify commit extensions
 	if ic.extensionsEnabled() {
-		if err = ic.extCommit.EnsureExtensions(true); err != nil {
+		if err := ic.extCommit.EnsureExtensions(true); err != nil {
 			return fmt.Errorf("ensure extensions: %w", err)
 		}
 
 		// if extensions are enabled, we must fully verify the commit since it
 		// is not validated within ValidateBlock but it will be written to the
 		// store.
-		err = state.Validators.VerifyCommit(chainID, blockID, height, ic.extCommit.ToCommit())
-		if err != nil {
-			return fmt.Errorf("verify extended commit: %w", err)
+		if !ic.commitsVerified {
+			err := state.Validators.VerifyCommit(chainID, blockID, height, ic.extCommit.ToCommit())
+			if err != nil {
+				return fmt.Errorf("verify extended commit: %w", err)
+			}
 		}
 	}
 
-	// build commit vote set
-	round, voteSet, err := buildCommitVoteSet(state, ic)
+	// build commit vote set, unless built with the commits verified ahead
+	round, voteSet, err := ic.commitVotes(state)
 	if err != nil {
 		return fmt.Errorf("commit voting: %w", err)
 	}
@@ -314,6 +336,29 @@ func (cs *State) ingestBlock(ic IngestCandidate) error {
 	return nil
 }
 
+// commitVotes returns the commit round and vote set, reusing the vote set given to SetCommitsVerified if any.
+func (ic *IngestCandidate) commitVotes(state state.State) (int32, *types.VoteSet, error) {
+	voteSet := ic.verifiedCommitVote
+	if !ic.commitsVerified || voteSet == nil {
+		return buildCommitVoteSet(state, ic)
+	}
+
+	height, round := ic.commit.Height, ic.commit.Round
+	if ic.extensionsEnabled() {
+		height, round = ic.extCommit.Height, ic.extCommit.Round
+	}
+	blockID, ok := voteSet.TwoThirdsMajority()
+	switch {
+	case voteSet.GetHeight() != height || voteSet.GetRound() != round:
+		return 0, nil, fmt.Errorf("vote set height %d round %d doesn't match the commit's %d/%d",
+			voteSet.GetHeight(), voteSet.GetRound(), height, round)
+	case !voteSet.IsCommit() || !ok || !blockID.Equals(ic.BlockID()):
+		return 0, nil, errors.New("vote set has no +2/3 majority for the block")
+	}
+
+	return round, voteSet, nil
+}
+
 // buildCommitVoteSet returns the commit round and vote set for the verified block.
 func buildCommitVoteSet(state state.State, ic *IngestCandidate) (round int32, voteSet *types.VoteSet, err error) {
 	var (
@@ -290,6 +290,36 @@ func TestIngestCandidate(t *testing.T) {
 				},
 				errContains: "verify commit",
 			},
+			{
+				name:           "commits verified ahead",
+				voteExtensions: true,
+				mutate: func(t *testing.T, ic *IngestCandidate, st *sm.State) {
+					voteSet := ic.extCommit.ToExtendedVoteSet(st.ChainID, st.Validators)
+					ic.SetCommitsVerified(voteSet)
+
+					// the signatures are not verified again
+					ic.extCommit.ExtendedSignatures[0].Signature = nil
+					ic.commit.Signatures[0].Signature = nil
+				},
+			},
+			{
+				name:           "commits verified ahead with another vote set",
+				voteExtensions: false,
+				mutate: func(t *testing.T, ic *IngestCandidate, st *sm.State) {
+					voteSet := types.NewVoteSet(st.ChainID, ic.Height(), ic.commit.Round, cmtproto.PrecommitType, st.Validators)
+					ic.SetCommitsVerified(voteSet)
+				},
+				errContains: "commit voting",
+			},
+			{
+				name:           "commits verified ahead with another validator set",
+				voteExtensions: false,
+				mutate: func(t *testing.T, ic *IngestCandidate, st *sm.State) {
+					ic.SetCommitsVerified(ic.commit.ToVoteSet(st.ChainID, st.Validators))
+					st.Validators = types.NewValidatorSet(st.Validators.Copy().Validators[1:])
+				},
+				errContains: "validate block",
+			},
 			{
 				name:           "extended commit missing extension signature",
 				voteExtensions: true,
@@ -446,6 +446,61 @@ func TestStateFullRound2(t *testing.T) {
 	ensureNewBlock(newBlockCh, height)
 }
 
+// the steps, the proposal and the votes of a height are recorded in its timings
+func TestStateTimings(t *testing.T) {
+	cs1, vss := randState(2)
+	vs2 := vss[1]
+	height, round := cs1.Height, cs1.Round
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs1, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartSetHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+
+	signAddVotes(cs1, cmtproto.PrevoteType, propBlockHash, propPartSetHeader, false, vs2)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, cmtproto.PrecommitType, propBlockHash, propPartSetHeader, true, vs2)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	var ht *cstypes.HeightTiming
+	timings := cs1.GetTimings()
+	for i := range timings {
+		if timings[i].Height == height {
+			ht = &timings[i]
+		}
+	}
+	require.NotNil(t, ht)
+
+	assert.EqualValues(t, 1, ht.Rounds)
+	assert.False(t, ht.CommitTime.IsZero())
+	assert.Equal(t, ht.CommitTime.Sub(ht.StartTime), ht.Duration)
+
+	var steps []string
+	for _, st := range ht.Steps {
+		steps = append(steps, st.Step)
+	}
+	assert.Equal(t, []string{"NewHeight", "NewRound", "Propose", "Prevote", "Precommit", "Commit"}, steps)
+
+	require.Len(t, ht.Proposals, 1)
+	assert.Equal(t, round, ht.Proposals[0].Round)
+	assert.NotZero(t, ht.Proposals[0].BlockLatency)
+
+	// both validators prevoted and precommitted
+	votes := map[string]int{}
+	for _, vt := range ht.Votes {
+		assert.Equal(t, round, vt.Round)
+		assert.False(t, vt.AfterCommit)
+		votes[vt.Type]++
+	}
+	assert.Equal(t, map[string]int{"prevote": 2, "precommit": 2}, votes)
+}
+
 //------------------------------------------------------------------------------------------
 // LockSuite
 
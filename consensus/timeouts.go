@@ -0,0 +1,180 @@
+package consensus
+
+import (
+	"slices"
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// minAdaptiveTimeoutSamples is the number of samples required to learn a
+	// timeout. Until then, the timeout of the config is used.
+	minAdaptiveTimeoutSamples = 10
+	// adaptiveTimeoutQuantile is the quantile of the samples a timeout is
+	// learnt from.
+	adaptiveTimeoutQuantile = 0.9
+	// adaptiveTimeoutFactor is the margin applied to the learnt quantile.
+	adaptiveTimeoutFactor = 1.5
+)
+
+// timeouts chooses the durations of the timeouts of the consensus steps. Each
+// timeout is, by order of precedence:
+//   - the one of the consensus params, if set on chain;
+//   - if adaptive timeouts are enabled, the one learnt from the timings of the
+//     latest heights, bounded by the config;
+//   - the static one of the config.
+//
+// The learnt timeouts only change once per height, and never in replay mode.
+// NOTE: Not thread safe. Should only be manipulated by functions downstream
+// of the cs.receiveRoutine.
+type timeouts struct {
+	config *cfg.ConsensusConfig
+	// timeouts set on chain, zero if not set
+	params types.TimeoutParams
+
+	// learnt timeouts at round 0, zero if not learnt
+	propose   time.Duration
+	prevote   time.Duration
+	precommit time.Duration
+	commit    time.Duration
+}
+
+func newTimeouts(config *cfg.ConsensusConfig) *timeouts {
+	return &timeouts{config: config}
+}
+
+// setParams sets the timeouts of the consensus params of the current height.
+func (to *timeouts) setParams(params types.TimeoutParams) {
+	to.params = params
+}
+
+// Propose returns the amount of time to wait for a proposal.
+func (to *timeouts) Propose(round int32) time.Duration {
+	return firstSet(to.params.Propose, to.propose, to.config.TimeoutPropose) +
+		firstSet(to.params.ProposeDelta, to.config.TimeoutProposeDelta)*time.Duration(round)
+}
+
+// Prevote returns the amount of time to wait for straggler votes after
+// receiving any +2/3 prevotes.
+func (to *timeouts) Prevote(round int32) time.Duration {
+	return firstSet(to.params.Vote, to.prevote, to.config.TimeoutPrevote) +
+		firstSet(to.params.VoteDelta, to.config.TimeoutPrevoteDelta)*time.Duration(round)
+}
+
+// Precommit returns the amount of time to wait for straggler votes after
+// receiving any +2/3 precommits.
+func (to *timeouts) Precommit(round int32) time.Duration {
+	return firstSet(to.params.Vote, to.precommit, to.config.TimeoutPrecommit) +
+		firstSet(to.params.VoteDelta, to.config.TimeoutPrecommitDelta)*time.Duration(round)
+}
+
+// Commit returns the time to start the next height at, after committing a
+// block at t.
+func (to *timeouts) Commit(t time.Time) time.Time {
+	return t.Add(to.commitTimeout())
+}
+
+// commitTimeout returns the amount of time to wait for straggler precommits
+// after committing a block.
+func (to *timeouts) commitTimeout() time.Duration {
+	return firstSet(to.params.Commit, to.commit, to.config.TimeoutCommit)
+}
+
+// firstSet returns the first non-zero timeout, or zero if none is set.
+func firstSet(timeouts ...time.Duration) time.Duration {
+	for _, timeout := range timeouts {
+		if timeout != 0 {
+			return timeout
+		}
+	}
+	return 0
+}
+
+// learn learns the timeouts from the timings of the given past heights, oldest
+// first. The timeouts for which there are not enough samples are reset to the
+// ones of the config.
+//
+// The timeouts are learnt from the following samples:
+//   - propose: the time elapsed between the start of a round and the reception
+//     of its complete proposal block.
+//   - prevote and precommit: the time elapsed between the receptions of the
+//     first and the last votes of a round.
+//   - commit: the time elapsed between the commit of a height and the
+//     reception of its last precommit.
+func (to *timeouts) learn(heights []*cstypes.HeightTiming) {
+	if !to.config.AdaptiveTimeouts {
+		return
+	}
+
+	var proposals, prevotes, precommits, commits []time.Duration
+	for i, ht := range heights {
+		for _, pt := range ht.Proposals {
+			if pt.BlockLatency > 0 {
+				proposals = append(proposals, pt.BlockLatency)
+			}
+		}
+		for round := int32(0); round < ht.Rounds; round++ {
+			if spread, ok := voteSpread(ht, round, types.SignedMsgTypeToShortString(cmtproto.PrevoteType)); ok {
+				prevotes = append(prevotes, spread)
+			}
+			if spread, ok := voteSpread(ht, round, types.SignedMsgTypeToShortString(cmtproto.PrecommitType)); ok {
+				precommits = append(precommits, spread)
+			}
+		}
+		// the latest height may still receive precommits
+		if i < len(heights)-1 && !ht.CommitTime.IsZero() {
+			var late time.Duration
+			for _, vt := range ht.Votes {
+				if vt.AfterCommit {
+					late = max(late, vt.ReceiveTime.Sub(ht.CommitTime))
+				}
+			}
+			commits = append(commits, late)
+		}
+	}
+
+	to.propose = learnTimeout(proposals, to.config.TimeoutProposeMin, to.config.TimeoutProposeMax)
+	to.prevote = learnTimeout(prevotes, to.config.TimeoutVoteMin, to.config.TimeoutVoteMax)
+	to.precommit = learnTimeout(precommits, to.config.TimeoutVoteMin, to.config.TimeoutVoteMax)
+	to.commit = learnTimeout(commits, to.config.TimeoutCommitMin, to.config.TimeoutCommitMax)
+}
+
+// voteSpread returns the time elapsed between the receptions of the first and
+// the last votes of the given type and round, received before the commit.
+func voteSpread(ht *cstypes.HeightTiming, round int32, voteType string) (time.Duration, bool) {
+	var first, last time.Time
+	n := 0
+	for _, vt := range ht.Votes {
+		if vt.Round != round || vt.Type != voteType || vt.AfterCommit {
+			continue
+		}
+		if n == 0 || vt.ReceiveTime.Before(first) {
+			first = vt.ReceiveTime
+		}
+		if n == 0 || vt.ReceiveTime.After(last) {
+			last = vt.ReceiveTime
+		}
+		n++
+	}
+	if n < 2 {
+		return 0, false
+	}
+	return last.Sub(first), true
+}
+
+// learnTimeout returns a margin over the quantile of the samples, bounded by
+// minimum and maximum, or zero if there are not enough samples.
+func learnTimeout(samples []time.Duration, minimum, maximum time.Duration) time.Duration {
+	if len(samples) < minAdaptiveTimeoutSamples {
+		return 0
+	}
+	slices.Sort(samples)
+	q := samples[int(float64(len(samples)-1)*adaptiveTimeoutQuantile)]
+	timeout := time.Duration(float64(q) * adaptiveTimeoutFactor)
+	// a zero timeout stands for the one of the config
+	return max(min(timeout, maximum), minimum, 1)
+}
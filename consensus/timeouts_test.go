@@ -0,0 +1,107 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestTimeoutsLearn(t *testing.T) {
+	config := cfg.TestConsensusConfig()
+	config.TimeoutProposeMin = 100 * time.Millisecond
+	config.TimeoutProposeMax = time.Second
+	config.TimeoutVoteMin = 10 * time.Millisecond
+	config.TimeoutVoteMax = 500 * time.Millisecond
+	config.TimeoutCommitMin = 5 * time.Millisecond
+	config.TimeoutCommitMax = time.Second
+	to := newTimeouts(config)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	makeHeights := func(n int, blockLatency, voteSpread, lateness time.Duration) []*cstypes.HeightTiming {
+		heights := make([]*cstypes.HeightTiming, n)
+		for i := range heights {
+			commitTime := start.Add(time.Second)
+			heights[i] = &cstypes.HeightTiming{
+				Height:     int64(i + 1),
+				CommitTime: commitTime,
+				Rounds:     1,
+				Proposals:  []cstypes.ProposalTiming{{BlockLatency: blockLatency}},
+				Votes: []cstypes.VoteTiming{
+					{Type: "prevote", ReceiveTime: start},
+					{Type: "prevote", ReceiveTime: start.Add(voteSpread)},
+					{Type: "precommit", ReceiveTime: start},
+					{Type: "precommit", ReceiveTime: start.Add(2 * voteSpread)},
+					{Type: "precommit", ReceiveTime: commitTime.Add(lateness), AfterCommit: true},
+				},
+			}
+		}
+		return heights
+	}
+
+	// disabled
+	to.learn(makeHeights(20, 200*time.Millisecond, 20*time.Millisecond, 30*time.Millisecond))
+	assert.Equal(t, config.Propose(1), to.Propose(1))
+	assert.Equal(t, config.Prevote(0), to.Prevote(0))
+	assert.Equal(t, config.Commit(start), to.Commit(start))
+
+	// enabled
+	config.AdaptiveTimeouts = true
+	to.learn(makeHeights(20, 200*time.Millisecond, 20*time.Millisecond, 30*time.Millisecond))
+	assert.Equal(t, 300*time.Millisecond, to.Propose(0))
+	assert.Equal(t, 300*time.Millisecond+config.TimeoutProposeDelta, to.Propose(1))
+	assert.Equal(t, 30*time.Millisecond, to.Prevote(0))
+	assert.Equal(t, 60*time.Millisecond, to.Precommit(0))
+	assert.Equal(t, start.Add(45*time.Millisecond), to.Commit(start))
+
+	// bounded
+	to.learn(makeHeights(20, time.Millisecond, time.Second, 0))
+	assert.Equal(t, config.TimeoutProposeMin, to.Propose(0))
+	assert.Equal(t, config.TimeoutVoteMax, to.Prevote(0))
+	assert.Equal(t, config.TimeoutVoteMax, to.Precommit(0))
+	assert.Equal(t, start.Add(config.TimeoutCommitMin), to.Commit(start))
+
+	// not enough samples
+	to.learn(makeHeights(minAdaptiveTimeoutSamples-1, time.Millisecond, time.Second, 0))
+	assert.Equal(t, config.Propose(0), to.Propose(0))
+	assert.Equal(t, config.Prevote(0), to.Prevote(0))
+	assert.Equal(t, config.Precommit(0), to.Precommit(0))
+	assert.Equal(t, config.Commit(start), to.Commit(start))
+}
+
+func TestTimeoutsParams(t *testing.T) {
+	config := cfg.TestConsensusConfig()
+	to := newTimeouts(config)
+	to.propose, to.prevote, to.precommit, to.commit = time.Second, time.Second, time.Second, time.Second
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// learnt
+	assert.Equal(t, time.Second+config.TimeoutProposeDelta, to.Propose(1))
+	assert.Equal(t, time.Second+config.TimeoutPrevoteDelta, to.Prevote(1))
+	assert.Equal(t, time.Second+config.TimeoutPrecommitDelta, to.Precommit(1))
+	assert.Equal(t, start.Add(time.Second), to.Commit(start))
+
+	// set on chain
+	to.setParams(types.TimeoutParams{
+		Propose:      2 * time.Second,
+		ProposeDelta: 200 * time.Millisecond,
+		Vote:         3 * time.Second,
+		VoteDelta:    300 * time.Millisecond,
+		Commit:       4 * time.Second,
+	})
+	assert.Equal(t, 2200*time.Millisecond, to.Propose(1))
+	assert.Equal(t, 3300*time.Millisecond, to.Prevote(1))
+	assert.Equal(t, 3300*time.Millisecond, to.Precommit(1))
+	assert.Equal(t, start.Add(4*time.Second), to.Commit(start))
+
+	// partially set on chain
+	to.setParams(types.TimeoutParams{Vote: 3 * time.Second})
+	assert.Equal(t, time.Second+config.TimeoutProposeDelta, to.Propose(1))
+	assert.Equal(t, 3*time.Second+config.TimeoutPrevoteDelta, to.Prevote(1))
+	assert.Equal(t, 3*time.Second+config.TimeoutPrecommitDelta, to.Precommit(1))
+	assert.Equal(t, start.Add(time.Second), to.Commit(start))
+}
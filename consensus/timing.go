@@ -0,0 +1,181 @@
+package consensus
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// timingHistorySize is the number of past heights whose timings are kept.
+const timingHistorySize = 100
+
+// timingHistory records the timings of the height in progress, and keeps those
+// of the latest heights in a ring buffer.
+// NOTE: Not thread safe. Should only be manipulated by functions downstream
+// of the cs.receiveRoutine, and read while holding cs.mtx.
+type timingHistory struct {
+	current *cstypes.HeightTiming
+	past    []*cstypes.HeightTiming
+	next    int
+}
+
+func newTimingHistory() *timingHistory {
+	return &timingHistory{past: make([]*cstypes.HeightTiming, 0, timingHistorySize)}
+}
+
+// enterStep records that the given step was entered at the given height and
+// round, ending the previous step. A new height ends the previous height.
+func (th *timingHistory) enterStep(height int64, round int32, step cstypes.RoundStepType, now time.Time) {
+	if th.current == nil || th.current.Height != height {
+		th.endHeight(now)
+		th.current = &cstypes.HeightTiming{Height: height, StartTime: now}
+	}
+
+	ht := th.current
+	stepName := strings.TrimPrefix(step.String(), "RoundStep")
+	if n := len(ht.Steps); n > 0 {
+		last := &ht.Steps[n-1]
+		if last.Round == round && last.Step == stepName {
+			return
+		}
+		last.Duration = now.Sub(last.Start)
+	}
+	ht.Steps = append(ht.Steps, cstypes.StepTiming{Round: round, Step: stepName, Start: now})
+	if round+1 > ht.Rounds {
+		ht.Rounds = round + 1
+	}
+	if step == cstypes.RoundStepCommit {
+		ht.CommitTime = now
+		ht.Duration = now.Sub(ht.StartTime)
+	}
+}
+
+// endHeight moves the height in progress to the past heights.
+func (th *timingHistory) endHeight(now time.Time) {
+	ht := th.current
+	if ht == nil {
+		return
+	}
+	if n := len(ht.Steps); n > 0 && ht.Steps[n-1].Duration == 0 {
+		ht.Steps[n-1].Duration = now.Sub(ht.Steps[n-1].Start)
+	}
+
+	if len(th.past) < timingHistorySize {
+		th.past = append(th.past, ht)
+	} else {
+		th.past[th.next] = ht
+	}
+	th.next = (th.next + 1) % timingHistorySize
+	th.current = nil
+}
+
+// proposal records that the proposal of the given round was received, and
+// returns its latency if the round started.
+func (th *timingHistory) proposal(round int32, now time.Time) (time.Duration, bool) {
+	if th.current == nil {
+		return 0, false
+	}
+	latency, ok := roundLatency(th.current, round, now)
+	th.current.Proposals = append(th.current.Proposals, cstypes.ProposalTiming{
+		Round:       round,
+		ReceiveTime: now,
+		Latency:     latency,
+	})
+	return latency, ok
+}
+
+// proposalBlock records that the block of the proposal of the given round was
+// completely received.
+func (th *timingHistory) proposalBlock(round int32, now time.Time) {
+	if th.current == nil {
+		return
+	}
+	for i := range th.current.Proposals {
+		pt := &th.current.Proposals[i]
+		if pt.Round == round && pt.BlockLatency == 0 {
+			pt.BlockLatency, _ = roundLatency(th.current, round, now)
+			return
+		}
+	}
+}
+
+// vote records that the given vote was received, and returns its latency if
+// its round started. The precommits of the previous height are recorded as
+// received after the commit.
+func (th *timingHistory) vote(vote *types.Vote, now time.Time) (time.Duration, bool) {
+	ht, afterCommit := th.current, false
+	if ht == nil || ht.Height != vote.Height {
+		ht, afterCommit = th.last(), true
+	}
+	if ht == nil || ht.Height != vote.Height {
+		return 0, false
+	}
+
+	latency, ok := roundLatency(ht, vote.Round, now)
+	ht.Votes = append(ht.Votes, cstypes.VoteTiming{
+		ValidatorAddress: vote.ValidatorAddress,
+		Round:            vote.Round,
+		Type:             types.SignedMsgTypeToShortString(vote.Type),
+		ReceiveTime:      now,
+		Latency:          latency,
+		AfterCommit:      afterCommit,
+	})
+	return latency, ok
+}
+
+// last returns the latest past height, if any.
+func (th *timingHistory) last() *cstypes.HeightTiming {
+	if len(th.past) == 0 {
+		return nil
+	}
+	return th.past[(th.next+timingHistorySize-1)%timingHistorySize]
+}
+
+// pastHeights returns the timings of the past heights, oldest first.
+func (th *timingHistory) pastHeights() []*cstypes.HeightTiming {
+	start := 0
+	if len(th.past) == timingHistorySize {
+		start = th.next
+	}
+	heights := make([]*cstypes.HeightTiming, len(th.past))
+	for i := range th.past {
+		heights[i] = th.past[(start+i)%len(th.past)]
+	}
+	return heights
+}
+
+// heights returns a copy of the timings of the past heights, oldest first,
+// followed by the height in progress.
+func (th *timingHistory) heights() []cstypes.HeightTiming {
+	heights := make([]cstypes.HeightTiming, 0, len(th.past)+1)
+	appendCopy := func(ht *cstypes.HeightTiming) {
+		c := *ht
+		c.Steps = slices.Clone(ht.Steps)
+		c.Proposals = slices.Clone(ht.Proposals)
+		c.Votes = slices.Clone(ht.Votes)
+		heights = append(heights, c)
+	}
+
+	for _, ht := range th.pastHeights() {
+		appendCopy(ht)
+	}
+	if th.current != nil {
+		appendCopy(th.current)
+	}
+	return heights
+}
+
+// roundLatency returns the time elapsed between the start of the given round
+// and now, if the round started.
+func roundLatency(ht *cstypes.HeightTiming, round int32, now time.Time) (time.Duration, bool) {
+	newRound := strings.TrimPrefix(cstypes.RoundStepNewRound.String(), "RoundStep")
+	for _, st := range ht.Steps {
+		if st.Round == round && st.Step == newRound {
+			return now.Sub(st.Start), true
+		}
+	}
+	return 0, false
+}
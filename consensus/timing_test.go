@@ -0,0 +1,65 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestTimingHistory(t *testing.T) {
+	th := newTimingHistory()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tick := func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	for h := int64(1); h <= timingHistorySize+10; h++ {
+		th.enterStep(h, 0, cstypes.RoundStepNewHeight, tick())
+		th.enterStep(h, 0, cstypes.RoundStepNewRound, tick())
+		th.enterStep(h, 0, cstypes.RoundStepNewRound, tick()) // ignored
+		latency, ok := th.proposal(0, tick())
+		require.True(t, ok)
+		assert.Equal(t, 2*time.Second, latency)
+		th.proposalBlock(0, tick())
+		th.enterStep(h, 0, cstypes.RoundStepCommit, tick())
+
+		// a precommit of the previous height
+		vote := &types.Vote{Type: cmtproto.PrecommitType, Height: h - 1, ValidatorAddress: []byte{1}}
+		_, ok = th.vote(vote, tick())
+		assert.Equal(t, h > 1, ok)
+	}
+
+	heights := th.heights()
+	require.Len(t, heights, timingHistorySize+1)
+	for i, ht := range heights {
+		assert.EqualValues(t, i+10, ht.Height)
+	}
+
+	// past heights end with the next one
+	ht := heights[timingHistorySize-1]
+	require.Len(t, ht.Steps, 3)
+	for _, st := range ht.Steps {
+		assert.NotZero(t, st.Duration)
+	}
+	assert.Equal(t, 5*time.Second, ht.Duration)
+	assert.Equal(t, []cstypes.ProposalTiming{{
+		ReceiveTime:  ht.Steps[1].Start.Add(2 * time.Second),
+		Latency:      2 * time.Second,
+		BlockLatency: 3 * time.Second,
+	}}, ht.Proposals)
+	require.Len(t, ht.Votes, 1)
+	assert.True(t, ht.Votes[0].AfterCommit)
+	assert.Equal(t, "precommit", ht.Votes[0].Type)
+
+	// the height in progress
+	ht = heights[timingHistorySize]
+	assert.Zero(t, ht.Steps[len(ht.Steps)-1].Duration)
+	assert.Empty(t, ht.Votes)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+// HeightTiming records how long the consensus steps took at a height, and
+// when the proposal and the votes of the validators were received.
+// Durations and latencies are measured with the local clock.
+type HeightTiming struct {
+	Height    int64     `json:"height"`
+	StartTime time.Time `json:"start_time"`
+	// Zero until the block is committed.
+	CommitTime time.Time     `json:"commit_time"`
+	Duration   time.Duration `json:"duration"`
+	Rounds     int32         `json:"rounds"`
+
+	Steps     []StepTiming     `json:"steps"`
+	Proposals []ProposalTiming `json:"proposals"`
+	Votes     []VoteTiming     `json:"votes"`
+}
+
+// StepTiming is a step of the consensus state machine entered at a round.
+type StepTiming struct {
+	Round int32     `json:"round"`
+	Step  string    `json:"step"`
+	Start time.Time `json:"start"`
+	// Zero for the step in progress.
+	Duration time.Duration `json:"duration"`
+}
+
+// ProposalTiming records when the proposal of a round and its block were
+// received, relative to the start of the round.
+type ProposalTiming struct {
+	Round       int32         `json:"round"`
+	ReceiveTime time.Time     `json:"receive_time"`
+	Latency     time.Duration `json:"latency"`
+	// Latency of the last block part, zero until the block is complete.
+	BlockLatency time.Duration `json:"block_latency"`
+}
+
+// VoteTiming records when the first vote of a validator was received for a
+// round and vote type, relative to the start of the round. The latency is zero
+// if the vote was received before the round started.
+type VoteTiming struct {
+	ValidatorAddress types.Address `json:"validator_address"`
+	Round            int32         `json:"round"`
+	Type             string        `json:"type"`
+	ReceiveTime      time.Time     `json:"receive_time"`
+	Latency          time.Duration `json:"latency"`
+	// AfterCommit is set for the precommits received once the height was
+	// committed.
+	AfterCommit bool `json:"after_commit"`
+}
@@ -15,6 +15,7 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	clist "github.com/cometbft/cometbft/libs/clist"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -27,6 +28,17 @@ const (
 	baseKeyPending   = byte(0x01)
 )
 
+// maxRejectedEvidence is the number of most recently rejected evidence whose
+// rejection reason is kept.
+const maxRejectedEvidence = 1000
+
+// RejectedEvidence records why evidence submitted to the pool was rejected.
+type RejectedEvidence struct {
+	Hash   cmtbytes.HexBytes
+	Height int64 // last block height known to the pool when rejected
+	Reason string
+}
+
 // Pool maintains a pool of valid evidence to be broadcasted and committed
 type Pool struct {
 	logger log.Logger
@@ -50,6 +62,13 @@ type Pool struct {
 
 	pruningHeight int64
 	pruningTime   time.Time
+
+	eventBus types.EvidenceEventPublisher
+
+	// reasons of the most recent rejections, keyed by evidence hash
+	rejectedMtx   sync.Mutex
+	rejected      map[string]RejectedEvidence
+	rejectedOrder []string
 }
 
 // NewPool creates an evidence pool. If using an existing evidence store,
@@ -68,6 +87,8 @@ func NewPool(evidenceDB dbm.DB, stateDB sm.Store, blockStore BlockStore) (*Pool,
 		evidenceStore:   evidenceDB,
 		evidenceList:    clist.New(),
 		consensusBuffer: make([]duplicateVoteSet, 0),
+		eventBus:        types.NopEventBus{},
+		rejected:        make(map[string]RejectedEvidence),
 	}
 
 	// if pending evidence already in db, in event of prior failure, then check for expiration,
@@ -123,7 +144,7 @@ func (evpool *Pool) Update(state sm.State, ev types.EvidenceList) {
 	evpool.updateState(state)
 
 	// move committed evidence out from the pending pool and into the committed pool
-	evpool.markEvidenceAsCommitted(ev)
+	evpool.markEvidenceAsCommitted(ev, state.LastBlockHeight)
 
 	// prune pending evidence when it has expired. This also updates when the next evidence will expire
 	if evpool.Size() > 0 && state.LastBlockHeight > evpool.pruningHeight &&
@@ -153,6 +174,7 @@ func (evpool *Pool) AddEvidence(ev types.Evidence) error {
 	// 1) Verify against state.
 	err := evpool.verify(ev)
 	if err != nil {
+		evpool.recordRejection(ev, err)
 		return types.NewErrInvalidEvidence(ev, err)
 	}
 
@@ -165,10 +187,39 @@ func (evpool *Pool) AddEvidence(ev types.Evidence) error {
 	evpool.evidenceList.PushBack(ev)
 
 	evpool.logger.Info("Verified new evidence of byzantine behavior", "evidence", ev)
+	evpool.publishEvidence(evpool.eventBus.PublishEventEvidenceAdded, evpool.State().LastBlockHeight, ev)
 
 	return nil
 }
 
+// RejectedEvidence returns why the evidence with the given hash was rejected
+// when added to the pool. Only the most recent rejections are kept.
+func (evpool *Pool) RejectedEvidence(hash []byte) (RejectedEvidence, bool) {
+	evpool.rejectedMtx.Lock()
+	defer evpool.rejectedMtx.Unlock()
+
+	rejected, ok := evpool.rejected[string(hash)]
+	return rejected, ok
+}
+
+func (evpool *Pool) recordRejection(ev types.Evidence, err error) {
+	hash := ev.Hash()
+	height := evpool.State().LastBlockHeight
+
+	evpool.rejectedMtx.Lock()
+	defer evpool.rejectedMtx.Unlock()
+
+	key := string(hash)
+	if _, ok := evpool.rejected[key]; !ok {
+		if len(evpool.rejectedOrder) == maxRejectedEvidence {
+			delete(evpool.rejected, evpool.rejectedOrder[0])
+			evpool.rejectedOrder = evpool.rejectedOrder[1:]
+		}
+		evpool.rejectedOrder = append(evpool.rejectedOrder, key)
+	}
+	evpool.rejected[key] = RejectedEvidence{Hash: hash, Height: height, Reason: err.Error()}
+}
+
 // ReportConflictingVotes takes two conflicting votes and forms duplicate vote evidence,
 // adding it eventually to the evidence pool.
 //
@@ -231,6 +282,43 @@ func (evpool *Pool) CheckEvidence(evList types.EvidenceList) error {
 	return nil
 }
 
+// Rollback makes the pool consistent with the chain rolled back to the given
+// height. The given evidence, committed above the height, is no longer marked
+// as committed and is pending again if it happened at or below the height. The
+// pending evidence which happened above the height is removed.
+func (evpool *Pool) Rollback(height int64, committed types.EvidenceList) error {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+
+	pending, _, err := evpool.listEvidence(baseKeyPending, -1)
+	if err != nil {
+		return err
+	}
+	removed := make(map[string]struct{})
+	for _, ev := range pending {
+		if ev.Height() > height {
+			evpool.removePendingEvidence(ev)
+			removed[evMapKey(ev)] = struct{}{}
+		}
+	}
+	if len(removed) != 0 {
+		evpool.removeEvidenceFromList(removed)
+	}
+
+	for _, ev := range committed {
+		if err := evpool.evidenceStore.Delete(keyCommitted(ev)); err != nil {
+			return fmt.Errorf("can't delete committed evidence: %w", err)
+		}
+		if ev.Height() <= height && !evpool.isPending(ev) {
+			if err := evpool.addPendingEvidence(ev); err != nil {
+				return err
+			}
+			evpool.evidenceList.PushBack(ev)
+		}
+	}
+	return nil
+}
+
 // EvidenceFront goes to the first evidence in the clist
 func (evpool *Pool) EvidenceFront() *clist.CElement {
 	return evpool.evidenceList.Front()
@@ -246,6 +334,21 @@ func (evpool *Pool) SetLogger(l log.Logger) {
 	evpool.logger = l
 }
 
+// SetEventBus sets the publisher of the evidence events.
+func (evpool *Pool) SetEventBus(b types.EvidenceEventPublisher) {
+	evpool.eventBus = b
+}
+
+func (evpool *Pool) publishEvidence(
+	publish func(types.EventDataEvidence) error,
+	height int64,
+	ev types.Evidence,
+) {
+	if err := publish(types.EventDataEvidence{Height: height, Evidence: ev}); err != nil {
+		evpool.logger.Error("Failed publishing evidence event", "evidence", ev, "err", err)
+	}
+}
+
 // Size returns the number of evidence in the pool.
 func (evpool *Pool) Size() uint32 {
 	return atomic.LoadUint32(&evpool.evidenceSize)
@@ -325,9 +428,9 @@ func (evpool *Pool) removePendingEvidence(evidence types.Evidence) {
 	}
 }
 
-// markEvidenceAsCommitted processes all the evidence in the block, marking it as
-// committed and removing it from the pending database.
-func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
+// markEvidenceAsCommitted processes all the evidence in the block at the given
+// height, marking it as committed and removing it from the pending database.
+func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList, height int64) {
 	blockEvidenceMap := make(map[string]struct{}, len(evidence))
 	for _, ev := range evidence {
 		if evpool.isPending(ev) {
@@ -348,7 +451,10 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
 
 		if err := evpool.evidenceStore.Set(key, evBytes); err != nil {
 			evpool.logger.Error("Unable to save committed evidence", "err", err, "key(height/hash)", key)
+			continue
 		}
+
+		evpool.publishEvidence(evpool.eventBus.PublishEventEvidenceCommitted, height, ev)
 	}
 
 	// remove committed evidence from the clist
@@ -427,6 +533,7 @@ func (evpool *Pool) removeExpiredPendingEvidence() (int64, time.Time) {
 		}
 		evpool.removePendingEvidence(ev)
 		blockEvidenceMap[evMapKey(ev)] = struct{}{}
+		evpool.publishEvidence(evpool.eventBus.PublishEventEvidenceExpired, evpool.State().LastBlockHeight, ev)
 	}
 	// We either have no pending evidence or all evidence has expired
 	if len(blockEvidenceMap) != 0 {
@@ -533,6 +640,7 @@ func (evpool *Pool) processConsensusBuffer(state sm.State) {
 		evpool.evidenceList.PushBack(dve)
 
 		evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", dve)
+		evpool.publishEvidence(evpool.eventBus.PublishEventEvidenceAdded, state.LastBlockHeight, dve)
 	}
 	// reset consensus buffer
 	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
@@ -1,6 +1,8 @@
 package evidence_test
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -15,6 +17,7 @@ import (
 	"github.com/cometbft/cometbft/evidence/mocks"
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	sm "github.com/cometbft/cometbft/state"
 	smmocks "github.com/cometbft/cometbft/state/mocks"
@@ -217,6 +220,107 @@ func TestEvidencePoolUpdate(t *testing.T) {
 	}
 }
 
+func TestEvidencePoolRollback(t *testing.T) {
+	height := int64(21)
+	pool, val := defaultTestPool(t, height)
+	state := pool.State()
+
+	committedEv, err := types.NewMockDuplicateVoteEvidenceWithValidator(height-1,
+		defaultEvidenceTime.Add(20*time.Minute), val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidence(committedEv))
+	pendingEv, err := types.NewMockDuplicateVoteEvidenceWithValidator(height,
+		defaultEvidenceTime.Add(21*time.Minute), val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidence(pendingEv))
+
+	state.LastBlockHeight = height + 1
+	state.LastBlockTime = defaultEvidenceTime.Add(22 * time.Minute)
+	pool.Update(state, types.EvidenceList{committedEv})
+	evList, _ := pool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Equal(t, []types.Evidence{pendingEv}, evList)
+
+	// the committed evidence is pending again, the one above the height is removed
+	require.NoError(t, pool.Rollback(height-1, types.EvidenceList{committedEv}))
+	evList, _ = pool.PendingEvidence(defaultEvidenceMaxBytes)
+	assert.Equal(t, []types.Evidence{committedEv}, evList)
+	assert.EqualValues(t, 1, pool.Size())
+}
+
+func TestEvidencePoolEvents(t *testing.T) {
+	height := int64(21)
+	pool, val := defaultTestPool(t, height)
+	state := pool.State()
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	pool.SetEventBus(eventBus)
+
+	subscribe := func(query cmtpubsub.Query) types.Subscription {
+		sub, err := eventBus.Subscribe(context.Background(), "test", query, 10)
+		require.NoError(t, err)
+		return sub
+	}
+	added := subscribe(types.EventQueryEvidenceAdded)
+	committed := subscribe(types.EventQueryEvidenceCommitted)
+	expired := subscribe(types.EventQueryEvidenceExpired)
+
+	expiredEv, err := types.NewMockDuplicateVoteEvidenceWithValidator(1, defaultEvidenceTime.Add(1*time.Minute),
+		val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidence(expiredEv))
+	ev, err := types.NewMockDuplicateVoteEvidenceWithValidator(height, defaultEvidenceTime.Add(21*time.Minute),
+		val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidence(ev))
+
+	for _, want := range []types.Evidence{expiredEv, ev} {
+		msg := <-added.Out()
+		data := msg.Data().(types.EventDataEvidence)
+		assert.Equal(t, want, data.Evidence)
+		assert.Equal(t, height, data.Height)
+		assert.Equal(t, []string{fmt.Sprintf("%X", want.Hash())}, msg.Events()[types.EvidenceHashKey])
+	}
+
+	state.LastBlockHeight = height + 1
+	state.LastBlockTime = defaultEvidenceTime.Add(22 * time.Minute)
+	pool.Update(state, types.EvidenceList{ev})
+
+	msg := <-committed.Out()
+	assert.Equal(t, types.EventDataEvidence{Height: height + 1, Evidence: ev}, msg.Data())
+	msg = <-expired.Out()
+	assert.Equal(t, types.EventDataEvidence{Height: height + 1, Evidence: expiredEv}, msg.Data())
+}
+
+func TestEvidencePoolRecordsRejections(t *testing.T) {
+	height := int64(10)
+	pool, val := defaultTestPool(t, height)
+
+	// the evidence time doesn't match the block time
+	ev, err := types.NewMockDuplicateVoteEvidenceWithValidator(height, defaultEvidenceTime, val, evidenceChainID)
+	require.NoError(t, err)
+	err = pool.AddEvidence(ev)
+	require.Error(t, err)
+
+	rejected, ok := pool.RejectedEvidence(ev.Hash())
+	require.True(t, ok)
+	assert.EqualValues(t, ev.Hash(), rejected.Hash)
+	assert.Equal(t, height, rejected.Height)
+	assert.Contains(t, err.Error(), rejected.Reason)
+
+	valid, err := types.NewMockDuplicateVoteEvidenceWithValidator(height, defaultEvidenceTime.Add(10*time.Minute),
+		val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidence(valid))
+	_, ok = pool.RejectedEvidence(valid.Hash())
+	assert.False(t, ok)
+}
+
 func TestVerifyPendingEvidencePasses(t *testing.T) {
 	var height int64 = 1
 	pool, val := defaultTestPool(t, height)
@@ -0,0 +1,152 @@
+package inspect
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/blocksync"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtstrings "github.com/cometbft/cometbft/libs/strings"
+	"github.com/cometbft/cometbft/lp2p"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+)
+
+// Option sets an optional parameter on the Inspector.
+type Option func(*Inspector)
+
+// WithBlocksync makes the Inspector also serve the blocks of its block store to
+// the peers syncing from it, e.g. to bootstrap other nodes off the data of a
+// crashed node. It runs a minimal p2p stack with the transport of cfg, either
+// CometBFT's p2p or go-libp2p, with only a serve-only blocksync reactor: the
+// node neither syncs nor runs consensus.
+func WithBlocksync(cfg *config.Config, nodeKey *p2p.NodeKey, genDoc *types.GenesisDoc) Option {
+	return func(ins *Inspector) {
+		ins.blocksync = &blocksyncServer{
+			config:  cfg,
+			nodeKey: nodeKey,
+			genDoc:  genDoc,
+		}
+	}
+}
+
+// blocksyncServer serves the blocks of a block store to the peers of the node.
+type blocksyncServer struct {
+	config  *config.Config
+	nodeKey *p2p.NodeKey
+	genDoc  *types.GenesisDoc
+
+	transport *p2p.MultiplexTransport
+	sw        p2p.Switcher
+}
+
+func (s *blocksyncServer) start(ss state.Store, bs state.BlockStore, logger log.Logger) error {
+	nodeInfo, err := s.nodeInfo(ss)
+	if err != nil {
+		return err
+	}
+
+	reactor := blocksync.NewServeOnlyReactor(ss, bs, blocksync.NopMetrics())
+	reactor.SetLogger(logger.With("module", "blocksync"))
+
+	p2pLogger := logger.With("module", "p2p")
+	if s.config.P2P.LibP2PEnabled() {
+		host, err := lp2p.NewHost(s.config.P2P, s.nodeKey.PrivKey, p2pLogger)
+		if err != nil {
+			return fmt.Errorf("unable to create libp2p host: %w", err)
+		}
+		reactors := []lp2p.SwitchReactor{{Name: "BLOCKSYNC", Reactor: reactor}}
+		sw, err := lp2p.NewSwitch(nodeInfo, host, reactors, p2p.NopMetrics(), p2pLogger)
+		if err != nil {
+			return fmt.Errorf("unable to create libp2p switch: %w", err)
+		}
+		s.sw = sw
+		return sw.Start()
+	}
+
+	s.transport = p2p.NewMultiplexTransport(nodeInfo, *s.nodeKey, p2p.MConnConfig(s.config.P2P))
+	if !s.config.P2P.AllowDuplicateIP {
+		p2p.MultiplexTransportConnFilters(p2p.ConnDuplicateIPFilter())(s.transport)
+	}
+	p2p.MultiplexTransportMaxIncomingConnections(s.config.P2P.MaxNumInboundPeers)(s.transport)
+
+	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(s.nodeKey.ID(), s.config.P2P.ListenAddress))
+	if err != nil {
+		return err
+	}
+	if err := s.transport.Listen(*addr); err != nil {
+		return err
+	}
+
+	sw := p2p.NewSwitch(s.config.P2P, s.transport)
+	sw.SetLogger(p2pLogger)
+	sw.AddReactor("BLOCKSYNC", reactor)
+	sw.SetNodeInfo(nodeInfo)
+	sw.SetNodeKey(s.nodeKey)
+	s.sw = sw
+
+	// the persistent peers of the node are the likeliest to recover from it
+	persistentPeers := cmtstrings.SplitAndTrimEmpty(s.config.P2P.PersistentPeers, ",", " ")
+	if err := sw.AddPersistentPeers(persistentPeers); err != nil {
+		return fmt.Errorf("could not add peers from persistent_peers field: %w", err)
+	}
+	if err := sw.Start(); err != nil {
+		return err
+	}
+	if err := sw.DialPeersAsync(persistentPeers); err != nil {
+		return fmt.Errorf("could not dial peers from persistent_peers field: %w", err)
+	}
+	return nil
+}
+
+func (s *blocksyncServer) stop(logger log.Logger) {
+	if s.sw != nil && s.sw.IsRunning() {
+		if err := s.sw.Stop(); err != nil {
+			logger.Error("Error closing switch", "err", err)
+		}
+	}
+	if s.transport != nil {
+		if err := s.transport.Close(); err != nil {
+			logger.Error("Error closing transport", "err", err)
+		}
+	}
+}
+
+// nodeInfo returns the node info advertised to the peers, with the blocksync
+// channel only.
+func (s *blocksyncServer) nodeInfo(ss state.Store) (p2p.DefaultNodeInfo, error) {
+	st, err := ss.Load()
+	if err != nil {
+		return p2p.DefaultNodeInfo{}, err
+	}
+	if st.IsEmpty() {
+		if st, err = state.MakeGenesisState(s.genDoc); err != nil {
+			return p2p.DefaultNodeInfo{}, err
+		}
+	}
+
+	listenAddr := s.config.P2P.ExternalAddress
+	if listenAddr == "" {
+		listenAddr = s.config.P2P.ListenAddress
+	}
+
+	nodeInfo := p2p.DefaultNodeInfo{
+		ProtocolVersion: p2p.NewProtocolVersion(
+			version.P2PProtocol,
+			st.Version.Consensus.Block,
+			st.Version.Consensus.App,
+		),
+		DefaultNodeID: s.nodeKey.ID(),
+		ListenAddr:    listenAddr,
+		Network:       s.genDoc.ChainID,
+		Version:       version.TMCoreSemVer,
+		Channels:      []byte{blocksync.BlocksyncChannel},
+		Moniker:       s.config.Moniker,
+		Other: p2p.DefaultNodeInfoOther{
+			RPCAddress: s.config.RPC.ListenAddress,
+		},
+	}
+	return nodeInfo, nodeInfo.Validate()
+}
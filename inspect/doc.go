@@ -32,5 +32,11 @@ Inspector serves its RPC endpoints on the address configured in the RPC configur
 
 The list of available RPC endpoints can then be viewed by navigating to
 http://127.0.0.1:26657/ in the web browser.
+
+With the WithBlocksync option, the Inspector also serves the blocks of the block store
+to the peers syncing from it, over a p2p stack running only the blocksync reactor,
+so that the data of a failed node can still be used to bootstrap other nodes:
+
+	ins, err := inspect.NewFromConfig(cfg, inspect.WithBlocksync(cfg, nodeKey, genDoc))
 */
 package inspect
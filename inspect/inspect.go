@@ -3,6 +3,7 @@ package inspect
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"os"
 
@@ -40,6 +41,9 @@ type Inspector struct {
 	// the Inspector to safely close them on shutdown.
 	ss state.Store
 	bs state.BlockStore
+
+	// blocksync serves the blocks of bs to the peers, if enabled.
+	blocksync *blocksyncServer
 }
 
 // New returns an Inspector that serves RPC on the specified BlockStore and StateStore.
@@ -54,30 +58,37 @@ func New(
 	ss state.Store,
 	txidx txindex.TxIndexer,
 	blkidx indexer.BlockIndexer,
+	options ...Option,
 ) *Inspector {
 	routes := rpc.Routes(*cfg, ss, bs, txidx, blkidx, logger)
 	eb := types.NewEventBus()
 	eb.SetLogger(logger.With("module", "events"))
-	return &Inspector{
+	ins := &Inspector{
 		routes: routes,
 		config: cfg,
 		logger: logger,
 		ss:     ss,
 		bs:     bs,
 	}
+	for _, option := range options {
+		option(ins)
+	}
+	return ins
 }
 
 // NewFromConfig constructs an Inspector using the values defined in the passed in config.
-func NewFromConfig(cfg *config.Config) (*Inspector, error) {
-	bsDB, err := config.DefaultDBProvider(&config.DBContext{ID: "blockstore", Config: cfg})
+func NewFromConfig(cfg *config.Config, options ...Option) (*Inspector, error) {
+	bs, err := store.LoadBlockStore(cfg, config.DefaultDBProvider, log.NewNopLogger())
 	if err != nil {
 		return nil, err
 	}
-	bs := store.NewBlockStore(bsDB)
 	sDB, err := config.DefaultDBProvider(&config.DBContext{ID: "state", Config: cfg})
 	if err != nil {
 		return nil, err
 	}
+	if _, err := state.MigrateDB(sDB, log.NewNopLogger()); err != nil {
+		return nil, err
+	}
 	genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
 	if err != nil {
 		return nil, err
@@ -87,7 +98,7 @@ func NewFromConfig(cfg *config.Config) (*Inspector, error) {
 		return nil, err
 	}
 	ss := state.NewStore(sDB, state.StoreOptions{})
-	return New(cfg.RPC, bs, ss, txidx, blkidx), nil
+	return New(cfg.RPC, bs, ss, txidx, blkidx, options...), nil
 }
 
 // Run starts the Inspector servers and blocks until the servers shut down. The passed
@@ -96,6 +107,13 @@ func (ins *Inspector) Run(ctx context.Context) error {
 	defer ins.bs.Close()
 	defer ins.ss.Close()
 
+	if ins.blocksync != nil {
+		defer ins.blocksync.stop(ins.logger)
+		if err := ins.blocksync.start(ins.ss, ins.bs, ins.logger); err != nil {
+			return fmt.Errorf("failed to start serving blocks: %w", err)
+		}
+	}
+
 	return startRPCServers(ctx, ins.config, ins.logger, ins.routes)
 }
 
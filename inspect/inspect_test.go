@@ -15,6 +15,7 @@ import (
 	"github.com/cometbft/cometbft/inspect"
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/p2p"
 	httpclient "github.com/cometbft/cometbft/rpc/client/http"
 	indexermocks "github.com/cometbft/cometbft/state/indexer/mocks"
 	statemocks "github.com/cometbft/cometbft/state/mocks"
@@ -55,6 +56,30 @@ func TestInspectRun(t *testing.T) {
 	})
 }
 
+func TestInspectRunWithBlocksync(t *testing.T) {
+	cfg := test.ResetTestRoot("test")
+	t.Cleanup(leaktest.CheckTimeout(t, 5*time.Second))
+	defer func() { _ = os.RemoveAll(cfg.RootDir) }()
+	cfg.P2P.ListenAddress = "tcp://127.0.0.1:0"
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
+	require.NoError(t, err)
+	genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
+	require.NoError(t, err)
+
+	d, err := inspect.NewFromConfig(cfg, inspect.WithBlocksync(cfg, nodeKey, genDoc))
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	stoppedWG := &sync.WaitGroup{}
+	stoppedWG.Add(1)
+	go func() {
+		require.NoError(t, d.Run(ctx))
+		stoppedWG.Done()
+	}()
+	cancel()
+	stoppedWG.Wait()
+}
+
 func TestBlock(t *testing.T) {
 	testHeight := int64(1)
 	testBlock := new(types.Block)
@@ -213,7 +238,6 @@ func TestConsensusParams(t *testing.T) {
 	blockStoreMock := &statemocks.BlockStore{}
 	blockStoreMock.On("Close").Return(nil)
 	blockStoreMock.On("Height").Return(testHeight)
-	blockStoreMock.On("Base").Return(int64(0))
 	stateStoreMock.On("LoadConsensusParams", testHeight).Return(types.ConsensusParams{
 		Block: types.BlockParams{
 			MaxGas: testMaxGas,
@@ -472,7 +496,6 @@ func TestValidators(t *testing.T) {
 	blockStoreMock := &statemocks.BlockStore{}
 	blockStoreMock.On("Close").Return(nil)
 	blockStoreMock.On("Height").Return(testHeight)
-	blockStoreMock.On("Base").Return(int64(0))
 	txIndexerMock := &txindexmocks.TxIndexer{}
 	blkIdxMock := &indexermocks.BlockIndexer{}
 	rpcConfig := config.TestRPCConfig()
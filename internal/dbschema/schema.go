@@ -0,0 +1,130 @@
+// Package dbschema versions the layout of the keys and values of databases,
+// and upgrades the databases written with a previous layout.
+package dbschema
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// BaseVersion is the version of the databases written before their schema was
+// versioned.
+const BaseVersion uint64 = 1
+
+// versionKey is the key the schema version of a database is stored at.
+var versionKey = []byte("schemaVersion")
+
+// ErrNewerVersion is returned when a database has a newer schema version than
+// the one supported, e.g. once written by a newer CometBFT version.
+type ErrNewerVersion struct {
+	Name      string
+	Version   uint64
+	Supported uint64
+}
+
+func (e ErrNewerVersion) Error() string {
+	return fmt.Sprintf("%s database has schema version %d, newer than the supported version %d",
+		e.Name, e.Version, e.Supported)
+}
+
+// Migration upgrades a database from the previous schema version to Version.
+// A migration interrupted midway is run again, so it must be idempotent.
+type Migration struct {
+	Version     uint64
+	Description string
+	Migrate     func(db dbm.DB) error
+}
+
+// Schema is the layout of the keys and values of a database, along with the
+// migrations from its previous layouts.
+type Schema struct {
+	Name string
+	// Migrations from BaseVersion on, by increasing version.
+	Migrations []Migration
+}
+
+// Version returns the current version of the schema.
+func (s Schema) Version() uint64 {
+	return BaseVersion + uint64(len(s.Migrations))
+}
+
+// LoadVersion returns the schema version of db, BaseVersion if it has none,
+// or zero if db is empty.
+func LoadVersion(db dbm.DB) (uint64, error) {
+	bz, err := db.Get(versionKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) > 0 {
+		version, err := strconv.ParseUint(string(bz), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid schema version %q: %w", bz, err)
+		}
+		return version, nil
+	}
+
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	if it.Valid() {
+		return BaseVersion, nil
+	}
+	return 0, it.Error()
+}
+
+// Migrate upgrades db to the current version of the schema, running the
+// migrations from its version on, and returns the version it was at. An empty
+// db is set to the current version. Databases with a newer version are
+// refused with ErrNewerVersion.
+func (s Schema) Migrate(db dbm.DB, logger log.Logger) (uint64, error) {
+	return s.MigrateTo(db, s.Version(), logger)
+}
+
+// MigrateTo is like Migrate, but stops at the given version, for the schemas
+// whose last versions are opted into. Databases at or past that version are
+// left as they are, and an empty db is set to it.
+func (s Schema) MigrateTo(db dbm.DB, target uint64, logger log.Logger) (uint64, error) {
+	current := s.Version()
+	if target < BaseVersion || target > current {
+		return 0, fmt.Errorf("%s database has no schema version %d", s.Name, target)
+	}
+	version, err := LoadVersion(db)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case version == 0:
+		return 0, setVersion(db, target)
+	case version > current:
+		return version, ErrNewerVersion{Name: s.Name, Version: version, Supported: current}
+	case version >= target:
+		return version, nil
+	}
+
+	from := version
+	for _, m := range s.Migrations[version-BaseVersion : target-BaseVersion] {
+		if m.Version != version+1 {
+			return from, errors.New("migrations must be ordered by version")
+		}
+		logger.Info("Migrating database schema", "db", s.Name, "version", m.Version, "migration", m.Description)
+		if err := m.Migrate(db); err != nil {
+			return from, fmt.Errorf("failed to migrate %s database to version %d: %w", s.Name, m.Version, err)
+		}
+		if err := setVersion(db, m.Version); err != nil {
+			return from, err
+		}
+		version = m.Version
+	}
+	return from, nil
+}
+
+func setVersion(db dbm.DB, version uint64) error {
+	return db.SetSync(versionKey, []byte(strconv.FormatUint(version, 10)))
+}
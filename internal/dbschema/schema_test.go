@@ -0,0 +1,128 @@
+package dbschema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+func TestMigrate(t *testing.T) {
+	var migrated []uint64
+	migration := func(version uint64) Migration {
+		return Migration{
+			Version: version,
+			Migrate: func(db dbm.DB) error {
+				migrated = append(migrated, version)
+				return db.Set([]byte("key"), []byte{byte(version)})
+			},
+		}
+	}
+	schema := Schema{Name: "test", Migrations: []Migration{migration(2), migration(3)}}
+	require.EqualValues(t, 3, schema.Version())
+
+	// an empty database is at the current version
+	db := dbm.NewMemDB()
+	from, err := schema.Migrate(db, log.TestingLogger())
+	require.NoError(t, err)
+	require.Zero(t, from)
+	version, err := LoadVersion(db)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, version)
+	require.Empty(t, migrated)
+
+	// a database without version is at the base version
+	db = dbm.NewMemDB()
+	require.NoError(t, db.Set([]byte("key"), []byte{1}))
+	version, err = LoadVersion(db)
+	require.NoError(t, err)
+	require.Equal(t, BaseVersion, version)
+	from, err = schema.Migrate(db, log.TestingLogger())
+	require.NoError(t, err)
+	require.Equal(t, BaseVersion, from)
+	require.Equal(t, []uint64{2, 3}, migrated)
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{3}, value)
+
+	// an up to date database isn't migrated
+	from, err = schema.Migrate(db, log.TestingLogger())
+	require.NoError(t, err)
+	require.EqualValues(t, 3, from)
+	require.Equal(t, []uint64{2, 3}, migrated)
+
+	// a newer database is refused
+	newer := Schema{Name: "test", Migrations: []Migration{migration(2)}}
+	_, err = newer.Migrate(db, log.TestingLogger())
+	require.ErrorAs(t, err, &ErrNewerVersion{})
+}
+
+func TestMigrateInterrupted(t *testing.T) {
+	fail := true
+	schema := Schema{Name: "test", Migrations: []Migration{
+		{Version: 2, Migrate: func(db dbm.DB) error { return nil }},
+		{Version: 3, Migrate: func(db dbm.DB) error {
+			if fail {
+				return errors.New("interrupted")
+			}
+			return nil
+		}},
+	}}
+	db := dbm.NewMemDB()
+	require.NoError(t, db.Set([]byte("key"), []byte{1}))
+
+	_, err := schema.Migrate(db, log.TestingLogger())
+	require.Error(t, err)
+	version, err := LoadVersion(db)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, version)
+
+	fail = false
+	from, err := schema.Migrate(db, log.TestingLogger())
+	require.NoError(t, err)
+	require.EqualValues(t, 2, from)
+	version, err = LoadVersion(db)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, version)
+}
+
+func TestMigrateTo(t *testing.T) {
+	var migrated []uint64
+	schema := Schema{Name: "test", Migrations: []Migration{
+		{Version: 2, Migrate: func(db dbm.DB) error { migrated = append(migrated, 2); return nil }},
+		{Version: 3, Migrate: func(db dbm.DB) error { migrated = append(migrated, 3); return nil }},
+	}}
+
+	// an empty database is at the target version
+	db := dbm.NewMemDB()
+	_, err := schema.MigrateTo(db, 2, log.TestingLogger())
+	require.NoError(t, err)
+	version, err := LoadVersion(db)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, version)
+
+	// the migrations past the target version aren't run
+	db = dbm.NewMemDB()
+	require.NoError(t, db.Set([]byte("key"), []byte{1}))
+	from, err := schema.MigrateTo(db, 2, log.TestingLogger())
+	require.NoError(t, err)
+	require.Equal(t, BaseVersion, from)
+	require.Equal(t, []uint64{2}, migrated)
+
+	// a database past the target version is left as it is
+	_, err = schema.Migrate(db, log.TestingLogger())
+	require.NoError(t, err)
+	from, err = schema.MigrateTo(db, BaseVersion, log.TestingLogger())
+	require.NoError(t, err)
+	require.EqualValues(t, 3, from)
+	version, err = LoadVersion(db)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, version)
+
+	_, err = schema.MigrateTo(db, 4, log.TestingLogger())
+	require.Error(t, err)
+}
@@ -20,6 +20,16 @@ const (
 //
 //	ParseLogLevel("consensus:debug,mempool:debug,*:error", log.NewTMLogger(os.Stdout), "info")
 func ParseLogLevel(lvl string, logger log.Logger, defaultLogLevelValue string) (log.Logger, error) {
+	options, err := ParseLogLevelOptions(lvl, defaultLogLevelValue)
+	if err != nil {
+		return nil, err
+	}
+	return log.NewFilter(logger, options...), nil
+}
+
+// ParseLogLevelOptions parses complex log level like ParseLogLevel, and
+// returns the options of the filter it applies, see log.NewFilter.
+func ParseLogLevelOptions(lvl string, defaultLogLevelValue string) ([]log.Option, error) {
 	if lvl == "" {
 		return nil, cmterrors.ErrRequiredField{Field: "LogLevel"}
 	}
@@ -88,5 +98,5 @@ func ParseLogLevel(lvl string, logger log.Logger, defaultLogLevelValue string) (
 		options = append(options, option)
 	}
 
-	return log.NewFilter(logger, options...), nil
+	return options, nil
 }
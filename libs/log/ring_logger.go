@@ -0,0 +1,119 @@
+package log
+
+import (
+	"io"
+	"sync"
+)
+
+// RingBuffer is an io.Writer keeping the last lines written to it, oldest
+// first. Every Write is taken as one line, which is how the loggers of this
+// package write their entries. It is safe for concurrent use.
+type RingBuffer struct {
+	mtx   sync.Mutex
+	lines [][]byte
+	next  int
+	full  bool
+}
+
+var _ io.Writer = (*RingBuffer)(nil)
+
+// NewRingBuffer returns a RingBuffer keeping the last size lines. It panics if
+// size is not positive.
+func NewRingBuffer(size int) *RingBuffer {
+	if size <= 0 {
+		panic("ring buffer size must be positive")
+	}
+	return &RingBuffer{lines: make([][]byte, size)}
+}
+
+// Write stores a copy of p as the latest line, overwriting the oldest line if
+// the buffer is full.
+func (b *RingBuffer) Write(p []byte) (int, error) {
+	line := make([]byte, len(p))
+	copy(line, p)
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.lines[b.next] = line
+	b.next = (b.next + 1) % len(b.lines)
+	if b.next == 0 {
+		b.full = true
+	}
+	return len(p), nil
+}
+
+// WriteTo writes the lines of the buffer to w, oldest first.
+func (b *RingBuffer) WriteTo(w io.Writer) (int64, error) {
+	var total int64
+	for _, line := range b.Lines() {
+		n, err := w.Write(line)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// Lines returns the lines of the buffer, oldest first.
+func (b *RingBuffer) Lines() [][]byte {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if !b.full {
+		return append([][]byte(nil), b.lines[:b.next]...)
+	}
+	lines := make([][]byte, 0, len(b.lines))
+	lines = append(lines, b.lines[b.next:]...)
+	return append(lines, b.lines[:b.next]...)
+}
+
+// NewRingLogger returns a logger forwarding everything to next, and also
+// recording the info, warn and error entries into buf in the CometBFT log
+// format, e.g. to serve the recent logs of a node over RPC. Debug entries are
+// not recorded, so that they don't evict the more relevant ones and are not
+// formatted twice. If filter options are given, the recorded entries are
+// filtered with them, as with NewFilter, e.g. to record only the entries next
+// logs.
+func NewRingLogger(next Logger, buf *RingBuffer, options ...Option) Logger {
+	ring := NewTMLogger(buf)
+	if len(options) > 0 {
+		ring = NewFilter(ring, options...)
+	}
+	return &ringLogger{
+		next: next,
+		ring: ring,
+	}
+}
+
+type ringLogger struct {
+	next Logger
+	ring Logger
+}
+
+func (l *ringLogger) Info(msg string, keyvals ...any) {
+	l.next.Info(msg, keyvals...)
+	l.ring.Info(msg, keyvals...)
+}
+
+func (l *ringLogger) Debug(msg string, keyvals ...any) {
+	l.next.Debug(msg, keyvals...)
+}
+
+func (l *ringLogger) Warn(msg string, keyvals ...any) {
+	l.next.Warn(msg, keyvals...)
+	l.ring.Warn(msg, keyvals...)
+}
+
+func (l *ringLogger) Error(msg string, keyvals ...any) {
+	l.next.Error(msg, keyvals...)
+	l.ring.Error(msg, keyvals...)
+}
+
+func (l *ringLogger) With(keyvals ...any) Logger {
+	return &ringLogger{
+		next: l.next.With(keyvals...),
+		ring: l.ring.With(keyvals...),
+	}
+}
@@ -0,0 +1,71 @@
+package log_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+func TestRingBuffer(t *testing.T) {
+	buf := log.NewRingBuffer(3)
+	require.Empty(t, buf.Lines())
+
+	for _, line := range []string{"a\n", "b\n"} {
+		_, err := buf.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.Equal(t, [][]byte{[]byte("a\n"), []byte("b\n")}, buf.Lines())
+
+	for _, line := range []string{"c\n", "d\n", "e\n"} {
+		_, err := buf.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	var out bytes.Buffer
+	n, err := buf.WriteTo(&out)
+	require.NoError(t, err)
+	require.EqualValues(t, 6, n)
+	require.Equal(t, "c\nd\ne\n", out.String())
+}
+
+func TestRingLogger(t *testing.T) {
+	var next bytes.Buffer
+	buf := log.NewRingBuffer(10)
+	logger := log.NewRingLogger(log.NewTMLogger(&next), buf).With("module", "test")
+
+	logger.Info("info msg", "k", "v")
+	logger.Debug("debug msg")
+	logger.Error("error msg")
+
+	lines := buf.Lines()
+	require.Len(t, lines, 2)
+	require.Contains(t, string(lines[0]), "info msg")
+	require.Contains(t, string(lines[0]), "module=test")
+	require.Contains(t, string(lines[0]), "k=v")
+	require.Contains(t, string(lines[1]), "error msg")
+
+	require.Contains(t, next.String(), "info msg")
+	require.Contains(t, next.String(), "error msg")
+	require.Equal(t, 2+strings.Count(next.String(), "debug msg"), strings.Count(next.String(), "\n"))
+}
+
+func TestRingLoggerFilter(t *testing.T) {
+	var next bytes.Buffer
+	buf := log.NewRingBuffer(10)
+	logger := log.NewRingLogger(log.NewTMLogger(&next), buf,
+		log.AllowError(), log.AllowInfoWith("module", "test"))
+
+	logger.Info("info msg")
+	logger.With("module", "test").Info("module info msg")
+	logger.Error("error msg")
+
+	// only the entries allowed by the filter are recorded
+	lines := buf.Lines()
+	require.Len(t, lines, 2)
+	require.Contains(t, string(lines[0]), "module info msg")
+	require.Contains(t, string(lines[1]), "error msg")
+	require.Contains(t, next.String(), "info msg")
+}
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
@@ -226,6 +227,10 @@ func (c *Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusSta
 	return c.next.ConsensusState(ctx)
 }
 
+func (c *Client) ConsensusTimings(ctx context.Context) (*ctypes.ResultConsensusTimings, error) {
+	return c.next.ConsensusTimings(ctx)
+}
+
 func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	res, err := c.next.ConsensusParams(ctx, height)
 	if err != nil {
@@ -422,6 +427,100 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 	return res, nil
 }
 
+// TxResultProof calls rpcclient#TxResultProof and then verifies the proof of
+// the result against the LastResultsHash of the trusted header at height+1.
+func (c *Client) TxResultProof(ctx context.Context, height int64, index int) (*ctypes.ResultTxResultProof, error) {
+	res, err := c.next.TxResultProof(ctx, height, index)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.Height != height || res.Index != index {
+		return nil, fmt.Errorf("expected result of tx %d at height %d, got tx %d at height %d",
+			index, height, res.Index, res.Height)
+	}
+	if res.TxResult == nil {
+		return nil, errors.New("nil tx result")
+	}
+	if res.Proof.Index != int64(index) {
+		return nil, fmt.Errorf("proof index %d does not match tx index %d", res.Proof.Index, index)
+	}
+
+	// The results of height are committed to by the header of the next height,
+	// which must be trusted: verify it, which fails if it does not exist yet.
+	nextHeight := height + 1
+	trustedBlock, err := c.updateLightClientIfNeededTo(ctx, &nextHeight)
+	if err != nil {
+		return nil, fmt.Errorf("results of height %d can't be verified without the trusted header %d: %w",
+			height, nextHeight, err)
+	}
+	if trustedBlock.Height != nextHeight {
+		return nil, fmt.Errorf("expected trusted header %d, got %d", nextHeight, trustedBlock.Height)
+	}
+
+	// Verify the result.
+	bz, err := abci.DeterministicExecTxResult(res.TxResult).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := res.Proof.Verify(trustedBlock.LastResultsHash, bz); err != nil {
+		return nil, fmt.Errorf("verify tx result against trusted last results %X: %w",
+			trustedBlock.LastResultsHash, err)
+	}
+
+	return res, nil
+}
+
+// EventProof calls rpcclient#EventProof and then verifies the proof of the
+// event against the events hash of the tx, and the events hash against the
+// verified result of the tx.
+//
+// CometBFT does not commit to events, so this only succeeds for the events of
+// the applications which set the data of each tx result to the hash of its
+// events (see types.ABCIEvents): the proof of an event holds only by this
+// application convention.
+func (c *Client) EventProof(
+	ctx context.Context,
+	height int64,
+	txIndex int,
+	index int,
+) (*ctypes.ResultEventProof, error) {
+	res, err := c.next.EventProof(ctx, height, txIndex, index)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.Height != height || res.TxIndex != txIndex || res.Index != index {
+		return nil, fmt.Errorf("expected event %d of tx %d at height %d, got another event", index, txIndex, height)
+	}
+	if res.Proof.Index != int64(index) {
+		return nil, fmt.Errorf("proof index %d does not match event index %d", res.Proof.Index, index)
+	}
+
+	// Verify the event against the events hash...
+	bz, err := abci.DeterministicEvent(res.Event).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := res.Proof.Verify(res.EventsHash, bz); err != nil {
+		return nil, fmt.Errorf("verify event against events hash %X: %w", res.EventsHash, err)
+	}
+
+	// ...and the events hash against the tx result.
+	txRes, err := c.TxResultProof(ctx, height, txIndex)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(txRes.TxResult.Data, res.EventsHash) {
+		return nil, fmt.Errorf("events hash %X does not match with tx result data %X; "+
+			"the application may not commit to the events of its txs", res.EventsHash, txRes.TxResult.Data)
+	}
+
+	return res, nil
+}
+
 // Header fetches and verifies the header directly via the light client
 func (c *Client) Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error) {
 	lb, err := c.updateLightClientIfNeededTo(ctx, height)
@@ -470,6 +569,48 @@ func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommi
 	}, nil
 }
 
+// ExtendedCommit calls rpcclient#ExtendedCommit and then verifies the
+// extended commit is for the trusted header, signed by more than 2/3 of the
+// voting power of the trusted validators, and that its precommits and vote
+// extensions are signed by them.
+func (c *Client) ExtendedCommit(ctx context.Context, height *int64) (*ctypes.ResultExtendedCommit, error) {
+	res, err := c.next.ExtendedCommit(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if err := res.ExtendedCommit.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if res.ExtendedCommit.Height != res.Header.Height {
+		return nil, fmt.Errorf("extended commit height %d does not match with header height %d",
+			res.ExtendedCommit.Height, res.Header.Height)
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Header.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify extended commit.
+	if bH, tH := res.ExtendedCommit.BlockID.Hash, l.Hash(); !bytes.Equal(bH, tH) {
+		return nil, fmt.Errorf("extended commit block %X does not match with trusted header %X",
+			bH, tH)
+	}
+	if err := l.ValidatorSet.VerifyCommitLight(l.ChainID, res.ExtendedCommit.BlockID, res.Header.Height,
+		res.ExtendedCommit.ToCommit()); err != nil {
+		return nil, err
+	}
+	if err := res.ExtendedCommit.VerifyExtensions(l.ChainID, l.ValidatorSet); err != nil {
+		return nil, err
+	}
+	res.Header = *l.Header
+
+	return res, nil
+}
+
 // Tx calls rpcclient#Tx method and then verifies the proof if such was
 // requested.
 func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
@@ -543,10 +684,46 @@ func (c *Client) Validators(
 	}, nil
 }
 
+// ValidatorUptime calls rpcclient#ValidatorUptime. The counts are not verified.
+func (c *Client) ValidatorUptime(ctx context.Context, address []byte) (*ctypes.ResultValidatorUptime, error) {
+	return c.next.ValidatorUptime(ctx, address)
+}
+
 func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.next.BroadcastEvidence(ctx, ev)
 }
 
+func (c *Client) PendingEvidence(ctx context.Context) (*ctypes.ResultPendingEvidence, error) {
+	return c.next.PendingEvidence(ctx)
+}
+
+// Evidence calls rpcclient#Evidence and then verifies the result against the
+// evidence hash of the trusted header.
+func (c *Client) Evidence(ctx context.Context, height *int64) (*ctypes.ResultEvidence, error) {
+	res, err := c.next.Evidence(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify evidence.
+	if eH, tH := types.EvidenceList(res.Evidence).Hash(), l.EvidenceHash; !bytes.Equal(eH, tH) {
+		return nil, fmt.Errorf("evidence hash %X does not match with trusted evidence hash %X",
+			eH, tH)
+	}
+
+	return res, nil
+}
+
+func (c *Client) RejectedEvidence(ctx context.Context, hash []byte) (*ctypes.ResultRejectedEvidence, error) {
+	return c.next.RejectedEvidence(ctx, hash)
+}
+
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int,
 ) (out <-chan ctypes.ResultEvent, err error) {
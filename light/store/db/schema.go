@@ -0,0 +1,25 @@
+package db
+
+import (
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/internal/dbschema"
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// dbSchema is the layout of the light client database. A change of the layout
+// must come with a migration from the previous one.
+var dbSchema = dbschema.Schema{Name: "light"}
+
+// SchemaVersion returns the current schema version of the light client
+// database.
+func SchemaVersion() uint64 {
+	return dbSchema.Version()
+}
+
+// MigrateDB upgrades the light client database db to the current schema
+// version, and returns the version it was at. It must be called before db is
+// used by a Store. Databases with a newer schema version are refused.
+func MigrateDB(db dbm.DB, logger log.Logger) (uint64, error) {
+	return dbSchema.Migrate(db, logger)
+}
@@ -16,6 +16,7 @@ import (
 
 const (
 	layer4UDP = "udp"
+	layer4TCP = "tcp"
 )
 
 func IDFromPrivateKey(cosmosPK cmcrypto.PrivKey) (peer.ID, error) {
@@ -28,8 +29,9 @@ func IDFromPrivateKey(cosmosPK cmcrypto.PrivKey) (peer.ID, error) {
 }
 
 // AddressToMultiAddr converts a `listenAddress` to a multiaddr for the given transport
-// Currently, only QUIC is supported. Example:
-// "tcp://1.1.1.1:5678" yields to "/ip4/1.1.1.1/udp/5678/quic-v1"
+// (QUIC or TCP). Example:
+// "tcp://1.1.1.1:5678" yields to "/ip4/1.1.1.1/udp/5678/quic-v1" with QUIC
+// and to "/ip4/1.1.1.1/tcp/5678" with TCP
 func AddressToMultiAddr(addr string, transport string) (ma.Multiaddr, error) {
 	if !strings.Contains(addr, "://") {
 		addr = "tcp://" + addr
@@ -44,14 +46,16 @@ func AddressToMultiAddr(addr string, transport string) (ma.Multiaddr, error) {
 	case parts.Port() == "":
 		return nil, fmt.Errorf("port is empty")
 	case transport == TransportQUIC:
-		return addrToQuicMultiaddr(parts, layer4UDP)
+		return addrToMultiaddr(parts, layer4UDP, TransportQUIC)
+	case transport == TransportTCP:
+		return addrToMultiaddr(parts, layer4TCP, "")
 	}
 
 	return nil, fmt.Errorf("unsupported transport: %s", transport)
 }
 
-func AddrInfoFromHostAndID(host, id string) (peer.AddrInfo, error) {
-	addr, err := AddressToMultiAddr(host, TransportQUIC)
+func AddrInfoFromHostAndID(host, id, transport string) (peer.AddrInfo, error) {
+	addr, err := AddressToMultiAddr(host, transport)
 	if err != nil {
 		return peer.AddrInfo{}, fmt.Errorf("failed to convert host to multiaddr: %w", err)
 	}
@@ -94,10 +98,12 @@ func (h *Host) multiAddrStrByID(id peer.ID) string {
 	return multiAddrStr(h.Peerstore().Addrs(id))
 }
 
-// addrToQuicMultiaddr converts a given address to a QUIC multiaddr
+// addrToMultiaddr converts a given address to a multiaddr over layer4, followed by
+// the given protocol, if any
 // example: "tcp://192.0.2.0:65432" -> "/ip4/192.0.2.0/udp/65432/quic-v1"
 // example: "tcp://my-host.cluster.local:65432" -> "dns/my-host.cluster.local/udp/65432/quic-v1"
-func addrToQuicMultiaddr(parts *url.URL, layer4 string) (ma.Multiaddr, error) {
+// example: "tcp://192.0.2.0:65432" -> "/ip4/192.0.2.0/tcp/65432" (no protocol)
+func addrToMultiaddr(parts *url.URL, layer4, protocol string) (ma.Multiaddr, error) {
 	hostname := parts.Hostname()
 
 	// Determine the network protocol prefix based on the hostname
@@ -113,7 +119,10 @@ func addrToQuicMultiaddr(parts *url.URL, layer4 string) (ma.Multiaddr, error) {
 		networkProto = "dns"
 	}
 
-	raw := fmt.Sprintf("/%s/%s/%s/%s/%s", networkProto, hostname, layer4, parts.Port(), TransportQUIC)
+	raw := fmt.Sprintf("/%s/%s/%s/%s", networkProto, hostname, layer4, parts.Port())
+	if protocol != "" {
+		raw += "/" + protocol
+	}
 
 	return ma.NewMultiaddr(raw)
 }
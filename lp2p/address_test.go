@@ -59,6 +59,24 @@ func TestAddressToMultiAddr(t *testing.T) {
 			transport: TransportQUIC,
 			want:      "/dns/localhost/udp/5678/quic-v1",
 		},
+		{
+			name:      "tcp to tcp",
+			addr:      "tcp://1.1.1.1:5678",
+			transport: TransportTCP,
+			want:      "/ip4/1.1.1.1/tcp/5678",
+		},
+		{
+			name:      "hostname to tcp",
+			addr:      "my-app-7d9c6f7c9f-2xk8m:5678",
+			transport: TransportTCP,
+			want:      "/dns/my-app-7d9c6f7c9f-2xk8m/tcp/5678",
+		},
+		{
+			name:        "unsupported transport",
+			addr:        "1.1.1.1:5678",
+			transport:   "webrtc",
+			errContains: "unsupported transport: webrtc",
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			got, err := AddressToMultiAddr(tt.addr, tt.transport)
@@ -150,7 +168,7 @@ func TestAddrInfoFromHostAndID(t *testing.T) {
 			peerID := tt.id(t)
 
 			// ACT
-			addrInfo, err := AddrInfoFromHostAndID(tt.host, peerID)
+			addrInfo, err := AddrInfoFromHostAndID(tt.host, peerID, TransportQUIC)
 
 			// ASSERT
 			if tt.errContains != "" {
@@ -0,0 +1,216 @@
+package lp2p
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+)
+
+// ErrPartitioned is returned when opening a stream to a peer the host is
+// partitioned from by its FaultInjector.
+var ErrPartitioned = errors.New("peer is partitioned")
+
+// ErrInjectedReset is returned by streams reset by a FaultInjector.
+var ErrInjectedReset = errors.New("injected stream reset")
+
+// maxExhaustedStreams bounds the number of stream scopes opened per peer by
+// FaultInjector.ExhaustResources, for resource managers without limits.
+const maxExhaustedStreams = 1 << 16
+
+// FaultInjector injects network faults into a Host. It's meant for tests, e.g.
+// chaos testing of lp2p networks in the e2e runner. See Host.SetFaultInjector.
+//
+// Faults only apply to streams: partitioned peers stay connected at the
+// libp2p level, but no stream can be opened to or accepted from them.
+type FaultInjector struct {
+	mtx sync.RWMutex
+
+	// host is set by Host.SetFaultInjector
+	host *Host
+
+	partitioned map[peer.ID]struct{}
+	latency     time.Duration
+	jitter      time.Duration
+	resetRate   float64
+	rand        *rand.Rand
+
+	// stream scopes reserved by ExhaustResources
+	reserved []network.StreamManagementScope
+}
+
+// NewFaultInjector returns a FaultInjector with no faults.
+func NewFaultInjector() *FaultInjector {
+	return &FaultInjector{
+		partitioned: make(map[peer.ID]struct{}),
+		rand:        rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+	}
+}
+
+// Partition blocks streams to and from the given peers.
+func (f *FaultInjector) Partition(ids ...peer.ID) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	for _, id := range ids {
+		f.partitioned[id] = struct{}{}
+	}
+}
+
+// Heal removes all partitions.
+func (f *FaultInjector) Heal() {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.partitioned = make(map[peer.ID]struct{})
+}
+
+// SetLatency delays each write to a stream by latency plus a random duration
+// up to jitter.
+func (f *FaultInjector) SetLatency(latency, jitter time.Duration) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.latency = latency
+	f.jitter = jitter
+}
+
+// SetStreamResetRate makes each write to a stream reset it with the given
+// probability, in [0, 1].
+func (f *FaultInjector) SetStreamResetRate(rate float64) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.resetRate = min(max(rate, 0), 1)
+}
+
+// ExhaustResources opens stream scopes with the host's resource manager for
+// each connected peer until it refuses to, so that no new stream can be
+// opened until Clear is called. It returns the number of reserved scopes.
+func (f *FaultInjector) ExhaustResources() (int, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if f.host == nil {
+		return 0, errors.New("fault injector is not attached to a host")
+	}
+
+	rm := f.host.Network().ResourceManager()
+	reserved := 0
+	for _, id := range f.host.Network().Peers() {
+		for i := 0; i < maxExhaustedStreams; i++ {
+			scope, err := rm.OpenStream(id, network.DirOutbound)
+			if err != nil {
+				break
+			}
+			f.reserved = append(f.reserved, scope)
+			reserved++
+		}
+	}
+
+	return reserved, nil
+}
+
+// Clear removes all faults and releases the resources reserved by
+// ExhaustResources.
+func (f *FaultInjector) Clear() {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.partitioned = make(map[peer.ID]struct{})
+	f.latency = 0
+	f.jitter = 0
+	f.resetRate = 0
+
+	for _, scope := range f.reserved {
+		scope.Done()
+	}
+	f.reserved = nil
+}
+
+// IsPartitioned returns true if streams to and from the given peer are
+// blocked.
+func (f *FaultInjector) IsPartitioned(id peer.ID) bool {
+	f.mtx.RLock()
+	defer f.mtx.RUnlock()
+
+	_, ok := f.partitioned[id]
+	return ok
+}
+
+// writeFault returns the delay to apply before a write, and whether the
+// stream should be reset instead.
+func (f *FaultInjector) writeFault() (time.Duration, bool) {
+	// rand.Rand is not safe for concurrent use
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	delay := f.latency
+	if f.jitter > 0 {
+		delay += time.Duration(f.rand.Int63n(int64(f.jitter)))
+	}
+
+	return delay, f.resetRate > 0 && f.rand.Float64() < f.resetRate
+}
+
+// faultyStream is a stream subject to the faults of a FaultInjector.
+type faultyStream struct {
+	network.Stream
+	faults *FaultInjector
+}
+
+func (s *faultyStream) Write(p []byte) (int, error) {
+	delay, reset := s.faults.writeFault()
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+	if reset {
+		_ = s.Stream.Reset()
+		return 0, ErrInjectedReset
+	}
+
+	return s.Stream.Write(p)
+}
+
+// SetFaultInjector attaches a FaultInjector to the host; nil detaches it.
+// Faults apply to streams opened after the call.
+func (h *Host) SetFaultInjector(f *FaultInjector) {
+	if f != nil {
+		f.mtx.Lock()
+		f.host = h
+		f.mtx.Unlock()
+	}
+	h.faults.Store(f)
+}
+
+// NewStream opens a new stream to the given peer, subject to the faults of the
+// host's FaultInjector, if any.
+func (h *Host) NewStream(ctx context.Context, id peer.ID, pids ...protocol.ID) (network.Stream, error) {
+	f := h.faults.Load()
+	if f == nil {
+		return h.Host.NewStream(ctx, id, pids...)
+	}
+
+	if f.IsPartitioned(id) {
+		return nil, ErrPartitioned
+	}
+
+	s, err := h.Host.NewStream(ctx, id, pids...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &faultyStream{Stream: s, faults: f}, nil
+}
+
+// acceptsStreamFrom returns false if the host's FaultInjector blocks streams
+// from the given peer.
+func (h *Host) acceptsStreamFrom(id peer.ID) bool {
+	f := h.faults.Load()
+	return f == nil || !f.IsPartitioned(id)
+}
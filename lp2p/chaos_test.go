@@ -0,0 +1,101 @@
+package lp2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaultInjector(t *testing.T) {
+	// ARRANGE
+	ctx := context.Background()
+	hosts := makeTestHosts(t, 2, withModifiedConfig(func(cfg *config.LibP2PConfig) {
+		cfg.Limits.Mode = config.LibP2PLimitsModeCustom
+		cfg.Limits.MaxPeers = 10
+		cfg.Limits.MaxPeerStreams = 8
+	}))
+	hostA, hostB := hosts[0], hosts[1]
+
+	protoID := ProtocolID(0xAA)
+	received := make(chan []byte, 16)
+	hostB.SetStreamHandler(protoID, func(stream network.Stream) {
+		bz, err := StreamReadClose(stream)
+		if err == nil {
+			received <- bz
+		}
+	})
+
+	require.NoError(t, hostA.Connect(ctx, hostB.AddrInfo()))
+
+	faults := NewFaultInjector()
+	hostA.SetFaultInjector(faults)
+
+	send := func() error {
+		s, err := hostA.NewStream(ctx, hostB.ID(), protoID)
+		if err != nil {
+			return err
+		}
+		return StreamWriteClose(s, []byte("hello"))
+	}
+
+	t.Run("NoFaults", func(t *testing.T) {
+		require.NoError(t, send())
+		require.Equal(t, []byte("hello"), <-received)
+	})
+
+	t.Run("Partition", func(t *testing.T) {
+		// ACT
+		faults.Partition(hostB.ID())
+
+		// ASSERT
+		require.ErrorIs(t, send(), ErrPartitioned)
+		require.False(t, hostA.acceptsStreamFrom(hostB.ID()))
+
+		faults.Heal()
+		require.NoError(t, send())
+		require.Equal(t, []byte("hello"), <-received)
+	})
+
+	t.Run("Latency", func(t *testing.T) {
+		// ACT
+		faults.SetLatency(100*time.Millisecond, 0)
+		start := time.Now()
+		err := send()
+
+		// ASSERT
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+		<-received
+		faults.SetLatency(0, 0)
+	})
+
+	t.Run("StreamReset", func(t *testing.T) {
+		// ACT
+		faults.SetStreamResetRate(1)
+
+		// ASSERT
+		require.ErrorIs(t, send(), ErrInjectedReset)
+
+		faults.SetStreamResetRate(0)
+		require.NoError(t, send())
+		<-received
+	})
+
+	t.Run("ExhaustResources", func(t *testing.T) {
+		// ACT
+		reserved, err := faults.ExhaustResources()
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Positive(t, reserved)
+		require.Error(t, send())
+
+		faults.Clear()
+		require.NoError(t, send())
+		<-received
+	})
+}
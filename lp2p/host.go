@@ -5,6 +5,7 @@ package lp2p
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/cometbft/cometbft/config"
@@ -19,6 +20,7 @@ import (
 	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
 	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	multiaddr "github.com/multiformats/go-multiaddr"
 )
 
@@ -36,6 +38,9 @@ type Host struct {
 	logger log.Logger
 
 	peerFailureHandlers []func(id peer.ID, err error)
+
+	// faults injects network faults, for testing purposes
+	faults atomic.Pointer[FaultInjector]
 }
 
 // BootstrapPeer initial peers to connect to
@@ -50,6 +55,19 @@ type BootstrapPeer struct {
 // @see https://docs.libp2p.io/concepts/transports/quic
 const TransportQUIC = "quic-v1"
 
+// TransportTCP tcp transport, secured with TLS or Noise and multiplexed with yamux.
+// @see https://docs.libp2p.io/concepts/transports/tcp
+const TransportTCP = "tcp"
+
+// TransportFromConfig returns the transport set in the config.
+func TransportFromConfig(cfg config.LibP2PConfig) string {
+	if cfg.Transport == config.LibP2PTransportTCP {
+		return TransportTCP
+	}
+
+	return TransportQUIC
+}
+
 // NewHost Host constructor.
 func NewHost(config *config.P2PConfig, nodeKey cmcrypto.PrivKey, logger log.Logger) (*Host, error) {
 	if !config.LibP2PEnabled() {
@@ -65,7 +83,9 @@ func NewHost(config *config.P2PConfig, nodeKey cmcrypto.PrivKey, logger log.Logg
 		return nil, fmt.Errorf("failed to convert private key to libp2p: %w", err)
 	}
 
-	listenAddr, err := AddressToMultiAddr(config.ListenAddress, TransportQUIC)
+	transport := TransportFromConfig(config.LibP2PConfig)
+
+	listenAddr, err := AddressToMultiAddr(config.ListenAddress, transport)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert %q to multiaddr: %w", config.ListenAddress, err)
 	}
@@ -92,17 +112,23 @@ func NewHost(config *config.P2PConfig, nodeKey cmcrypto.PrivKey, logger log.Logg
 		libp2p.ListenAddrs(listenAddr),
 		libp2p.UserAgent("cometbft"),
 		libp2p.Ping(true),
-		libp2p.Transport(quic.NewTransport),
 		libp2p.ResourceManager(resourceManager),
 	}
 
+	// security and multiplexing of TCP connections fall back to libp2p's defaults
+	if transport == TransportTCP {
+		opts = append(opts, libp2p.Transport(tcp.NewTCPTransport))
+	} else {
+		opts = append(opts, libp2p.Transport(quic.NewTransport))
+	}
+
 	if connGaterEnabled {
 		opts = append(opts, libp2p.ConnectionGater(connGater))
 	}
 
 	// We listen on `listenAddr` but advertise `externalAddr` to peers
 	if config.ExternalAddress != "" {
-		externalAddr, err := AddressToMultiAddr(config.ExternalAddress, TransportQUIC)
+		externalAddr, err := AddressToMultiAddr(config.ExternalAddress, transport)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert %q to multiaddr: %w", config.ExternalAddress, err)
 		}
@@ -168,9 +194,10 @@ func (h *Host) EmitPeerFailure(id peer.ID, err error) {
 
 func BootstrapPeersFromConfig(config config.LibP2PConfig) (map[peer.ID]BootstrapPeer, error) {
 	peers := make(map[peer.ID]BootstrapPeer, len(config.BootstrapPeers))
+	transport := TransportFromConfig(config)
 
 	for _, bp := range config.BootstrapPeers {
-		addr, err := AddrInfoFromHostAndID(bp.Host, bp.ID)
+		addr, err := AddrInfoFromHostAndID(bp.Host, bp.ID, transport)
 		if err != nil {
 			return nil, fmt.Errorf("[%s, %s]: %w", bp.Host, bp.ID, err)
 		}
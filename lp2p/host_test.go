@@ -214,6 +214,36 @@ func TestHost(t *testing.T) {
 	})
 }
 
+func TestHostTCP(t *testing.T) {
+	// ARRANGE
+	ctx := context.Background()
+	withTCP := withModifiedConfig(func(cfg *config.LibP2PConfig) {
+		cfg.Transport = config.LibP2PTransportTCP
+	})
+
+	// Given 2 available ports
+	ports := utils.GetFreePorts(t, 2)
+
+	// Given two hosts using the TCP transport
+	host1 := makeTestHost(t, ports[0], withTCP)
+	host2 := makeTestHost(t, ports[1], withTCP, withBootstrapPeers([]config.LibP2PBootstrapPeer{
+		{
+			Host: fmt.Sprintf("127.0.0.1:%d", ports[0]),
+			ID:   host1.ID().String(),
+		},
+	}))
+
+	// ACT
+	connectBootstrapPeers(t, ctx, host2, host2.BootstrapPeers())
+
+	// ASSERT
+	require.Equal(t, fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", ports[0]), host1.Addrs()[0].String())
+
+	conns := host2.Network().ConnsToPeer(host1.ID())
+	require.Len(t, conns, 1)
+	require.Equal(t, TransportTCP, conns[0].ConnState().Transport)
+}
+
 func TestHostConnGater(t *testing.T) {
 	t.Run("default", func(t *testing.T) {
 		// ARRANGE
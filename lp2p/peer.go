@@ -211,14 +211,32 @@ func (p *Peer) handleSendErr(err error) {
 
 // NodeInfo returns a DefaultNodeInfo populated with the peer's ID and address.
 // Since libp2p does not perform a CometBFT-style handshake, only the fields
-// derivable from the connection are filled in (ID, listen address).
+// derivable from the connection are filled in (ID, listen address), and the
+// channels of the protocols the peer announced with libp2p's identify
+// protocol, once known.
 func (p *Peer) NodeInfo() p2p.NodeInfo {
 	return p2p.DefaultNodeInfo{
 		DefaultNodeID: p.ID(),
 		ListenAddr:    p.netAddr.DialString(),
+		Channels:      p.channels(),
 	}
 }
 
+// channels returns the channels of the protocols supported by the peer.
+func (p *Peer) channels() []byte {
+	protocols, err := p.host.Peerstore().GetProtocols(p.addrInfo.ID)
+	if err != nil {
+		return nil
+	}
+	var channels []byte
+	for _, id := range protocols {
+		if ch, ok := ChannelFromProtocolID(id); ok {
+			channels = append(channels, ch)
+		}
+	}
+	return channels
+}
+
 // RemoteIP returns the remote IP address of the peer derived from its address info.
 func (p *Peer) RemoteIP() net.IP {
 	return p.netAddr.IP
@@ -31,6 +31,16 @@ func ProtocolID(channelID byte) protocol.ID {
 	)
 }
 
+// ChannelFromProtocolID returns the channel of a protocol ID returned by
+// ProtocolID, or false if it is not the protocol of a channel.
+func ChannelFromProtocolID(id protocol.ID) (byte, bool) {
+	var ch byte
+	if _, err := fmt.Sscanf(string(id), ProtocolIDPrefix+"/channel/0x%02x", &ch); err != nil {
+		return 0, false
+	}
+	return ch, ProtocolID(ch) == id
+}
+
 // StreamWrite sends payload over a stream w/o waiting for a response.
 // Only guarantees that the recipient will receive the bytes (no "message processed" guarantee).
 // It doesn't control stream's lifecycle, so it's up to the caller to close the stream.
@@ -218,6 +218,20 @@ func TestProtocolID(t *testing.T) {
 		{channel: 0xff, expected: "/p2p/cometbft/1.0.0/channel/0xff"},
 	} {
 		require.Equal(t, protocol.ID(tt.expected), ProtocolID(tt.channel))
+
+		ch, ok := ChannelFromProtocolID(protocol.ID(tt.expected))
+		require.True(t, ok)
+		require.Equal(t, tt.channel, ch)
+	}
+
+	for _, id := range []string{
+		"/ipfs/id/1.0.0",
+		"/p2p/cometbft/1.0.0/channel/0x",
+		"/p2p/cometbft/1.0.0/channel/0x1",
+		"/p2p/cometbft/1.0.0/channel/0xaa/extra",
+	} {
+		_, ok := ChannelFromProtocolID(protocol.ID(id))
+		require.False(t, ok, id)
 	}
 }
 
@@ -174,6 +174,11 @@ func (s *Switch) Log() log.Logger {
 	return s.Logger
 }
 
+// Host returns the underlying libp2p host.
+func (s *Switch) Host() *Host {
+	return s.host
+}
+
 //--------------------------------
 // ReactorManager methods
 //--------------------------------
@@ -374,6 +379,16 @@ func (s *Switch) handleStream(stream network.Stream) {
 		return
 	}
 
+	if !s.host.acceptsStreamFrom(peerID) {
+		s.Log().Debug(
+			"Rejecting stream from partitioned peer",
+			"peer_id", peerID.String(),
+			"protocol", protocolID,
+		)
+		_ = stream.Reset()
+		return
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
 			s.Logger.Error(
@@ -1,7 +1,6 @@
 package mempool
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"sync"
@@ -360,6 +359,15 @@ func (mem *CListMempool) addTx(memTx *mempoolTx) {
 	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
 }
 
+// GetTxByKey returns the transaction identified by its key, if it's in the
+// mempool. Safe for concurrent use.
+func (mem *CListMempool) GetTxByKey(txKey types.TxKey) (types.Tx, bool) {
+	if memTx := mem.getMemTx(txKey); memTx != nil {
+		return memTx.tx, true
+	}
+	return nil, false
+}
+
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
 // Called from:
 //   - Update (lock held) if tx was committed
@@ -479,9 +487,8 @@ func (mem *CListMempool) resCbFirstTime(
 // The case where the app checks the tx for the first time is handled by the
 // resCbFirstTime callback.
 func (mem *CListMempool) resCbRecheck(tx types.Tx, res *abci.ResponseCheckTx) {
-	// Check whether tx is still in the list of transactions that can be rechecked.
-	if !mem.recheck.findNextEntryMatching(&tx) {
-		// Reached the end of the list and didn't find a matching tx; rechecking has finished.
+	// Check whether tx is still pending recheck.
+	if !mem.recheck.markRechecked(tx) {
 		return
 	}
 
@@ -652,13 +659,12 @@ func (mem *CListMempool) recheckTxs() {
 		return
 	}
 
-	mem.recheck.init(mem.txs.Front(), mem.txs.Back())
+	mem.recheck.init(mem.txs.Front())
 
 	// NOTE: globalCb may be called concurrently, but CheckTx cannot be executed concurrently
 	// because this function has the lock (via Update and Lock).
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
 		tx := e.Value.(*mempoolTx).tx
-		mem.recheck.numPendingTxs.Add(1)
 
 		// Send a CheckTx request to the app. If we're using a sync client, the resCbRecheck
 		// callback will be called right after receiving the response.
@@ -689,19 +695,17 @@ func (mem *CListMempool) recheckTxs() {
 	mem.logger.Debug("done rechecking txs", "height", mem.height.Load(), "num-txs", mem.Size())
 }
 
-// The cursor and end pointers define a dynamic list of transactions that could be rechecked. The
-// end pointer is fixed. When a recheck response for a transaction is received, cursor will point to
-// the entry in the mempool corresponding to that transaction, thus narrowing the list. Transactions
-// corresponding to entries between the old and current positions of cursor will be ignored for
-// rechecking. This is to guarantee that recheck responses are processed in the same sequential
-// order as they appear in the mempool.
+// recheck tracks the transactions sent to the app for rechecking. Recheck responses are matched to
+// the pending transactions by key, so that they can be processed in any order, e.g. when the
+// responses of a multiplexed ABCI connection arrive out of order. Transactions whose recheck
+// response doesn't arrive before rechecking finishes are not rechecked.
 type recheck struct {
-	cursor        *clist.CElement // next expected recheck response
-	end           *clist.CElement // last entry in the mempool to recheck
-	doneCh        chan struct{}   // to signal that rechecking has finished successfully (for async app connections)
-	numPendingTxs atomic.Int32    // number of transactions still pending to recheck
-	isRechecking  atomic.Bool     // true iff the rechecking process has begun and is not yet finished
-	recheckFull   atomic.Bool     // whether rechecking TXs cannot be completed before a new block is decided
+	mtx           sync.Mutex
+	pending       map[types.TxKey]struct{} // txs whose recheck response is still expected
+	doneCh        chan struct{}            // to signal that rechecking has finished successfully (for async app connections)
+	numPendingTxs atomic.Int32             // number of transactions still pending to recheck
+	isRechecking  atomic.Bool              // true iff the rechecking process has begun and is not yet finished
+	recheckFull   atomic.Bool              // whether rechecking TXs cannot be completed before a new block is decided
 }
 
 func newRecheck() *recheck {
@@ -710,13 +714,19 @@ func newRecheck() *recheck {
 	}
 }
 
-func (rc *recheck) init(first, last *clist.CElement) {
+// init starts rechecking the txs of the list starting at first.
+func (rc *recheck) init(first *clist.CElement) {
 	if !rc.done() {
 		panic("Having more than one rechecking process at a time is not possible.")
 	}
-	rc.cursor = first
-	rc.end = last
-	rc.numPendingTxs.Store(0)
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+
+	rc.pending = make(map[types.TxKey]struct{})
+	for e := first; e != nil; e = e.Next() {
+		rc.pending[e.Value.(*mempoolTx).tx.Key()] = struct{}{}
+	}
+	rc.numPendingTxs.Store(int32(len(rc.pending)))
 	rc.isRechecking.Store(true)
 }
 
@@ -728,58 +738,43 @@ func (rc *recheck) done() bool {
 
 // setDone registers that rechecking has finished.
 func (rc *recheck) setDone() {
-	rc.cursor = nil
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+	rc.setDoneLocked()
+}
+
+func (rc *recheck) setDoneLocked() {
+	rc.pending = nil
 	rc.recheckFull.Store(false)
 	rc.isRechecking.Store(false)
 }
 
-// setNextEntry sets cursor to the next entry in the list. If there is no next, cursor will be nil.
-func (rc *recheck) setNextEntry() {
-	rc.cursor = rc.cursor.Next()
-}
+// markRechecked registers the recheck response of tx. It returns false if tx is not pending
+// recheck, e.g. because its response already arrived or rechecking has finished. When the last
+// pending response arrives, it finishes rechecking and notifies doneRechecking.
+func (rc *recheck) markRechecked(tx types.Tx) bool {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
 
-// tryFinish will check if the cursor is at the end of the list and notify the channel that
-// rechecking has finished. It returns true iff it's done rechecking.
-func (rc *recheck) tryFinish() bool {
-	if rc.cursor == rc.end {
-		// Reached end of the list without finding a matching tx.
-		rc.setDone()
-	}
 	if rc.done() {
+		return false
+	}
+	key := tx.Key()
+	if _, ok := rc.pending[key]; !ok {
+		return false
+	}
+	delete(rc.pending, key)
+	rc.numPendingTxs.Add(-1)
+
+	if len(rc.pending) == 0 {
+		rc.setDoneLocked()
 		// Notify that recheck has finished.
 		select {
 		case rc.doneCh <- struct{}{}:
 		default:
 		}
-		return true
-	}
-	return false
-}
-
-// findNextEntryMatching searches for the next transaction matching the given transaction, which
-// corresponds to the recheck response to be processed next. Then it checks if it has reached the
-// end of the list, so it can finish rechecking.
-//
-// The goal is to guarantee that transactions are rechecked in the order in which they are in the
-// mempool. Transactions whose recheck response arrive late or don't arrive at all are skipped and
-// not rechecked.
-func (rc *recheck) findNextEntryMatching(tx *types.Tx) bool {
-	found := false
-	for ; !rc.done(); rc.setNextEntry() {
-		expectedTx := rc.cursor.Value.(*mempoolTx).tx
-		if bytes.Equal(*tx, expectedTx) {
-			// Found an entry in the list of txs to recheck that matches tx.
-			found = true
-			rc.numPendingTxs.Add(-1)
-			break
-		}
-	}
-
-	if !rc.tryFinish() {
-		// Not finished yet; set the cursor for processing the next recheck response.
-		rc.setNextEntry()
 	}
-	return found
+	return true
 }
 
 // doneRechecking returns the channel used to signal that rechecking has finished.
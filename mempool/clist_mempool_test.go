@@ -896,8 +896,7 @@ func TestMempoolAsyncRecheckTxReturnError(t *testing.T) {
 
 	// Check that recheck has not started.
 	require.True(t, mp.recheck.done())
-	require.Nil(t, mp.recheck.cursor)
-	require.Nil(t, mp.recheck.end)
+	require.Empty(t, mp.recheck.pending)
 	require.False(t, mp.recheck.isRechecking.Load())
 	mockClient.AssertExpectations(t)
 
@@ -921,15 +920,61 @@ func TestMempoolAsyncRecheckTxReturnError(t *testing.T) {
 	mp.recheckTxs()
 	require.True(t, mp.recheck.done())
 	require.False(t, mp.recheck.isRechecking.Load())
-	require.Nil(t, mp.recheck.cursor)
-	require.NotNil(t, mp.recheck.end)
-	require.Equal(t, mp.recheck.end, mp.txs.Back())
+	require.Empty(t, mp.recheck.pending)
 	require.Equal(t, len(txs)-1, mp.Size()) // one invalid tx was removed
 	require.Equal(t, int32(2), mp.recheck.numPendingTxs.Load())
 
 	mockClient.AssertExpectations(t)
 }
 
+// Test that recheck responses arriving out of order, e.g. from a multiplexed ABCI connection, are
+// all processed.
+func TestMempoolAsyncRecheckTxOutOfOrder(t *testing.T) {
+	var callback abciclient.Callback
+	mockClient := new(abciclimocks.Client)
+	mockClient.On("Start").Return(nil)
+	mockClient.On("SetLogger", mock.Anything)
+	mockClient.On("Error").Return(nil).Times(4)
+	mockClient.On("SetResponseCallback", mock.MatchedBy(func(cb abciclient.Callback) bool { callback = cb; return true }))
+
+	mp, cleanup, err := newMempoolWithAppMock(mockClient)
+	require.NoError(t, err)
+	defer cleanup()
+
+	txs := []types.Tx{[]byte{0x01}, []byte{0x02}, []byte{0x03}, []byte{0x04}}
+	for _, tx := range txs {
+		reqRes := newReqRes(tx, abci.CodeTypeOK, abci.CheckTxType_New)
+		mockClient.On("CheckTxAsync", mock.Anything, mock.Anything).Return(reqRes, nil).Once()
+		err := mp.CheckTx(tx, nil, TxInfo{})
+		require.NoError(t, err)
+		reqRes.InvokeCallback()
+	}
+	require.Len(t, txs, mp.Size())
+
+	mockClient.On("CheckTxAsync", mock.Anything, mock.Anything).Return(nil, nil).Times(4)
+
+	// The app replies in reverse order, and the second tx became invalid.
+	mockClient.On("Flush", mock.Anything).Run(func(_ mock.Arguments) {
+		for i := len(txs) - 1; i >= 0; i-- {
+			code := abci.CodeTypeOK
+			if i == 1 {
+				code = 1
+			}
+			reqRes := newReqRes(txs[i], code, abci.CheckTxType_Recheck)
+			callback(reqRes.Request, reqRes.Response)
+		}
+	}).Return(nil)
+
+	mp.recheckTxs()
+	require.True(t, mp.recheck.done())
+	require.Zero(t, mp.recheck.numPendingTxs.Load())
+	require.Equal(t, len(txs)-1, mp.Size())
+	_, ok := mp.GetTxByKey(txs[1].Key())
+	require.False(t, ok)
+
+	mockClient.AssertExpectations(t)
+}
+
 // This test used to cause a data race when rechecking (see https://github.com/cometbft/cometbft/issues/1827).
 func TestMempoolRecheckRace(t *testing.T) {
 	mp, cleanup := newMempoolWithAsyncConnection(t)
@@ -948,10 +993,10 @@ func TestMempoolRecheckRace(t *testing.T) {
 
 	// Recheck has finished
 	require.True(t, mp.recheck.done())
-	require.Nil(t, mp.recheck.cursor)
+	require.Empty(t, mp.recheck.pending)
 
 	// Add again the same transaction that was updated. Recheck has finished so adding this tx
-	// should not result in a data race on the variable recheck.cursor.
+	// should not result in a data race on the variable recheck.pending.
 	err = mp.CheckTx(txs[:1][0], nil, TxInfo{})
 	require.Equal(t, err, ErrTxInCache)
 	require.Zero(t, mp.recheck.numPendingTxs.Load())
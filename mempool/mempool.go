@@ -37,6 +37,10 @@ type Mempool interface {
 	// from the mempool.
 	RemoveTxByKey(txKey types.TxKey) error
 
+	// GetTxByKey returns the transaction identified by its key, if it's in the
+	// mempool.
+	GetTxByKey(txKey types.TxKey) (types.Tx, bool)
+
 	// ReapMaxBytesMaxGas reaps transactions from the mempool up to maxBytes
 	// bytes total with the condition that the total gasWanted must be less than
 	// maxGas.
@@ -62,6 +62,36 @@ func (_m *Mempool) FlushAppConn() error {
 	return r0
 }
 
+// GetTxByKey provides a mock function with given fields: txKey
+func (_m *Mempool) GetTxByKey(txKey types.TxKey) (types.Tx, bool) {
+	ret := _m.Called(txKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTxByKey")
+	}
+
+	var r0 types.Tx
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.TxKey) (types.Tx, bool)); ok {
+		return rf(txKey)
+	}
+	if rf, ok := ret.Get(0).(func(types.TxKey) types.Tx); ok {
+		r0 = rf(txKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Tx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.TxKey) bool); ok {
+		r1 = rf(txKey)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // Lock provides a mock function with no fields
 func (_m *Mempool) Lock() {
 	_m.Called()
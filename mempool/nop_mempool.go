@@ -28,6 +28,9 @@ func (*NopMempool) CheckTx(types.Tx, func(*abci.ResponseCheckTx), TxInfo) error
 // RemoveTxByKey always returns an error.
 func (*NopMempool) RemoveTxByKey(types.TxKey) error { return errNotAllowed }
 
+// GetTxByKey always returns false.
+func (*NopMempool) GetTxByKey(types.TxKey) (types.Tx, bool) { return nil, false }
+
 // ReapMaxBytesMaxGas always returns nil.
 func (*NopMempool) ReapMaxBytesMaxGas(int64, int64) types.Txs { return nil }
 
@@ -0,0 +1,32 @@
+/*
+Package monitor provides a service watching several CometBFT nodes for
+misbehaviour of the validators.
+
+Validators detect the conflicting votes they receive themselves, and light
+clients detect the conflicting headers served by their witnesses, but an
+operator who doesn't run a validator has no way to catch an equivocation
+observed by some nodes of the network only. The Monitor type subscribes to the
+votes and the new block headers of multiple nodes over RPC, and cross-checks
+what each of them saw:
+
+  - two votes signed by the same validator for different blocks at the same
+    height, round and step form DuplicateVoteEvidence, verified with
+    evidence.VerifyDuplicateVote against the validator set of the height.
+  - two different headers committed at the same height form
+    LightClientAttackEvidence, verified with evidence.VerifyLightClientAttack.
+
+The evidence is submitted with BroadcastEvidence: the duplicate votes to every
+node, and the conflicting header to the nodes which committed the other one.
+
+The Monitor's lifecycle is controlled by a context.Context
+
+	m := monitor.New(chainID, clients, logger)
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	// Run blocks until the context is canceled.
+	go m.Run(ctx)
+	...
+
+	cancelFunc()
+*/
+package monitor
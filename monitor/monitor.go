@@ -0,0 +1,401 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/evidence"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light/provider"
+	lighthttp "github.com/cometbft/cometbft/light/provider/http"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// retainHeights is the number of heights below the latest one whose votes
+	// and headers are kept for cross-checking.
+	retainHeights = 100
+
+	subscriber = "monitor"
+)
+
+// node is a node watched by the monitor.
+type node struct {
+	events   rpcclient.EventsClient
+	provider provider.Provider
+}
+
+type voteKey struct {
+	height  int64
+	round   int32
+	msgType cmtproto.SignedMsgType
+	address string
+}
+
+// headerView is a header committed at some height, along with the indexes of
+// the nodes which committed it.
+type headerView struct {
+	lightBlock *types.LightBlock
+	nodes      []int
+}
+
+// attack is a header conflicting with the one committed by the receivers.
+type attack struct {
+	conflicting *types.LightBlock
+	trusted     *types.LightBlock
+	common      *types.LightBlock
+	receivers   []int
+}
+
+// Monitor watches the votes and headers of several nodes, and submits evidence
+// of the misbehaviour it detects to them.
+type Monitor struct {
+	chainID string
+	nodes   []*node
+	logger  log.Logger
+
+	mtx          sync.Mutex
+	latestHeight int64
+	votes        map[voteKey]*types.Vote
+	pending      map[int64][]*types.Vote // waiting for the header of their height
+	headers      map[int64][]*headerView
+	reported     map[string]int64 // evidence hash => height
+}
+
+// New returns a Monitor watching the nodes served by the given clients, which
+// must be started so that they can subscribe to events.
+func New(chainID string, clients []rpcclient.RemoteClient, logger log.Logger) *Monitor {
+	nodes := make([]*node, len(clients))
+	for i, c := range clients {
+		nodes[i] = &node{events: c, provider: lighthttp.NewWithClient(chainID, c)}
+	}
+	return newMonitor(chainID, nodes, logger)
+}
+
+func newMonitor(chainID string, nodes []*node, logger log.Logger) *Monitor {
+	return &Monitor{
+		chainID:  chainID,
+		nodes:    nodes,
+		logger:   logger,
+		votes:    make(map[voteKey]*types.Vote),
+		pending:  make(map[int64][]*types.Vote),
+		headers:  make(map[int64][]*headerView),
+		reported: make(map[string]int64),
+	}
+}
+
+// Run subscribes to the votes and new block headers of the nodes and
+// cross-checks them until the context is canceled.
+func (m *Monitor) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for i, n := range m.nodes {
+		votes, err := n.events.Subscribe(ctx, subscriber, types.EventQueryVote.String())
+		if err != nil {
+			return fmt.Errorf("subscribing to votes of %v: %w", n.provider, err)
+		}
+		headers, err := n.events.Subscribe(ctx, subscriber, types.EventQueryNewBlockHeader.String())
+		if err != nil {
+			return fmt.Errorf("subscribing to headers of %v: %w", n.provider, err)
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.watch(ctx, i, votes, headers)
+		}(i)
+	}
+
+	m.logger.Info("Monitoring nodes for misbehaviour", "nodes", len(m.nodes))
+	<-ctx.Done()
+	return nil
+}
+
+func (m *Monitor) watch(ctx context.Context, i int, votes, headers <-chan ctypes.ResultEvent) {
+	n := m.nodes[i]
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case ev, ok := <-votes:
+			if !ok {
+				m.logger.Error("Votes subscription closed", "node", n.provider)
+				return
+			}
+			if data, ok := ev.Data.(types.EventDataVote); ok {
+				m.handleVote(ctx, data.Vote)
+			}
+
+		case ev, ok := <-headers:
+			if !ok {
+				m.logger.Error("Headers subscription closed", "node", n.provider)
+				return
+			}
+			data, ok := ev.Data.(types.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+			lb, err := n.provider.LightBlock(ctx, data.Header.Height)
+			if err != nil {
+				m.logger.Error("Failed to fetch light block", "node", n.provider, "height", data.Header.Height, "err", err)
+				continue
+			}
+			m.handleLightBlock(ctx, i, lb)
+		}
+	}
+}
+
+// handleVote records the given vote once its signature is verified against
+// the validators of its height, and reports it along with the vote of the
+// same validator for a different block at the same height, round and step if
+// any. The vote is held until the header of its height is committed.
+func (m *Monitor) handleVote(ctx context.Context, vote *types.Vote) {
+	m.mtx.Lock()
+	if vote.Height+retainHeights < m.latestHeight {
+		m.mtx.Unlock()
+		return
+	}
+
+	views := m.headers[vote.Height]
+	if len(views) == 0 {
+		m.pending[vote.Height] = append(m.pending[vote.Height], vote)
+		m.mtx.Unlock()
+		return
+	}
+	lb := views[0].lightBlock
+	m.mtx.Unlock()
+
+	m.addVote(ctx, vote, lb)
+}
+
+// addVote verifies the given vote against the validators of the given light
+// block, at the height of the vote, and records it.
+func (m *Monitor) addVote(ctx context.Context, vote *types.Vote, lb *types.LightBlock) {
+	_, val := lb.ValidatorSet.GetByAddress(vote.ValidatorAddress)
+	if val == nil {
+		return
+	}
+	if err := vote.Verify(m.chainID, val.PubKey); err != nil {
+		m.logger.Error("Invalid vote", "vote", vote, "err", err)
+		return
+	}
+
+	key := voteKey{
+		height:  vote.Height,
+		round:   vote.Round,
+		msgType: vote.Type,
+		address: string(vote.ValidatorAddress),
+	}
+	m.mtx.Lock()
+	other, ok := m.votes[key]
+	if !ok {
+		m.votes[key] = vote
+	}
+	m.mtx.Unlock()
+	if !ok || other.BlockID.Equals(vote.BlockID) {
+		return
+	}
+
+	m.reportDuplicateVote(ctx, other, vote, lb)
+}
+
+// handleLightBlock records the given light block committed by the i-th node,
+// and reports it if it conflicts with the one committed by other nodes.
+func (m *Monitor) handleLightBlock(ctx context.Context, i int, lb *types.LightBlock) {
+	m.mtx.Lock()
+	if lb.Height+retainHeights < m.latestHeight {
+		m.mtx.Unlock()
+		return
+	}
+
+	var (
+		attacks []attack
+		known   bool
+	)
+	views := m.headers[lb.Height]
+	for _, view := range views {
+		if bytes.Equal(view.lightBlock.Hash(), lb.Hash()) {
+			if !slices.Contains(view.nodes, i) {
+				view.nodes = append(view.nodes, i)
+			}
+			known = true
+			break
+		}
+	}
+	if !known {
+		view := &headerView{lightBlock: lb, nodes: []int{i}}
+		for _, other := range views {
+			attacks = append(attacks, m.attacks(view, other)...)
+		}
+		m.headers[lb.Height] = append(views, view)
+	}
+
+	votes := m.pending[lb.Height]
+	delete(m.pending, lb.Height)
+
+	if lb.Height > m.latestHeight {
+		m.latestHeight = lb.Height
+		m.prune()
+	}
+	m.mtx.Unlock()
+
+	for _, vote := range votes {
+		m.addVote(ctx, vote, lb)
+	}
+	for _, a := range attacks {
+		m.reportLightClientAttack(ctx, a)
+	}
+}
+
+// attacks returns the attacks of each of the given conflicting headers on the
+// nodes which committed the other one.
+//
+// CONTRACT: mtx must be held.
+func (m *Monitor) attacks(a, b *headerView) []attack {
+	var attacks []attack
+	for _, pair := range [][2]*headerView{{a, b}, {b, a}} {
+		conflicting, trusted := pair[0].lightBlock, pair[1].lightBlock
+		common := trusted
+		// If the conflicting header can't be derived from the trusted
+		// validators, this is a lunatic attack, which is verified from the
+		// latest header both sides agree on.
+		ev := &types.LightClientAttackEvidence{ConflictingBlock: conflicting}
+		if ev.ConflictingHeaderIsInvalid(trusted.Header) {
+			common = m.commonLightBlock(conflicting.Height, pair[0].nodes, pair[1].nodes)
+			if common == nil {
+				m.logger.Error("Detected conflicting headers without a common header",
+					"height", conflicting.Height, "conflicting", conflicting.Hash(), "trusted", trusted.Hash())
+				continue
+			}
+		}
+		attacks = append(attacks, attack{
+			conflicting: conflicting,
+			trusted:     trusted,
+			common:      common,
+			receivers:   slices.Clone(pair[1].nodes),
+		})
+	}
+	return attacks
+}
+
+// commonLightBlock returns the latest light block below the given height
+// committed by at least one node of each given group.
+//
+// CONTRACT: mtx must be held.
+func (m *Monitor) commonLightBlock(height int64, a, b []int) *types.LightBlock {
+	for h := height - 1; h > 0 && h+retainHeights >= m.latestHeight; h-- {
+		for _, view := range m.headers[h] {
+			if slices.ContainsFunc(view.nodes, func(i int) bool { return slices.Contains(a, i) }) &&
+				slices.ContainsFunc(view.nodes, func(i int) bool { return slices.Contains(b, i) }) {
+				return view.lightBlock
+			}
+		}
+	}
+	return nil
+}
+
+// prune removes the votes and headers which are no longer retained.
+//
+// CONTRACT: mtx must be held.
+func (m *Monitor) prune() {
+	minHeight := m.latestHeight - retainHeights
+	for key := range m.votes {
+		if key.height < minHeight {
+			delete(m.votes, key)
+		}
+	}
+	for h := range m.pending {
+		if h < minHeight {
+			delete(m.pending, h)
+		}
+	}
+	for h := range m.headers {
+		if h < minHeight {
+			delete(m.headers, h)
+		}
+	}
+	for hash, h := range m.reported {
+		if h < minHeight {
+			delete(m.reported, hash)
+		}
+	}
+}
+
+func (m *Monitor) reportDuplicateVote(ctx context.Context, voteA, voteB *types.Vote, lb *types.LightBlock) {
+	ev, err := types.NewDuplicateVoteEvidence(voteA, voteB, lb.Time, lb.ValidatorSet)
+	if err != nil {
+		m.logger.Error("Failed to create duplicate vote evidence", "voteA", voteA, "voteB", voteB, "err", err)
+		return
+	}
+	if err := ev.ValidateBasic(); err != nil {
+		m.logger.Error("Invalid duplicate vote evidence", "evidence", ev, "err", err)
+		return
+	}
+	if err := evidence.VerifyDuplicateVote(ev, m.chainID, lb.ValidatorSet); err != nil {
+		m.logger.Error("Failed to verify duplicate vote evidence", "evidence", ev, "err", err)
+		return
+	}
+
+	receivers := make([]int, len(m.nodes))
+	for i := range m.nodes {
+		receivers[i] = i
+	}
+	m.report(ctx, ev, receivers)
+}
+
+func (m *Monitor) reportLightClientAttack(ctx context.Context, a attack) {
+	ev := &types.LightClientAttackEvidence{ConflictingBlock: a.conflicting}
+	if ev.ConflictingHeaderIsInvalid(a.trusted.Header) {
+		ev.CommonHeight = a.common.Height
+		ev.Timestamp = a.common.Time
+		ev.TotalVotingPower = a.common.ValidatorSet.TotalVotingPower()
+	} else {
+		ev.CommonHeight = a.trusted.Height
+		ev.Timestamp = a.trusted.Time
+		ev.TotalVotingPower = a.trusted.ValidatorSet.TotalVotingPower()
+	}
+	ev.ByzantineValidators = ev.GetByzantineValidators(a.common.ValidatorSet, a.trusted.SignedHeader)
+
+	if err := ev.ValidateBasic(); err != nil {
+		m.logger.Error("Invalid light client attack evidence", "evidence", ev, "err", err)
+		return
+	}
+	// The current time and trusting period aren't used by the verification.
+	err := evidence.VerifyLightClientAttack(ev, a.common.SignedHeader, a.trusted.SignedHeader,
+		a.common.ValidatorSet, time.Now(), 0)
+	if err != nil {
+		m.logger.Error("Failed to verify light client attack evidence", "evidence", ev, "err", err)
+		return
+	}
+
+	m.report(ctx, ev, a.receivers)
+}
+
+// report submits the given evidence to the given nodes, unless it was already
+// reported.
+func (m *Monitor) report(ctx context.Context, ev types.Evidence, receivers []int) {
+	m.mtx.Lock()
+	if _, ok := m.reported[string(ev.Hash())]; ok {
+		m.mtx.Unlock()
+		return
+	}
+	m.reported[string(ev.Hash())] = ev.Height()
+	m.mtx.Unlock()
+
+	m.logger.Info("Detected misbehaviour", "evidence", ev)
+	for _, i := range receivers {
+		n := m.nodes[i]
+		if err := n.provider.ReportEvidence(ctx, ev); err != nil {
+			m.logger.Error("Failed to submit evidence", "node", n.provider, "evidence", ev, "err", err)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light/provider/mock"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+const chainID = "monitor-chain"
+
+var defaultTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestMonitorReportsDuplicateVotes(t *testing.T) {
+	ctx := context.Background()
+	m, providers := newTestMonitor(3)
+	vals, privVals := types.RandValidatorSet(4, 10)
+	height := int64(10)
+
+	voteA, err := types.MakeVote(privVals[0], chainID, 0, height, 0, cmtproto.PrevoteType,
+		test.MakeBlockID(), defaultTime)
+	require.NoError(t, err)
+	voteB, err := types.MakeVote(privVals[0], chainID, 0, height, 0, cmtproto.PrevoteType,
+		test.MakeBlockID(), defaultTime)
+	require.NoError(t, err)
+
+	m.handleVote(ctx, voteA)
+	m.handleVote(ctx, voteA)
+	m.handleVote(ctx, voteB)
+
+	// the evidence is only formed once the height is committed
+	lb := makeLightBlock(t, height, vals, privVals, nil)
+	ev, err := types.NewDuplicateVoteEvidence(voteA, voteB, lb.Time, vals)
+	require.NoError(t, err)
+	for _, p := range providers {
+		assert.False(t, p.HasEvidence(ev))
+	}
+
+	m.handleLightBlock(ctx, 0, lb)
+	for _, p := range providers {
+		assert.True(t, p.HasEvidence(ev))
+	}
+
+	// votes signed by a non validator are ignored
+	_, otherPrivVals := types.RandValidatorSet(1, 10)
+	voteC, err := types.MakeVote(otherPrivVals[0], chainID, 0, height, 0, cmtproto.PrecommitType,
+		test.MakeBlockID(), defaultTime)
+	require.NoError(t, err)
+	voteD, err := types.MakeVote(otherPrivVals[0], chainID, 0, height, 0, cmtproto.PrecommitType,
+		test.MakeBlockID(), defaultTime)
+	require.NoError(t, err)
+	m.handleVote(ctx, voteC)
+	m.handleVote(ctx, voteD)
+	for _, p := range providers {
+		assert.Len(t, p.reported, 1)
+	}
+}
+
+func TestMonitorIgnoresForgedVotes(t *testing.T) {
+	ctx := context.Background()
+	m, providers := newTestMonitor(3)
+	vals, privVals := types.RandValidatorSet(4, 10)
+	height := int64(10)
+
+	m.handleLightBlock(ctx, 0, makeLightBlock(t, height, vals, privVals, nil))
+
+	// a vote of the validator for another block, with an invalid signature
+	forged, err := types.MakeVote(privVals[0], chainID, 0, height, 0, cmtproto.PrevoteType,
+		test.MakeBlockID(), defaultTime)
+	require.NoError(t, err)
+	forged.BlockID = test.MakeBlockID()
+	voteA, err := types.MakeVote(privVals[0], chainID, 0, height, 0, cmtproto.PrevoteType,
+		test.MakeBlockID(), defaultTime)
+	require.NoError(t, err)
+	voteB, err := types.MakeVote(privVals[0], chainID, 0, height, 0, cmtproto.PrevoteType,
+		test.MakeBlockID(), defaultTime)
+	require.NoError(t, err)
+
+	m.handleVote(ctx, forged)
+	m.handleVote(ctx, voteA)
+	m.handleVote(ctx, voteB)
+
+	ev, err := types.NewDuplicateVoteEvidence(voteA, voteB, defaultTime, vals)
+	require.NoError(t, err)
+	for _, p := range providers {
+		assert.Len(t, p.reported, 1)
+		assert.True(t, p.HasEvidence(ev))
+	}
+}
+
+func TestMonitorReportsConflictingHeaders(t *testing.T) {
+	ctx := context.Background()
+	m, providers := newTestMonitor(3)
+	vals, privVals := types.RandValidatorSet(4, 10)
+	height := int64(10)
+
+	lbA := makeLightBlock(t, height, vals, privVals, nil)
+	// an equivocation: the header only differs in the block's data
+	header := *lbA.Header
+	header.DataHash = test.RandomHash()
+	lbB := makeLightBlock(t, height, vals, privVals, &header)
+
+	m.handleLightBlock(ctx, 0, lbA)
+	m.handleLightBlock(ctx, 1, lbA)
+	for _, p := range providers {
+		assert.Empty(t, p.reported)
+	}
+
+	m.handleLightBlock(ctx, 2, lbB)
+
+	evA := &types.LightClientAttackEvidence{ConflictingBlock: lbA, CommonHeight: height}
+	evB := &types.LightClientAttackEvidence{ConflictingBlock: lbB, CommonHeight: height}
+	// the nodes which committed A receive the evidence of B, and vice versa
+	for _, p := range providers[:2] {
+		assert.True(t, p.HasEvidence(evB))
+		assert.False(t, p.HasEvidence(evA))
+	}
+	assert.True(t, providers[2].HasEvidence(evA))
+	assert.False(t, providers[2].HasEvidence(evB))
+
+	for _, ev := range providers[0].reported {
+		lcae := ev.(*types.LightClientAttackEvidence)
+		assert.Len(t, lcae.ByzantineValidators, 4)
+		assert.Equal(t, vals.TotalVotingPower(), lcae.TotalVotingPower)
+		assert.Equal(t, lbA.Time, lcae.Timestamp)
+	}
+}
+
+// reportingProvider records the evidence reported to it.
+type reportingProvider struct {
+	*mock.Mock
+	reported []types.Evidence
+}
+
+func (p *reportingProvider) ReportEvidence(ctx context.Context, ev types.Evidence) error {
+	p.reported = append(p.reported, ev)
+	return p.Mock.ReportEvidence(ctx, ev)
+}
+
+func newTestMonitor(n int) (*Monitor, []*reportingProvider) {
+	nodes := make([]*node, n)
+	providers := make([]*reportingProvider, n)
+	for i := range nodes {
+		providers[i] = &reportingProvider{
+			Mock: mock.New(chainID, map[int64]*types.SignedHeader{}, map[int64]*types.ValidatorSet{}),
+		}
+		nodes[i] = &node{provider: providers[i]}
+	}
+	return newMonitor(chainID, nodes, log.TestingLogger()), providers
+}
+
+// makeLightBlock returns a light block at the given height signed by all the
+// validators, with the given header if any.
+func makeLightBlock(
+	t *testing.T,
+	height int64,
+	vals *types.ValidatorSet,
+	privVals []types.PrivValidator,
+	header *types.Header,
+) *types.LightBlock {
+	t.Helper()
+
+	if header == nil {
+		header = test.MakeHeader(t, &types.Header{
+			ChainID:            chainID,
+			Height:             height,
+			Time:               defaultTime,
+			ValidatorsHash:     vals.Hash(),
+			NextValidatorsHash: vals.Hash(),
+			ProposerAddress:    vals.Proposer.Address,
+		})
+	}
+	blockID := test.MakeBlockIDWithHash(header.Hash())
+	commit, err := test.MakeCommit(blockID, height, 0, vals, privVals, chainID, defaultTime)
+	require.NoError(t, err)
+
+	return &types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: header, Commit: commit},
+		ValidatorSet: vals,
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/cometbft/cometbft/evidence"
 	"github.com/cometbft/cometbft/light"
 
+	cmtflags "github.com/cometbft/cometbft/libs/cli/flags"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	"github.com/cometbft/cometbft/libs/service"
@@ -34,6 +35,7 @@ import (
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/null"
+	"github.com/cometbft/cometbft/state/uptime"
 	"github.com/cometbft/cometbft/statesync"
 	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
@@ -79,8 +81,10 @@ type Node struct {
 	txIndexer         txindex.TxIndexer
 	blockIndexer      indexer.BlockIndexer
 	indexerService    *txindex.IndexerService
+	uptimeTracker     *uptime.Tracker
 	prometheusSrv     *http.Server
 	pprofSrv          *http.Server
+	logBuffer         *log.RingBuffer // recent logs, served by the unsafe RPC
 }
 
 type waitSyncReactor interface {
@@ -181,9 +185,12 @@ func BootstrapStateWithGenProvider(ctx context.Context, config *cfg.Config, dbPr
 	if dbProvider == nil {
 		dbProvider = cfg.DefaultDBProvider
 	}
-	blockStore, stateDB, err := initDBs(config, dbProvider)
+	blockStore, stateDB, err := initDBs(config, dbProvider, logger)
 
 	defer func() {
+		if blockStore == nil {
+			return
+		}
 		if derr := blockStore.Close(); derr != nil {
 			logger.Error("Failed to close blockstore", "err", derr)
 			// Set the return value
@@ -311,24 +318,42 @@ func NewNodeWithContext(
 	logger log.Logger,
 	options ...Option,
 ) (*Node, error) {
-	blockStore, stateDB, err := initDBs(config, dbProvider)
+	// keep the recent logs for the debug bundle of the unsafe RPC
+	var logBuffer *log.RingBuffer
+	if config.RPC.Unsafe && config.RPC.DebugLogBufferSize > 0 {
+		// record the entries of the configured log levels only
+		logLevels, err := cmtflags.ParseLogLevelOptions(config.LogLevel, cfg.DefaultLogLevel)
+		if err != nil {
+			return nil, err
+		}
+		logBuffer = log.NewRingBuffer(config.RPC.DebugLogBufferSize)
+		logger = log.NewRingLogger(logger, logBuffer, logLevels...)
+	}
+
+	stateDB, err := initStateDB(config, dbProvider, logger)
 	if err != nil {
 		return nil, err
 	}
 
+	state, genDoc, err := LoadStateFromDBOrGenesisDocProvider(stateDB, genesisDocProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	csMetrics, p2pMetrics, memplMetrics, smMetrics, abciMetrics, bsMetrics, ssMetrics, nodeMetrics := metricsProvider(genDoc.ChainID)
+
 	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
 		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		CacheSize:            config.Storage.CacheSize,
+		Metrics:              smMetrics,
 	})
-
-	state, genDoc, err := LoadStateFromDBOrGenesisDocProvider(stateDB, genesisDocProvider)
+	blockStore, err := store.LoadBlockStore(config, dbProvider, logger, store.WithMetrics(nodeMetrics.Store))
 	if err != nil {
 		return nil, err
 	}
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics, abciMetrics, bsMetrics, ssMetrics := metricsProvider(genDoc.ChainID)
-
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
-	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, abciMetrics)
+	proxyApp, err := createAndStartProxyAppConns(config, clientCreator, logger, abciMetrics)
 	if err != nil {
 		return nil, err
 	}
@@ -390,6 +415,14 @@ func NewNodeWithContext(
 
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
+	// Started after the handshake, so that the commits of the replayed blocks
+	// are read from the block store.
+	uptimeTracker, err := createAndStartUptimeTracker(config, stateStore, blockStore, eventBus,
+		localAddr, nodeMetrics.Uptime, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	// these bools might be a bit confusing, but here's the breakdown:
 	var (
 		// comet's spec initializes the node in BLOCKSYNC mode by default
@@ -416,6 +449,7 @@ func NewNodeWithContext(
 	if err != nil {
 		return nil, err
 	}
+	evidencePool.SetEventBus(eventBus)
 
 	// make block executor for consensus and blocksync reactors to execute blocks
 	blockExec := sm.NewBlockExecutor(
@@ -427,6 +461,7 @@ func NewNodeWithContext(
 		blockStore,
 		sm.BlockExecutorWithMetrics(smMetrics),
 		sm.BlockExecutorWithBlockTimeTolerance(config.Consensus.BlockTimeTolerance),
+		sm.BlockExecutorWithHistoryRetainHeights(config.Storage.HistoryRetainHeights),
 	)
 
 	offlineStateSyncHeight := int64(0)
@@ -598,7 +633,9 @@ func NewNodeWithContext(
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
 		blockIndexer:     blockIndexer,
+		uptimeTracker:    uptimeTracker,
 		eventBus:         eventBus,
+		logBuffer:        logBuffer,
 	}
 
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
@@ -690,6 +727,11 @@ func (n *Node) OnStop() {
 			n.Logger.Error("Error closing indexerService", "err", err)
 		}
 	}
+	if n.uptimeTracker != nil {
+		if err := n.uptimeTracker.Stop(); err != nil {
+			n.Logger.Error("Error closing uptimeTracker", "err", err)
+		}
+	}
 	// now stop the reactors
 	if err := n.sw.Stop(); err != nil {
 		n.Logger.Error("Error closing switch", "err", err)
@@ -775,10 +817,18 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 		Mempool:          n.mempool,
 		IsAdaptiveSync:   n.config.BlockSync.AdaptiveSync,
 
-		Logger: n.Logger.With("module", "rpc"),
+		Logger:    n.Logger.With("module", "rpc"),
+		LogBuffer: n.logBuffer,
+		WALFile:   n.config.Consensus.WalFile(),
 
 		Config: *n.config.RPC,
 	}
+	if bcR, ok := n.bcReactor.(*bc.Reactor); ok {
+		rpcCoreEnv.BlockSyncReactor = bcR
+	}
+	if n.uptimeTracker != nil {
+		rpcCoreEnv.UptimeTracker = n.uptimeTracker
+	}
 	if err := rpcCoreEnv.InitGenesisChunks(); err != nil {
 		return nil, err
 	}
@@ -829,6 +879,9 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		wm.SetLogger(wmLogger)
 		mux.HandleFunc("/websocket", wm.WebsocketHandler)
 		rpcserver.RegisterRPCFuncs(mux, routes, rpcLogger)
+		if n.config.RPC.Unsafe {
+			mux.HandleFunc("/unsafe_debug_bundle", env.DebugBundle)
+		}
 		listener, err := rpcserver.Listen(
 			listenAddr,
 			config.MaxOpenConnections,
@@ -523,6 +523,38 @@ func TestNodeNewNodeCustomReactors(t *testing.T) {
 	assert.Contains(t, channels, cr.Channels[0].ID)
 }
 
+func TestNodeNewerDBSchema(t *testing.T) {
+	config := test.ResetTestRoot("node_newer_db_schema_test")
+	defer os.RemoveAll(config.RootDir)
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+	for _, id := range []string{"blockstore", "state", "tx_index", "evidence"} {
+		t.Run(id, func(t *testing.T) {
+			// a database written by a newer version
+			newerDB := dbm.NewMemDB()
+			require.NoError(t, newerDB.Set([]byte("schemaVersion"), []byte("999")))
+			dbProvider := func(ctx *cfg.DBContext) (dbm.DB, error) {
+				if ctx.ID == id {
+					return newerDB, nil
+				}
+				return dbm.NewMemDB(), nil
+			}
+
+			_, err = NewNode(config,
+				privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()),
+				nodeKey,
+				proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+				DefaultGenesisDocProviderFunc(config),
+				dbProvider,
+				DefaultMetricsProvider(config.Instrumentation),
+				log.TestingLogger(),
+			)
+			require.ErrorContains(t, err, "newer than the supported version")
+		})
+	}
+}
+
 func state(nVals int, height int64) (sm.State, dbm.DB, []types.PrivValidator) {
 	privVals := make([]types.PrivValidator, nVals)
 	vals := make([]types.GenesisValidator, nVals)
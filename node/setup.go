@@ -33,6 +33,7 @@ import (
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/indexer/block"
 	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/uptime"
 	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
@@ -78,46 +79,95 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	)
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics)
+// MetricsProvider returns a consensus, p2p and mempool Metrics, along with the
+// Metrics of the other node components.
+type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics, *Metrics)
+
+// Metrics groups the metrics of the node components which are not returned
+// on their own by MetricsProvider. The metrics of new components are added
+// here, so that the signature of MetricsProvider doesn't change.
+type Metrics struct {
+	Store  *store.Metrics
+	Uptime *uptime.Metrics
+}
+
+// PrometheusMetrics returns the Metrics built using the Prometheus client
+// library.
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	return &Metrics{
+		Store:  store.PrometheusMetrics(namespace, labelsAndValues...),
+		Uptime: uptime.PrometheusMetrics(namespace, labelsAndValues...),
+	}
+}
+
+// NopMetrics returns the no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Store:  store.NopMetrics(),
+		Uptime: uptime.NopMetrics(),
+	}
+}
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics) {
+	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics, *Metrics) {
 		if config.Prometheus {
+			bsMetrics := blocksync.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+			bsMetrics.Peers = blocksync.PrometheusPeerMetrics(config.Namespace, "chain_id", chainID)
 			return cs.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				mempl.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				proxy.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				blocksync.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				statesync.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+				bsMetrics,
+				statesync.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				PrometheusMetrics(config.Namespace, "chain_id", chainID)
 		}
-		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), proxy.NopMetrics(), blocksync.NopMetrics(), statesync.NopMetrics()
+		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), proxy.NopMetrics(), blocksync.NopMetrics(), statesync.NopMetrics(), NopMetrics()
 	}
 }
 
 //------------------------------------------------------------------------------
 
-func initDBs(config *cfg.Config, dbProvider cfg.DBProvider) (blockStore *store.BlockStore, stateDB dbm.DB, err error) {
-	var blockStoreDB dbm.DB
-	blockStoreDB, err = dbProvider(&cfg.DBContext{ID: "blockstore", Config: config})
+func initDBs(
+	config *cfg.Config,
+	dbProvider cfg.DBProvider,
+	logger log.Logger,
+) (blockStore *store.BlockStore, stateDB dbm.DB, err error) {
+	// Upgrade the layouts of the databases before they are used.
+	blockStore, err = store.LoadBlockStore(config, dbProvider, logger)
 	if err != nil {
 		return
 	}
-	blockStore = store.NewBlockStore(blockStoreDB)
+	stateDB, err = initStateDB(config, dbProvider, logger)
+	return
+}
 
-	stateDB, err = dbProvider(&cfg.DBContext{ID: "state", Config: config})
+func initStateDB(config *cfg.Config, dbProvider cfg.DBProvider, logger log.Logger) (dbm.DB, error) {
+	stateDB, err := dbProvider(&cfg.DBContext{ID: "state", Config: config})
 	if err != nil {
-		return
+		return nil, err
 	}
-
-	return
+	// Upgrade the layout of the database before it is used.
+	if _, err := sm.MigrateDB(stateDB, logger); err != nil {
+		return nil, err
+	}
+	return stateDB, nil
 }
 
-func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger, metrics *proxy.Metrics) (proxy.AppConns, error) {
-	proxyApp := proxy.NewAppConns(clientCreator, metrics)
+func createAndStartProxyAppConns(
+	config *cfg.Config,
+	clientCreator proxy.ClientCreator,
+	logger log.Logger,
+	metrics *proxy.Metrics,
+) (proxy.AppConns, error) {
+	var options []proxy.MultiAppConnOption
+	if dir := config.ABCIRecordPath(); dir != "" {
+		logger.Info("Recording ABCI traffic", "dir", dir)
+		options = append(options, proxy.WithRecording(dir))
+	}
+	proxyApp := proxy.NewAppConns(clientCreator, metrics, options...)
 	proxyApp.SetLogger(logger.With("module", "proxy"))
 	if err := proxyApp.Start(); err != nil {
 		return nil, fmt.Errorf("error starting proxy app connections: %v", err)
@@ -166,6 +216,30 @@ func createAndStartIndexerService(
 	return indexerService, txIndexer, blockIndexer, nil
 }
 
+func createAndStartUptimeTracker(
+	config *cfg.Config,
+	stateStore sm.Store,
+	blockStore sm.BlockStore,
+	eventBus *types.EventBus,
+	localAddr crypto.Address,
+	metrics *uptime.Metrics,
+	logger log.Logger,
+) (*uptime.Tracker, error) {
+	if config.Instrumentation.ValidatorUptimeWindow == 0 {
+		return nil, nil
+	}
+
+	tracker := uptime.NewTracker(stateStore, blockStore, eventBus, config.Instrumentation.ValidatorUptimeWindow,
+		uptime.WithLocalValidator(localAddr, config.Instrumentation.MissedBlocksWarnThreshold),
+		uptime.WithMetrics(metrics),
+	)
+	tracker.SetLogger(logger.With("module", "uptime"))
+	if err := tracker.Start(); err != nil {
+		return nil, err
+	}
+	return tracker, nil
+}
+
 func doHandshake(
 	ctx context.Context,
 	stateStore sm.Store,
@@ -271,6 +345,9 @@ func createEvidenceReactor(config *cfg.Config, dbProvider cfg.DBProvider,
 		return nil, nil, err
 	}
 	evidenceLogger := logger.With("module", "evidence")
+	if _, err := evidence.MigrateDB(evidenceDB, evidenceLogger); err != nil {
+		return nil, nil, err
+	}
 	evidencePool, err := evidence.NewPool(evidenceDB, stateStore, blockStore)
 	if err != nil {
 		return nil, nil, err
@@ -305,6 +382,7 @@ func createBlocksyncReactor(
 		localAddr,
 		offlineStateSyncHeight,
 		metrics,
+		blocksync.WithVerifyWorkers(config.BlockSync.VerifyWorkers),
 	)
 
 	bcReactor.SetLogger(logger.With("module", "blocksync"))
@@ -341,7 +419,12 @@ func createConsensusReactor(
 		consensusState.SetPrivValidator(privValidator)
 	}
 
-	consensusReactor := cs.NewReactor(consensusState, waitForSync, cs.ReactorMetrics(csMetrics))
+	consensusReactor := cs.NewReactor(
+		consensusState,
+		waitForSync,
+		cs.ReactorMetrics(csMetrics),
+		cs.ReactorMempool(mempool),
+	)
 	consensusReactor.SetLogger(logger)
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
@@ -18,6 +18,10 @@ var (
 	_ p2p.Wrapper = &NewRoundStep{}
 	_ p2p.Wrapper = &HasVote{}
 	_ p2p.Wrapper = &BlockPart{}
+	_ p2p.Wrapper = &CompactBlock{}
+	_ p2p.Wrapper = &CompactBlockTxsRequest{}
+	_ p2p.Wrapper = &CompactBlockTxs{}
+	_ p2p.Wrapper = &HasCompactBlock{}
 )
 
 func (m *VoteSetBits) Wrap() proto.Message {
@@ -74,6 +78,30 @@ func (m *NewRoundStep) Wrap() proto.Message {
 	return cm
 }
 
+func (m *CompactBlock) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_CompactBlock{CompactBlock: m}
+	return cm
+}
+
+func (m *CompactBlockTxsRequest) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_CompactBlockTxsRequest{CompactBlockTxsRequest: m}
+	return cm
+}
+
+func (m *CompactBlockTxs) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_CompactBlockTxs{CompactBlockTxs: m}
+	return cm
+}
+
+func (m *HasCompactBlock) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_HasCompactBlock{HasCompactBlock: m}
+	return cm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped consensus
 // proto message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -105,6 +133,18 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_VoteSetBits:
 		return m.GetVoteSetBits(), nil
 
+	case *Message_CompactBlock:
+		return m.GetCompactBlock(), nil
+
+	case *Message_CompactBlockTxsRequest:
+		return m.GetCompactBlockTxsRequest(), nil
+
+	case *Message_CompactBlockTxs:
+		return m.GetCompactBlockTxs(), nil
+
+	case *Message_HasCompactBlock:
+		return m.GetHasCompactBlock(), nil
+
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
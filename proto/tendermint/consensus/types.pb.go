@@ -609,6 +609,286 @@ func (m *VoteSetBits) GetVotes() bits.BitArray {
 	return bits.BitArray{}
 }
 
+// CompactBlock is sent instead of the parts of a proposed block when compact
+// blocks are enabled. It carries the block with its txs replaced by their keys,
+// so that the receiver can reconstruct it from its mempool.
+type CompactBlock struct {
+	Height             int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round              int32               `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	BlockPartSetHeader types.PartSetHeader `protobuf:"bytes,3,opt,name=block_part_set_header,json=blockPartSetHeader,proto3" json:"block_part_set_header"`
+	Header             types.Header        `protobuf:"bytes,4,opt,name=header,proto3" json:"header"`
+	Evidence           types.EvidenceList  `protobuf:"bytes,5,opt,name=evidence,proto3" json:"evidence"`
+	LastCommit         *types.Commit       `protobuf:"bytes,6,opt,name=last_commit,json=lastCommit,proto3" json:"last_commit,omitempty"`
+	TxKeys             [][]byte            `protobuf:"bytes,7,rep,name=tx_keys,json=txKeys,proto3" json:"tx_keys,omitempty"`
+}
+
+func (m *CompactBlock) Reset()         { *m = CompactBlock{} }
+func (m *CompactBlock) String() string { return proto.CompactTextString(m) }
+func (*CompactBlock) ProtoMessage()    {}
+func (*CompactBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{9}
+}
+func (m *CompactBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlock.Merge(m, src)
+}
+func (m *CompactBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlock proto.InternalMessageInfo
+
+func (m *CompactBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetBlockPartSetHeader() types.PartSetHeader {
+	if m != nil {
+		return m.BlockPartSetHeader
+	}
+	return types.PartSetHeader{}
+}
+
+func (m *CompactBlock) GetHeader() types.Header {
+	if m != nil {
+		return m.Header
+	}
+	return types.Header{}
+}
+
+func (m *CompactBlock) GetEvidence() types.EvidenceList {
+	if m != nil {
+		return m.Evidence
+	}
+	return types.EvidenceList{}
+}
+
+func (m *CompactBlock) GetLastCommit() *types.Commit {
+	if m != nil {
+		return m.LastCommit
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetTxKeys() [][]byte {
+	if m != nil {
+		return m.TxKeys
+	}
+	return nil
+}
+
+// CompactBlockTxsRequest is sent to request the txs of a compact block which
+// are missing from the mempool.
+type CompactBlockTxsRequest struct {
+	Height  int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round   int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Indexes []uint32 `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (m *CompactBlockTxsRequest) Reset()         { *m = CompactBlockTxsRequest{} }
+func (m *CompactBlockTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*CompactBlockTxsRequest) ProtoMessage()    {}
+func (*CompactBlockTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{10}
+}
+func (m *CompactBlockTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlockTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlockTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlockTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlockTxsRequest.Merge(m, src)
+}
+func (m *CompactBlockTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlockTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlockTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlockTxsRequest proto.InternalMessageInfo
+
+func (m *CompactBlockTxsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlockTxsRequest) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlockTxsRequest) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+// CompactBlockTxs is sent in response to a CompactBlockTxsRequest.
+type CompactBlockTxs struct {
+	Height  int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round   int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Indexes []uint32 `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	Txs     [][]byte `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *CompactBlockTxs) Reset()         { *m = CompactBlockTxs{} }
+func (m *CompactBlockTxs) String() string { return proto.CompactTextString(m) }
+func (*CompactBlockTxs) ProtoMessage()    {}
+func (*CompactBlockTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{11}
+}
+func (m *CompactBlockTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlockTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlockTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlockTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlockTxs.Merge(m, src)
+}
+func (m *CompactBlockTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlockTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlockTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlockTxs proto.InternalMessageInfo
+
+func (m *CompactBlockTxs) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlockTxs) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlockTxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *CompactBlockTxs) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+// HasCompactBlock is sent once a compact block has been reconstructed, so that
+// its sender doesn't gossip the parts of the block.
+type HasCompactBlock struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (m *HasCompactBlock) Reset()         { *m = HasCompactBlock{} }
+func (m *HasCompactBlock) String() string { return proto.CompactTextString(m) }
+func (*HasCompactBlock) ProtoMessage()    {}
+func (*HasCompactBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{12}
+}
+func (m *HasCompactBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HasCompactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HasCompactBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HasCompactBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasCompactBlock.Merge(m, src)
+}
+func (m *HasCompactBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *HasCompactBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasCompactBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HasCompactBlock proto.InternalMessageInfo
+
+func (m *HasCompactBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HasCompactBlock) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Message_NewRoundStep
@@ -620,6 +900,10 @@ type Message struct {
 	//	*Message_HasVote
 	//	*Message_VoteSetMaj23
 	//	*Message_VoteSetBits
+	//	*Message_CompactBlock
+	//	*Message_CompactBlockTxsRequest
+	//	*Message_CompactBlockTxs
+	//	*Message_HasCompactBlock
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -627,7 +911,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81a22d2efc008981, []int{9}
+	return fileDescriptor_81a22d2efc008981, []int{13}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,16 +973,32 @@ type Message_VoteSetMaj23 struct {
 type Message_VoteSetBits struct {
 	VoteSetBits *VoteSetBits `protobuf:"bytes,9,opt,name=vote_set_bits,json=voteSetBits,proto3,oneof" json:"vote_set_bits,omitempty"`
 }
+type Message_CompactBlock struct {
+	CompactBlock *CompactBlock `protobuf:"bytes,10,opt,name=compact_block,json=compactBlock,proto3,oneof" json:"compact_block,omitempty"`
+}
+type Message_CompactBlockTxsRequest struct {
+	CompactBlockTxsRequest *CompactBlockTxsRequest `protobuf:"bytes,11,opt,name=compact_block_txs_request,json=compactBlockTxsRequest,proto3,oneof" json:"compact_block_txs_request,omitempty"`
+}
+type Message_CompactBlockTxs struct {
+	CompactBlockTxs *CompactBlockTxs `protobuf:"bytes,12,opt,name=compact_block_txs,json=compactBlockTxs,proto3,oneof" json:"compact_block_txs,omitempty"`
+}
+type Message_HasCompactBlock struct {
+	HasCompactBlock *HasCompactBlock `protobuf:"bytes,13,opt,name=has_compact_block,json=hasCompactBlock,proto3,oneof" json:"has_compact_block,omitempty"`
+}
 
-func (*Message_NewRoundStep) isMessage_Sum()  {}
-func (*Message_NewValidBlock) isMessage_Sum() {}
-func (*Message_Proposal) isMessage_Sum()      {}
-func (*Message_ProposalPol) isMessage_Sum()   {}
-func (*Message_BlockPart) isMessage_Sum()     {}
-func (*Message_Vote) isMessage_Sum()          {}
-func (*Message_HasVote) isMessage_Sum()       {}
-func (*Message_VoteSetMaj23) isMessage_Sum()  {}
-func (*Message_VoteSetBits) isMessage_Sum()   {}
+func (*Message_NewRoundStep) isMessage_Sum()           {}
+func (*Message_NewValidBlock) isMessage_Sum()          {}
+func (*Message_Proposal) isMessage_Sum()               {}
+func (*Message_ProposalPol) isMessage_Sum()            {}
+func (*Message_BlockPart) isMessage_Sum()              {}
+func (*Message_Vote) isMessage_Sum()                   {}
+func (*Message_HasVote) isMessage_Sum()                {}
+func (*Message_VoteSetMaj23) isMessage_Sum()           {}
+func (*Message_VoteSetBits) isMessage_Sum()            {}
+func (*Message_CompactBlock) isMessage_Sum()           {}
+func (*Message_CompactBlockTxsRequest) isMessage_Sum() {}
+func (*Message_CompactBlockTxs) isMessage_Sum()        {}
+func (*Message_HasCompactBlock) isMessage_Sum()        {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -770,6 +1070,34 @@ func (m *Message) GetVoteSetBits() *VoteSetBits {
 	return nil
 }
 
+func (m *Message) GetCompactBlock() *CompactBlock {
+	if x, ok := m.GetSum().(*Message_CompactBlock); ok {
+		return x.CompactBlock
+	}
+	return nil
+}
+
+func (m *Message) GetCompactBlockTxsRequest() *CompactBlockTxsRequest {
+	if x, ok := m.GetSum().(*Message_CompactBlockTxsRequest); ok {
+		return x.CompactBlockTxsRequest
+	}
+	return nil
+}
+
+func (m *Message) GetCompactBlockTxs() *CompactBlockTxs {
+	if x, ok := m.GetSum().(*Message_CompactBlockTxs); ok {
+		return x.CompactBlockTxs
+	}
+	return nil
+}
+
+func (m *Message) GetHasCompactBlock() *HasCompactBlock {
+	if x, ok := m.GetSum().(*Message_HasCompactBlock); ok {
+		return x.HasCompactBlock
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -782,6 +1110,10 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_HasVote)(nil),
 		(*Message_VoteSetMaj23)(nil),
 		(*Message_VoteSetBits)(nil),
+		(*Message_CompactBlock)(nil),
+		(*Message_CompactBlockTxsRequest)(nil),
+		(*Message_CompactBlockTxs)(nil),
+		(*Message_HasCompactBlock)(nil),
 	}
 }
 
@@ -795,67 +1127,85 @@ func init() {
 	proto.RegisterType((*HasVote)(nil), "tendermint.consensus.HasVote")
 	proto.RegisterType((*VoteSetMaj23)(nil), "tendermint.consensus.VoteSetMaj23")
 	proto.RegisterType((*VoteSetBits)(nil), "tendermint.consensus.VoteSetBits")
+	proto.RegisterType((*CompactBlock)(nil), "tendermint.consensus.CompactBlock")
+	proto.RegisterType((*CompactBlockTxsRequest)(nil), "tendermint.consensus.CompactBlockTxsRequest")
+	proto.RegisterType((*CompactBlockTxs)(nil), "tendermint.consensus.CompactBlockTxs")
+	proto.RegisterType((*HasCompactBlock)(nil), "tendermint.consensus.HasCompactBlock")
 	proto.RegisterType((*Message)(nil), "tendermint.consensus.Message")
 }
 
 func init() { proto.RegisterFile("tendermint/consensus/types.proto", fileDescriptor_81a22d2efc008981) }
 
 var fileDescriptor_81a22d2efc008981 = []byte{
-	// 855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xb7, 0xa9, 0xd3, 0x24, 0xcf, 0x49, 0x0b, 0xa3, 0xee, 0x2a, 0x04, 0x48, 0x82, 0xb9, 0x54,
-	0x08, 0x39, 0x28, 0x3d, 0xac, 0xb4, 0x42, 0x02, 0xcc, 0x9f, 0xf5, 0xae, 0x36, 0xbb, 0xc1, 0x59,
-	0xad, 0x10, 0x17, 0xcb, 0x89, 0x87, 0x64, 0xd8, 0xd8, 0x63, 0x79, 0x26, 0x29, 0xbd, 0xf2, 0x09,
-	0xf8, 0x00, 0x7c, 0x0d, 0x24, 0x3e, 0x42, 0x8f, 0x3d, 0x72, 0xaa, 0x50, 0xfa, 0x11, 0x10, 0x9c,
-	0xd1, 0x8c, 0x27, 0xb1, 0x43, 0xdd, 0x42, 0x2f, 0x48, 0xdc, 0x66, 0xe6, 0xbd, 0xf7, 0x9b, 0x37,
-	0xbf, 0xf7, 0xde, 0xcf, 0x86, 0x1e, 0xc7, 0x71, 0x88, 0xd3, 0x88, 0xc4, 0xbc, 0x3f, 0xa5, 0x31,
-	0xc3, 0x31, 0x5b, 0xb2, 0x3e, 0x3f, 0x4b, 0x30, 0xb3, 0x93, 0x94, 0x72, 0x8a, 0x8e, 0x72, 0x0f,
-	0x7b, 0xeb, 0xd1, 0x3e, 0x9a, 0xd1, 0x19, 0x95, 0x0e, 0x7d, 0xb1, 0xca, 0x7c, 0xdb, 0x45, 0xb4,
-	0x05, 0x99, 0xb0, 0xfe, 0x84, 0xf0, 0x1d, 0xb4, 0xf6, 0xdb, 0x05, 0x0f, 0x79, 0x5e, 0xb4, 0x5a,
-	0x3f, 0xeb, 0xd0, 0x78, 0x86, 0x4f, 0x3d, 0xba, 0x8c, 0xc3, 0x31, 0xc7, 0x09, 0xba, 0x0f, 0xfb,
-	0x73, 0x4c, 0x66, 0x73, 0xde, 0xd2, 0x7b, 0xfa, 0xf1, 0x9e, 0xa7, 0x76, 0xe8, 0x08, 0x2a, 0xa9,
-	0x70, 0x6a, 0xbd, 0xd6, 0xd3, 0x8f, 0x2b, 0x5e, 0xb6, 0x41, 0x08, 0x0c, 0xc6, 0x71, 0xd2, 0xda,
-	0xeb, 0xe9, 0xc7, 0x4d, 0x4f, 0xae, 0xd1, 0x03, 0x68, 0x31, 0x3c, 0xa5, 0x71, 0xc8, 0x7c, 0x46,
-	0xe2, 0x29, 0xf6, 0x19, 0x0f, 0x52, 0xee, 0x73, 0x12, 0xe1, 0x96, 0x21, 0x31, 0xef, 0x29, 0xfb,
-	0x58, 0x98, 0xc7, 0xc2, 0xfa, 0x82, 0x44, 0x18, 0xbd, 0x0f, 0x6f, 0x2c, 0x02, 0xc6, 0xfd, 0x29,
-	0x8d, 0x22, 0xc2, 0xfd, 0xec, 0xba, 0x8a, 0xbc, 0xee, 0x50, 0x18, 0x3e, 0x93, 0xe7, 0x32, 0x55,
-	0xeb, 0x0f, 0x1d, 0x9a, 0xcf, 0xf0, 0xe9, 0xcb, 0x60, 0x41, 0x42, 0x67, 0x41, 0xa7, 0xaf, 0xee,
-	0x98, 0xf8, 0xd7, 0x70, 0x6f, 0x22, 0xc2, 0xfc, 0x44, 0xe4, 0xc6, 0x30, 0xf7, 0xe7, 0x38, 0x08,
-	0x71, 0x2a, 0x5f, 0x62, 0x0e, 0xba, 0x76, 0xa1, 0x06, 0x19, 0x5f, 0xa3, 0x20, 0xe5, 0x63, 0xcc,
-	0x5d, 0xe9, 0xe6, 0x18, 0xe7, 0x97, 0x5d, 0xcd, 0x43, 0x12, 0x63, 0xc7, 0x82, 0x3e, 0x06, 0x33,
-	0x47, 0x66, 0xf2, 0xc5, 0xe6, 0xa0, 0x53, 0xc4, 0x13, 0x75, 0xb2, 0x45, 0x9d, 0x6c, 0x87, 0xf0,
-	0x4f, 0xd3, 0x34, 0x38, 0xf3, 0x60, 0x0b, 0xc4, 0xd0, 0x5b, 0x50, 0x27, 0x4c, 0x91, 0x20, 0x9f,
-	0x5f, 0xf3, 0x6a, 0x84, 0x65, 0x8f, 0xb7, 0x5c, 0xa8, 0x8d, 0x52, 0x9a, 0x50, 0x16, 0x2c, 0xd0,
-	0x47, 0x50, 0x4b, 0xd4, 0x5a, 0xbe, 0xd9, 0x1c, 0xb4, 0x4b, 0xd2, 0x56, 0x1e, 0x2a, 0xe3, 0x6d,
-	0x84, 0xf5, 0x93, 0x0e, 0xe6, 0xc6, 0x38, 0x7a, 0xfe, 0xf4, 0x46, 0xfe, 0x3e, 0x00, 0xb4, 0x89,
-	0xf1, 0x13, 0xba, 0xf0, 0x8b, 0x64, 0xbe, 0xbe, 0xb1, 0x8c, 0xe8, 0x42, 0xd6, 0x05, 0x3d, 0x82,
-	0x46, 0xd1, 0x5b, 0xd1, 0xf9, 0x0f, 0xcf, 0x57, 0xb9, 0x99, 0x05, 0x34, 0xeb, 0x15, 0xd4, 0x9d,
-	0x0d, 0x27, 0x77, 0xac, 0xed, 0x87, 0x60, 0x08, 0xee, 0xd5, 0xdd, 0xf7, 0xcb, 0x4b, 0xa9, 0xee,
-	0x94, 0x9e, 0xd6, 0x00, 0x8c, 0x97, 0x94, 0x8b, 0x0e, 0x34, 0x56, 0x94, 0x63, 0xc5, 0x66, 0x49,
-	0xa4, 0xf0, 0xf2, 0xa4, 0x8f, 0xf5, 0x83, 0x0e, 0x55, 0x37, 0x60, 0x32, 0xee, 0x6e, 0xf9, 0x9d,
-	0x80, 0x21, 0xd0, 0x64, 0x7e, 0x07, 0x65, 0xad, 0x36, 0x26, 0xb3, 0x18, 0x87, 0x43, 0x36, 0x7b,
-	0x71, 0x96, 0x60, 0x4f, 0x3a, 0x0b, 0x28, 0x12, 0x87, 0xf8, 0x7b, 0xd9, 0x50, 0x15, 0x2f, 0xdb,
-	0x58, 0xbf, 0xe8, 0xd0, 0x10, 0x19, 0x8c, 0x31, 0x1f, 0x06, 0xdf, 0x0d, 0x4e, 0xfe, 0x8b, 0x4c,
-	0xbe, 0x80, 0x5a, 0xd6, 0xe0, 0x24, 0x54, 0xdd, 0xfd, 0xe6, 0xf5, 0x40, 0x59, 0xbb, 0xc7, 0x9f,
-	0x3b, 0x87, 0x82, 0xe5, 0xf5, 0x65, 0xb7, 0xaa, 0x0e, 0xbc, 0xaa, 0x8c, 0x7d, 0x1c, 0x5a, 0xbf,
-	0xeb, 0x60, 0xaa, 0xd4, 0x1d, 0xc2, 0xd9, 0xff, 0x27, 0x73, 0xf4, 0x10, 0x2a, 0xa2, 0x03, 0x98,
-	0x1c, 0xce, 0x7f, 0xdb, 0xdc, 0x59, 0x88, 0xf5, 0xa7, 0x01, 0xd5, 0x21, 0x66, 0x2c, 0x98, 0x61,
-	0xf4, 0x04, 0x0e, 0x62, 0x7c, 0x9a, 0x0d, 0x94, 0x2f, 0x65, 0x34, 0xeb, 0x3b, 0xcb, 0x2e, 0xfb,
-	0x00, 0xd8, 0x45, 0x99, 0x76, 0x35, 0xaf, 0x11, 0x17, 0x65, 0x7b, 0x08, 0x87, 0x02, 0x6b, 0x25,
-	0xf4, 0xd0, 0x97, 0x89, 0x4a, 0xbe, 0xcc, 0xc1, 0x7b, 0x37, 0x82, 0xe5, 0xda, 0xe9, 0x6a, 0x5e,
-	0x33, 0xde, 0x11, 0xd3, 0xa2, 0xb4, 0x94, 0x8c, 0x70, 0x8e, 0xb3, 0x51, 0x10, 0xb7, 0x20, 0x2d,
-	0xe8, 0xcb, 0xbf, 0x89, 0x40, 0xc6, 0xf5, 0xbb, 0xb7, 0x23, 0x8c, 0x9e, 0x3f, 0x75, 0x77, 0x35,
-	0x00, 0x7d, 0x02, 0x90, 0x4b, 0xa9, 0x62, 0xbb, 0x5b, 0x8e, 0xb2, 0xd5, 0x0a, 0x57, 0xf3, 0xea,
-	0x5b, 0x31, 0x15, 0x52, 0x20, 0x07, 0x7a, 0xff, 0xba, 0x3c, 0xe6, 0xb1, 0xa2, 0x0b, 0x5d, 0x2d,
-	0x1b, 0x6b, 0xf4, 0x10, 0x6a, 0xf3, 0x80, 0xf9, 0x32, 0xaa, 0x2a, 0xa3, 0xde, 0x29, 0x8f, 0x52,
-	0xb3, 0xef, 0x6a, 0x5e, 0x75, 0xae, 0x64, 0xe0, 0x09, 0x1c, 0x88, 0x38, 0xf9, 0x39, 0x89, 0xc4,
-	0x38, 0xb6, 0x6a, 0xb7, 0x15, 0xb4, 0x38, 0xb8, 0xa2, 0xa0, 0xab, 0xe2, 0x20, 0x3f, 0x82, 0xe6,
-	0x16, 0x4b, 0xf4, 0x53, 0xab, 0x7e, 0x1b, 0x89, 0x85, 0x41, 0x12, 0x24, 0xae, 0xf2, 0xad, 0x53,
-	0x81, 0x3d, 0xb6, 0x8c, 0x9c, 0xaf, 0xce, 0xd7, 0x1d, 0xfd, 0x62, 0xdd, 0xd1, 0x7f, 0x5b, 0x77,
-	0xf4, 0x1f, 0xaf, 0x3a, 0xda, 0xc5, 0x55, 0x47, 0xfb, 0xf5, 0xaa, 0xa3, 0x7d, 0xf3, 0x60, 0x46,
-	0xf8, 0x7c, 0x39, 0xb1, 0xa7, 0x34, 0xea, 0x4f, 0x69, 0x84, 0xf9, 0xe4, 0x5b, 0x9e, 0x2f, 0xb2,
-	0x3f, 0x8e, 0xb2, 0x7f, 0x96, 0xc9, 0xbe, 0xb4, 0x9d, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0x94,
-	0xdf, 0xca, 0xd0, 0xd2, 0x08, 0x00, 0x00,
+	// 1085 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xbf, 0xab, 0xed, 0x9c, 0x33, 0x67, 0xd7, 0xed, 0x2a, 0x0d, 0xd7, 0x00, 0x8e, 0x39, 0x84,
+	0x14, 0xa1, 0xca, 0x46, 0x8e, 0x44, 0x45, 0x85, 0xd4, 0xe2, 0x52, 0x7a, 0x81, 0xa4, 0x0d, 0xeb,
+	0xa8, 0x42, 0xbc, 0x1c, 0xe7, 0xbb, 0xc5, 0x5e, 0xe2, 0xfb, 0xc3, 0xed, 0x26, 0xb1, 0x5f, 0xf9,
+	0x04, 0x7c, 0x00, 0xbe, 0x06, 0x12, 0x1f, 0xa1, 0x8f, 0x7d, 0x42, 0x3c, 0x55, 0x28, 0xe1, 0x1b,
+	0x20, 0xde, 0xd1, 0xee, 0x9d, 0x7d, 0xe7, 0xd8, 0x31, 0x35, 0x42, 0x08, 0xde, 0x76, 0x77, 0x66,
+	0x7e, 0x33, 0x3b, 0x33, 0x3b, 0xbf, 0x3b, 0x68, 0x70, 0x12, 0x78, 0x24, 0xf6, 0x69, 0xc0, 0x5b,
+	0x6e, 0x18, 0x30, 0x12, 0xb0, 0x13, 0xd6, 0xe2, 0xe3, 0x88, 0xb0, 0x66, 0x14, 0x87, 0x3c, 0x44,
+	0x1b, 0x99, 0x46, 0x73, 0xaa, 0xb1, 0xb5, 0xd1, 0x0f, 0xfb, 0xa1, 0x54, 0x68, 0x89, 0x55, 0xa2,
+	0xbb, 0x95, 0x47, 0x1b, 0xd2, 0x1e, 0x6b, 0xf5, 0x28, 0x9f, 0x41, 0xdb, 0xda, 0xce, 0x69, 0xc8,
+	0xf3, 0x16, 0x39, 0xa5, 0x1e, 0x09, 0x5c, 0x92, 0x2a, 0xbc, 0x31, 0xa7, 0x90, 0x33, 0x37, 0x7f,
+	0x54, 0xa1, 0xf2, 0x84, 0x9c, 0xe1, 0xf0, 0x24, 0xf0, 0xba, 0x9c, 0x44, 0x68, 0x13, 0xd6, 0x06,
+	0x84, 0xf6, 0x07, 0xdc, 0x50, 0x1b, 0xea, 0x4e, 0x01, 0xa7, 0x3b, 0xb4, 0x01, 0xa5, 0x58, 0x28,
+	0x19, 0xd7, 0x1a, 0xea, 0x4e, 0x09, 0x27, 0x1b, 0x84, 0xa0, 0xc8, 0x38, 0x89, 0x8c, 0x42, 0x43,
+	0xdd, 0xa9, 0x62, 0xb9, 0x46, 0x77, 0xc1, 0x60, 0xc4, 0x0d, 0x03, 0x8f, 0xd9, 0x8c, 0x06, 0x2e,
+	0xb1, 0x19, 0x77, 0x62, 0x6e, 0x73, 0xea, 0x13, 0xa3, 0x28, 0x31, 0x6f, 0xa5, 0xf2, 0xae, 0x10,
+	0x77, 0x85, 0xf4, 0x88, 0xfa, 0x04, 0xbd, 0x0b, 0x37, 0x87, 0x0e, 0xe3, 0xb6, 0x1b, 0xfa, 0x3e,
+	0xe5, 0x76, 0xe2, 0xae, 0x24, 0xdd, 0xd5, 0x84, 0xe0, 0xa1, 0x3c, 0x97, 0xa1, 0x9a, 0x7f, 0xa8,
+	0x50, 0x7d, 0x42, 0xce, 0x9e, 0x39, 0x43, 0xea, 0x75, 0x86, 0xa1, 0x7b, 0xbc, 0x62, 0xe0, 0x5f,
+	0xc0, 0xad, 0x9e, 0x30, 0xb3, 0x23, 0x11, 0x1b, 0x23, 0xdc, 0x1e, 0x10, 0xc7, 0x23, 0xb1, 0xbc,
+	0x89, 0xde, 0xde, 0x6e, 0xe6, 0x8a, 0x94, 0xe4, 0xeb, 0xd0, 0x89, 0x79, 0x97, 0x70, 0x4b, 0xaa,
+	0x75, 0x8a, 0xcf, 0x5f, 0x6e, 0x2b, 0x18, 0x49, 0x8c, 0x19, 0x09, 0xba, 0x0f, 0x7a, 0x86, 0xcc,
+	0xe4, 0x8d, 0xf5, 0x76, 0x3d, 0x8f, 0x27, 0x0a, 0xd9, 0x14, 0x85, 0x6c, 0x76, 0x28, 0xff, 0x28,
+	0x8e, 0x9d, 0x31, 0x86, 0x29, 0x10, 0x43, 0xaf, 0xc3, 0x3a, 0x65, 0x69, 0x12, 0xe4, 0xf5, 0xcb,
+	0xb8, 0x4c, 0x59, 0x72, 0x79, 0xd3, 0x82, 0xf2, 0x61, 0x1c, 0x46, 0x21, 0x73, 0x86, 0xe8, 0x43,
+	0x28, 0x47, 0xe9, 0x5a, 0xde, 0x59, 0x6f, 0x6f, 0x2d, 0x08, 0x3b, 0xd5, 0x48, 0x23, 0x9e, 0x5a,
+	0x98, 0x3f, 0xa8, 0xa0, 0x4f, 0x84, 0x87, 0x4f, 0xf7, 0xaf, 0xcc, 0xdf, 0x1d, 0x40, 0x13, 0x1b,
+	0x3b, 0x0a, 0x87, 0x76, 0x3e, 0x99, 0x37, 0x26, 0x92, 0xc3, 0x70, 0x28, 0xeb, 0x82, 0x1e, 0x43,
+	0x25, 0xaf, 0x6d, 0x14, 0x5e, 0xe5, 0xfa, 0x69, 0x6c, 0x7a, 0x0e, 0xcd, 0x3c, 0x86, 0xf5, 0xce,
+	0x24, 0x27, 0x2b, 0xd6, 0xf6, 0x3d, 0x28, 0x8a, 0xdc, 0xa7, 0xbe, 0x37, 0x17, 0x97, 0x32, 0xf5,
+	0x29, 0x35, 0xcd, 0x36, 0x14, 0x9f, 0x85, 0x5c, 0x74, 0x60, 0xf1, 0x34, 0xe4, 0xc4, 0x50, 0xaf,
+	0xb2, 0x14, 0x5a, 0x58, 0xea, 0x98, 0xdf, 0xa9, 0xa0, 0x59, 0x0e, 0x93, 0x76, 0xab, 0xc5, 0xb7,
+	0x0b, 0x45, 0x81, 0x26, 0xe3, 0xbb, 0xbe, 0xa8, 0xd5, 0xba, 0xb4, 0x1f, 0x10, 0xef, 0x80, 0xf5,
+	0x8f, 0xc6, 0x11, 0xc1, 0x52, 0x59, 0x40, 0xd1, 0xc0, 0x23, 0x23, 0xd9, 0x50, 0x25, 0x9c, 0x6c,
+	0xcc, 0x9f, 0x54, 0xa8, 0x88, 0x08, 0xba, 0x84, 0x1f, 0x38, 0xdf, 0xb4, 0x77, 0xff, 0x8d, 0x48,
+	0x1e, 0x41, 0x39, 0x69, 0x70, 0xea, 0xa5, 0xdd, 0x7d, 0x7b, 0xde, 0x50, 0xd6, 0x6e, 0xef, 0xe3,
+	0x4e, 0x4d, 0x64, 0xf9, 0xfc, 0xe5, 0xb6, 0x96, 0x1e, 0x60, 0x4d, 0xda, 0xee, 0x79, 0xe6, 0xef,
+	0x2a, 0xe8, 0x69, 0xe8, 0x1d, 0xca, 0xd9, 0xff, 0x27, 0x72, 0x74, 0x0f, 0x4a, 0xa2, 0x03, 0x98,
+	0x51, 0x5a, 0xa1, 0xb9, 0x13, 0x13, 0xf3, 0xb7, 0x6b, 0x50, 0x79, 0x18, 0xfa, 0x91, 0xe3, 0xf2,
+	0xff, 0xd6, 0xd8, 0x7a, 0x5f, 0xc4, 0x21, 0xa1, 0x92, 0xcc, 0x18, 0xf3, 0x50, 0x33, 0x18, 0xa9,
+	0x36, 0x7a, 0x00, 0xe5, 0x09, 0xe1, 0x2c, 0xca, 0x47, 0x62, 0xf9, 0x28, 0xd5, 0xd8, 0xa7, 0x6c,
+	0xf2, 0xf0, 0xa6, 0x56, 0xe8, 0x03, 0xd0, 0x73, 0x63, 0xdf, 0x58, 0xbb, 0xca, 0x7d, 0x3a, 0xfe,
+	0x21, 0xa3, 0x02, 0xf4, 0x1a, 0x68, 0x7c, 0x64, 0x1f, 0x93, 0x31, 0x33, 0xb4, 0x46, 0x61, 0xa7,
+	0x82, 0xd7, 0xf8, 0xe8, 0x33, 0x32, 0x66, 0xe6, 0x57, 0xb0, 0x99, 0xcf, 0xf2, 0xd1, 0x88, 0x61,
+	0xf2, 0xed, 0x09, 0x61, 0xab, 0x8e, 0x12, 0x03, 0x34, 0xf9, 0xd0, 0x08, 0x33, 0x0a, 0x8d, 0xc2,
+	0x4e, 0x15, 0x4f, 0xb6, 0xe6, 0x31, 0xd4, 0x2e, 0x79, 0xf8, 0xa7, 0xa0, 0xd1, 0x0d, 0x28, 0xf0,
+	0x91, 0x60, 0x0e, 0x71, 0x23, 0xb1, 0x34, 0xef, 0x43, 0xcd, 0x72, 0xd8, 0xdf, 0xef, 0x1b, 0xf3,
+	0x67, 0x0d, 0xb4, 0x03, 0xc2, 0x98, 0xd3, 0x27, 0xe8, 0x53, 0xb8, 0x1e, 0x90, 0xb3, 0x64, 0x8e,
+	0xdb, 0x92, 0xbd, 0x93, 0x71, 0x67, 0x36, 0x17, 0x7d, 0x98, 0x34, 0xf3, 0x5f, 0x07, 0x96, 0x82,
+	0x2b, 0x41, 0x6e, 0x8f, 0x0e, 0xa0, 0x26, 0xb0, 0x4e, 0x05, 0x0d, 0xdb, 0xb2, 0xab, 0xa4, 0x5f,
+	0xbd, 0xfd, 0xf6, 0x95, 0x60, 0x19, 0x65, 0x5b, 0x0a, 0xae, 0x06, 0xf9, 0x83, 0x19, 0x46, 0x5b,
+	0xc0, 0x1c, 0x19, 0xce, 0x84, 0xb8, 0xac, 0x1c, 0xa3, 0xa1, 0x4f, 0x2e, 0x71, 0x4f, 0xd2, 0xc8,
+	0x6f, 0x2d, 0x47, 0x38, 0x7c, 0xba, 0x6f, 0xcd, 0x52, 0x0f, 0x7a, 0x00, 0x90, 0x3d, 0x32, 0xa3,
+	0x34, 0xff, 0xb2, 0x32, 0x94, 0x29, 0x45, 0x59, 0x0a, 0x5e, 0x9f, 0xbe, 0x2a, 0xc1, 0x40, 0x92,
+	0x47, 0xd6, 0xe6, 0x59, 0x39, 0xb3, 0x15, 0xc3, 0xcf, 0x52, 0x12, 0x36, 0x41, 0xf7, 0xa0, 0x3c,
+	0x70, 0x98, 0x2d, 0xad, 0x34, 0x69, 0xf5, 0xe6, 0x62, 0xab, 0x94, 0x72, 0x2c, 0x05, 0x6b, 0x83,
+	0x64, 0x29, 0x0a, 0x2a, 0xec, 0xe4, 0x38, 0xf0, 0x05, 0x0b, 0x18, 0xe5, 0x65, 0x05, 0xcd, 0xf3,
+	0x85, 0x28, 0xe8, 0x69, 0x6e, 0x8f, 0x1e, 0x43, 0x75, 0x8a, 0x25, 0xc6, 0x98, 0xb1, 0xbe, 0x2c,
+	0x89, 0xb9, 0xf9, 0x2d, 0x92, 0x78, 0x9a, 0x6d, 0xd1, 0x1e, 0x54, 0xdd, 0xa4, 0x5f, 0xd3, 0xbe,
+	0x80, 0x65, 0x31, 0xe5, 0x5b, 0x5b, 0xc4, 0xe4, 0xe6, 0x5b, 0x9d, 0xc2, 0xed, 0x19, 0x28, 0x9b,
+	0x8f, 0x98, 0x1d, 0x27, 0xef, 0xd9, 0xd0, 0x25, 0xec, 0x9d, 0xbf, 0x86, 0xcd, 0x66, 0x80, 0xa5,
+	0xe0, 0x4d, 0x77, 0xa1, 0x04, 0x75, 0xe1, 0xe6, 0x9c, 0x2b, 0xa3, 0x22, 0x5d, 0xbc, 0xf3, 0x4a,
+	0x2e, 0x2c, 0x05, 0xd7, 0x2e, 0x61, 0x0b, 0x50, 0x51, 0xdb, 0xd9, 0x74, 0x54, 0x97, 0x81, 0x5e,
+	0x7a, 0xec, 0x02, 0x74, 0x30, 0x7b, 0xd4, 0x29, 0x41, 0x81, 0x9d, 0xf8, 0x9d, 0xcf, 0x9f, 0x9f,
+	0xd7, 0xd5, 0x17, 0xe7, 0x75, 0xf5, 0xd7, 0xf3, 0xba, 0xfa, 0xfd, 0x45, 0x5d, 0x79, 0x71, 0x51,
+	0x57, 0x7e, 0xb9, 0xa8, 0x2b, 0x5f, 0xde, 0xed, 0x53, 0x3e, 0x38, 0xe9, 0x35, 0xdd, 0xd0, 0x6f,
+	0xb9, 0xa1, 0x4f, 0x78, 0xef, 0x6b, 0x9e, 0x2d, 0x92, 0x3f, 0x8d, 0x45, 0xff, 0x2a, 0xbd, 0x35,
+	0x29, 0xdb, 0xfd, 0x73, 0x00, 0xbb, 0x70, 0x73, 0x52, 0xca, 0x0c, 0x00, 0x00,
 }
 
 func (m *NewRoundStep) Marshal() (dAtA []byte, err error) {
@@ -1274,7 +1624,7 @@ func (m *VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
+func (m *CompactBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1284,38 +1634,28 @@ func (m *Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactBlock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if len(m.TxKeys) > 0 {
+		for iNdEx := len(m.TxKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxKeys[iNdEx])
+			copy(dAtA[i:], m.TxKeys[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TxKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Message_NewRoundStep) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NewRoundStep != nil {
+	if m.LastCommit != nil {
 		{
-			size, err := m.NewRoundStep.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastCommit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1323,41 +1663,279 @@ func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_NewValidBlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_NewValidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NewValidBlock != nil {
-		{
-			size, err := m.NewValidBlock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	{
+		size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Proposal != nil {
-		{
-			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.BlockPartSetHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactBlockTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactBlockTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactBlockTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		dAtA15 := make([]byte, len(m.Indexes)*10)
+		var j14 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintTypes(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactBlockTxs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactBlockTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactBlockTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Indexes) > 0 {
+		dAtA17 := make([]byte, len(m.Indexes)*10)
+		var j16 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintTypes(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HasCompactBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HasCompactBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HasCompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message_NewRoundStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NewRoundStep != nil {
+		{
+			size, err := m.NewRoundStep.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_NewValidBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NewValidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NewValidBlock != nil {
+		{
+			size, err := m.NewValidBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1495,44 +2073,128 @@ func (m *Message_VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *NewRoundStep) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
-	}
-	if m.Round != 0 {
-		n += 1 + sovTypes(uint64(m.Round))
-	}
-	if m.Step != 0 {
-		n += 1 + sovTypes(uint64(m.Step))
-	}
-	if m.SecondsSinceStartTime != 0 {
-		n += 1 + sovTypes(uint64(m.SecondsSinceStartTime))
-	}
-	if m.LastCommitRound != 0 {
-		n += 1 + sovTypes(uint64(m.LastCommitRound))
-	}
-	return n
+func (m *Message_CompactBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NewValidBlock) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Message_CompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlock != nil {
+		{
+			size, err := m.CompactBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_CompactBlockTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlockTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlockTxsRequest != nil {
+		{
+			size, err := m.CompactBlockTxsRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_CompactBlockTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlockTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlockTxs != nil {
+		{
+			size, err := m.CompactBlockTxs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_HasCompactBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_HasCompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HasCompactBlock != nil {
+		{
+			size, err := m.HasCompactBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NewRoundStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if m.Step != 0 {
+		n += 1 + sovTypes(uint64(m.Step))
+	}
+	if m.SecondsSinceStartTime != 0 {
+		n += 1 + sovTypes(uint64(m.SecondsSinceStartTime))
+	}
+	if m.LastCommitRound != 0 {
+		n += 1 + sovTypes(uint64(m.LastCommitRound))
+	}
+	return n
+}
+
+func (m *NewValidBlock) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1675,6 +2337,102 @@ func (m *VoteSetBits) Size() (n int) {
 	return n
 }
 
+func (m *CompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = m.BlockPartSetHeader.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Header.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Evidence.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.LastCommit != nil {
+		l = m.LastCommit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.TxKeys) > 0 {
+		for _, b := range m.TxKeys {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompactBlockTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *CompactBlockTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HasCompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1793,16 +2551,590 @@ func (m *Message_VoteSetBits) Size() (n int) {
 		l = m.VoteSetBits.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	return n
-}
+	return n
+}
+func (m *Message_CompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlock != nil {
+		l = m.CompactBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_CompactBlockTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlockTxsRequest != nil {
+		l = m.CompactBlockTxsRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_CompactBlockTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlockTxs != nil {
+		l = m.CompactBlockTxs.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_HasCompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasCompactBlock != nil {
+		l = m.HasCompactBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NewRoundStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRoundStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRoundStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsSinceStartTime", wireType)
+			}
+			m.SecondsSinceStartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsSinceStartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommitRound", wireType)
+			}
+			m.LastCommitRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCommitRound |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewValidBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewValidBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewValidBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockPartSetHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockPartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockParts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockParts == nil {
+				m.BlockParts = &bits.BitArray{}
+			}
+			if err := m.BlockParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCommit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCommit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalPOL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalPOL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalPolRound", wireType)
+			}
+			m.ProposalPolRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalPolRound |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalPol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposalPol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *NewRoundStep) Unmarshal(dAtA []byte) error {
+func (m *BlockPart) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,10 +3157,10 @@ func (m *NewRoundStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewRoundStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockPart: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewRoundStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockPart: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1870,10 +3202,10 @@ func (m *NewRoundStep) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Part", wireType)
 			}
-			m.Step = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1883,35 +3215,80 @@ func (m *NewRoundStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Step |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsSinceStartTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.SecondsSinceStartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SecondsSinceStartTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCommitRound", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastCommitRound = 0
+			if err := m.Part.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1921,11 +3298,28 @@ func (m *NewRoundStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastCommitRound |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vote == nil {
+				m.Vote = &types.Vote{}
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1947,7 +3341,7 @@ func (m *NewRoundStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewValidBlock) Unmarshal(dAtA []byte) error {
+func (m *HasVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1970,10 +3364,10 @@ func (m *NewValidBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewValidBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: HasVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewValidBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HasVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2015,10 +3409,10 @@ func (m *NewValidBlock) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockPartSetHeader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2028,66 +3422,16 @@ func (m *NewValidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= types.SignedMsgType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BlockPartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockParts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BlockParts == nil {
-				m.BlockParts = &bits.BitArray{}
-			}
-			if err := m.BlockParts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var v int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2097,12 +3441,11 @@ func (m *NewValidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Index |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsCommit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2124,7 +3467,7 @@ func (m *NewValidBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2147,15 +3490,72 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteSetMaj23: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteSetMaj23: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.SignedMsgType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2182,7 +3582,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2207,7 +3607,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
+func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2230,10 +3630,10 @@ func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposalPOL: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteSetBits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposalPOL: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteSetBits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2257,9 +3657,9 @@ func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalPolRound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			m.ProposalPolRound = 0
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2269,14 +3669,33 @@ func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalPolRound |= int32(b&0x7F) << shift
+				m.Round |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.SignedMsgType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalPol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2303,7 +3722,40 @@ func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProposalPol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2328,7 +3780,7 @@ func (m *ProposalPOL) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockPart) Unmarshal(dAtA []byte) error {
+func (m *CompactBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2351,10 +3803,10 @@ func (m *BlockPart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockPart: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockPart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2397,7 +3849,7 @@ func (m *BlockPart) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Part", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockPartSetHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2424,63 +3876,79 @@ func (m *BlockPart) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Part.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockPartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Vote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2507,13 +3975,45 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vote == nil {
-				m.Vote = &types.Vote{}
+			if m.LastCommit == nil {
+				m.LastCommit = &types.Commit{}
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxKeys = append(m.TxKeys, make([]byte, postIndex-iNdEx))
+			copy(m.TxKeys[len(m.TxKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2535,7 +4035,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HasVote) Unmarshal(dAtA []byte) error {
+func (m *CompactBlockTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2558,10 +4058,10 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HasVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactBlockTxsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HasVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactBlockTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2587,45 +4087,7 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			m.Round = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Round |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.SignedMsgType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2635,11 +4097,87 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				m.Round |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2661,7 +4199,7 @@ func (m *HasVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
+func (m *CompactBlockTxs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2684,10 +4222,10 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteSetMaj23: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactBlockTxs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteSetMaj23: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactBlockTxs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2729,29 +4267,86 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.SignedMsgType(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2761,24 +4356,23 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2801,7 +4395,7 @@ func (m *VoteSetMaj23) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
+func (m *HasCompactBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2824,10 +4418,10 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteSetBits: wiretype end group for non-group")
+			return fmt.Errorf("proto: HasCompactBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteSetBits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HasCompactBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2868,91 +4462,6 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.SignedMsgType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3318,6 +4827,146 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_VoteSetBits{v}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlock{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlockTxsRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlockTxsRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlockTxsRequest{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlockTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlockTxs{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlockTxs{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCompactBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HasCompactBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_HasCompactBlock{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cometbft/cometbft/abci/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	types1 "github.com/cometbft/cometbft/proto/tendermint/types"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -108,6 +110,144 @@ func (m *RequestBroadcastTx) GetTx() []byte {
 	return nil
 }
 
+// RequestValidatorSet requests the validator set at the given height, or at
+// the latest height if 0.
+type RequestValidatorSet struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RequestValidatorSet) Reset()         { *m = RequestValidatorSet{} }
+func (m *RequestValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*RequestValidatorSet) ProtoMessage()    {}
+func (*RequestValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffff5682c662b95, []int{2}
+}
+func (m *RequestValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestValidatorSet.Merge(m, src)
+}
+func (m *RequestValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestValidatorSet proto.InternalMessageInfo
+
+func (m *RequestValidatorSet) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// RequestConsensusParams requests the consensus params at the given height,
+// or at the latest height if 0.
+type RequestConsensusParams struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RequestConsensusParams) Reset()         { *m = RequestConsensusParams{} }
+func (m *RequestConsensusParams) String() string { return proto.CompactTextString(m) }
+func (*RequestConsensusParams) ProtoMessage()    {}
+func (*RequestConsensusParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffff5682c662b95, []int{3}
+}
+func (m *RequestConsensusParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestConsensusParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestConsensusParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestConsensusParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestConsensusParams.Merge(m, src)
+}
+func (m *RequestConsensusParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestConsensusParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestConsensusParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestConsensusParams proto.InternalMessageInfo
+
+func (m *RequestConsensusParams) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// RequestExtendedCommit requests the extended commit of the block at the given
+// height, or at the latest height if 0.
+type RequestExtendedCommit struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RequestExtendedCommit) Reset()         { *m = RequestExtendedCommit{} }
+func (m *RequestExtendedCommit) String() string { return proto.CompactTextString(m) }
+func (*RequestExtendedCommit) ProtoMessage()    {}
+func (*RequestExtendedCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffff5682c662b95, []int{4}
+}
+func (m *RequestExtendedCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestExtendedCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestExtendedCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestExtendedCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestExtendedCommit.Merge(m, src)
+}
+func (m *RequestExtendedCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestExtendedCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestExtendedCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestExtendedCommit proto.InternalMessageInfo
+
+func (m *RequestExtendedCommit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type ResponsePing struct {
 }
 
@@ -115,7 +255,7 @@ func (m *ResponsePing) Reset()         { *m = ResponsePing{} }
 func (m *ResponsePing) String() string { return proto.CompactTextString(m) }
 func (*ResponsePing) ProtoMessage()    {}
 func (*ResponsePing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{2}
+	return fileDescriptor_0ffff5682c662b95, []int{5}
 }
 func (m *ResponsePing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,7 +293,7 @@ func (m *ResponseBroadcastTx) Reset()         { *m = ResponseBroadcastTx{} }
 func (m *ResponseBroadcastTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseBroadcastTx) ProtoMessage()    {}
 func (*ResponseBroadcastTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffff5682c662b95, []int{3}
+	return fileDescriptor_0ffff5682c662b95, []int{6}
 }
 func (m *ResponseBroadcastTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,38 +336,259 @@ func (m *ResponseBroadcastTx) GetTxResult() *types.ExecTxResult {
 	return nil
 }
 
+// ResponseValidatorSet contains the validator set at a height and, if the
+// block at that height is available, its header and a proof of the set's hash
+// against the header's validators_hash.
+type ResponseValidatorSet struct {
+	Height       int64                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	ValidatorSet *types1.ValidatorSet `protobuf:"bytes,2,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+	Header       *types1.Header       `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	Proof        *crypto.Proof        `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *ResponseValidatorSet) Reset()         { *m = ResponseValidatorSet{} }
+func (m *ResponseValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*ResponseValidatorSet) ProtoMessage()    {}
+func (*ResponseValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffff5682c662b95, []int{7}
+}
+func (m *ResponseValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseValidatorSet.Merge(m, src)
+}
+func (m *ResponseValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseValidatorSet proto.InternalMessageInfo
+
+func (m *ResponseValidatorSet) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ResponseValidatorSet) GetValidatorSet() *types1.ValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
+}
+
+func (m *ResponseValidatorSet) GetHeader() *types1.Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ResponseValidatorSet) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// ResponseConsensusParams contains the consensus params at a height and, if
+// the block at that height is available, its header and a proof of the
+// params' hash against the header's consensus_hash.
+type ResponseConsensusParams struct {
+	Height          int64                   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	ConsensusParams *types1.ConsensusParams `protobuf:"bytes,2,opt,name=consensus_params,json=consensusParams,proto3" json:"consensus_params,omitempty"`
+	Header          *types1.Header          `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	Proof           *crypto.Proof           `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *ResponseConsensusParams) Reset()         { *m = ResponseConsensusParams{} }
+func (m *ResponseConsensusParams) String() string { return proto.CompactTextString(m) }
+func (*ResponseConsensusParams) ProtoMessage()    {}
+func (*ResponseConsensusParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffff5682c662b95, []int{8}
+}
+func (m *ResponseConsensusParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseConsensusParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseConsensusParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseConsensusParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseConsensusParams.Merge(m, src)
+}
+func (m *ResponseConsensusParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseConsensusParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseConsensusParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseConsensusParams proto.InternalMessageInfo
+
+func (m *ResponseConsensusParams) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ResponseConsensusParams) GetConsensusParams() *types1.ConsensusParams {
+	if m != nil {
+		return m.ConsensusParams
+	}
+	return nil
+}
+
+func (m *ResponseConsensusParams) GetHeader() *types1.Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ResponseConsensusParams) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// ResponseExtendedCommit contains the header of a block and its extended
+// commit, with the vote extensions of the precommits and their signatures.
+type ResponseExtendedCommit struct {
+	Header         *types1.Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ExtendedCommit *types1.ExtendedCommit `protobuf:"bytes,2,opt,name=extended_commit,json=extendedCommit,proto3" json:"extended_commit,omitempty"`
+}
+
+func (m *ResponseExtendedCommit) Reset()         { *m = ResponseExtendedCommit{} }
+func (m *ResponseExtendedCommit) String() string { return proto.CompactTextString(m) }
+func (*ResponseExtendedCommit) ProtoMessage()    {}
+func (*ResponseExtendedCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffff5682c662b95, []int{9}
+}
+func (m *ResponseExtendedCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseExtendedCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseExtendedCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseExtendedCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseExtendedCommit.Merge(m, src)
+}
+func (m *ResponseExtendedCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseExtendedCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseExtendedCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseExtendedCommit proto.InternalMessageInfo
+
+func (m *ResponseExtendedCommit) GetHeader() *types1.Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ResponseExtendedCommit) GetExtendedCommit() *types1.ExtendedCommit {
+	if m != nil {
+		return m.ExtendedCommit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RequestPing)(nil), "tendermint.rpc.grpc.RequestPing")
 	proto.RegisterType((*RequestBroadcastTx)(nil), "tendermint.rpc.grpc.RequestBroadcastTx")
+	proto.RegisterType((*RequestValidatorSet)(nil), "tendermint.rpc.grpc.RequestValidatorSet")
+	proto.RegisterType((*RequestConsensusParams)(nil), "tendermint.rpc.grpc.RequestConsensusParams")
+	proto.RegisterType((*RequestExtendedCommit)(nil), "tendermint.rpc.grpc.RequestExtendedCommit")
 	proto.RegisterType((*ResponsePing)(nil), "tendermint.rpc.grpc.ResponsePing")
 	proto.RegisterType((*ResponseBroadcastTx)(nil), "tendermint.rpc.grpc.ResponseBroadcastTx")
+	proto.RegisterType((*ResponseValidatorSet)(nil), "tendermint.rpc.grpc.ResponseValidatorSet")
+	proto.RegisterType((*ResponseConsensusParams)(nil), "tendermint.rpc.grpc.ResponseConsensusParams")
+	proto.RegisterType((*ResponseExtendedCommit)(nil), "tendermint.rpc.grpc.ResponseExtendedCommit")
 }
 
 func init() { proto.RegisterFile("tendermint/rpc/grpc/types.proto", fileDescriptor_0ffff5682c662b95) }
 
 var fileDescriptor_0ffff5682c662b95 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x4f, 0x02, 0x31,
-	0x14, 0xc7, 0x29, 0x31, 0x8a, 0x05, 0x19, 0xca, 0x42, 0x30, 0x9e, 0x48, 0x4c, 0x64, 0x2a, 0x09,
-	0x6e, 0x32, 0x89, 0x31, 0xd1, 0xb8, 0x90, 0x86, 0xc9, 0x05, 0xb9, 0xf2, 0x84, 0x8b, 0x72, 0x3d,
-	0xdb, 0x47, 0x52, 0xbf, 0x84, 0xf1, 0x0b, 0xb9, 0x3b, 0x32, 0x3a, 0x1a, 0xf8, 0x22, 0xa6, 0x27,
-	0x27, 0x35, 0x46, 0x96, 0xe6, 0xdf, 0xe6, 0xff, 0x7b, 0xfd, 0xbf, 0xd7, 0xd2, 0x43, 0x84, 0x78,
-	0x04, 0x7a, 0x1a, 0xc5, 0xd8, 0xd2, 0x89, 0x6c, 0x8d, 0xdd, 0x82, 0xcf, 0x09, 0x18, 0x9e, 0x68,
-	0x85, 0x8a, 0x55, 0xd6, 0x06, 0xae, 0x13, 0xc9, 0x9d, 0xa1, 0xb6, 0xef, 0x51, 0xc3, 0x50, 0x46,
-	0x3e, 0xd1, 0xd8, 0xa3, 0x45, 0x01, 0x4f, 0x33, 0x30, 0xd8, 0x8b, 0xe2, 0x71, 0xe3, 0x98, 0xb2,
-	0xd5, 0xb6, 0xab, 0xd5, 0x70, 0x24, 0x87, 0x06, 0xfb, 0x96, 0x95, 0x69, 0x1e, 0x6d, 0x95, 0xd4,
-	0x49, 0xb3, 0x24, 0xf2, 0x68, 0x1b, 0x65, 0x5a, 0x12, 0x60, 0x12, 0x15, 0x1b, 0x48, 0xa9, 0x17,
-	0x42, 0x2b, 0xd9, 0x81, 0xcf, 0x75, 0x68, 0x41, 0x4e, 0x40, 0x3e, 0x0c, 0x56, 0x74, 0xb1, 0x5d,
-	0xe7, 0x5e, 0x42, 0x17, 0x86, 0x67, 0xdc, 0x85, 0x33, 0xf6, 0xad, 0xd8, 0x91, 0xdf, 0x82, 0x9d,
-	0xd1, 0x5d, 0xb4, 0x03, 0x0d, 0x66, 0xf6, 0x88, 0xd5, 0x7c, 0x4a, 0x1f, 0xfc, 0xa1, 0x2f, 0x2d,
-	0xc8, 0xbe, 0x15, 0xa9, 0x49, 0x14, 0x70, 0xa5, 0xda, 0x6f, 0x84, 0x96, 0x7e, 0x82, 0x9c, 0xf7,
-	0xae, 0xd9, 0x0d, 0xdd, 0x72, 0x49, 0xd9, 0xaf, 0xfb, 0xb3, 0x09, 0x71, 0x6f, 0x02, 0xb5, 0xa3,
-	0x7f, 0x1c, 0xeb, 0x76, 0xd9, 0x1d, 0x2d, 0xfa, 0x5d, 0x9e, 0x6c, 0xaa, 0xe9, 0x19, 0x6b, 0xcd,
-	0x8d, 0xa5, 0x3d, 0x67, 0xf7, 0xea, 0x7d, 0x11, 0x90, 0xf9, 0x22, 0x20, 0x9f, 0x8b, 0x80, 0xbc,
-	0x2e, 0x83, 0xdc, 0x7c, 0x19, 0xe4, 0x3e, 0x96, 0x41, 0xee, 0x96, 0x8f, 0x23, 0x9c, 0xcc, 0x42,
-	0x2e, 0xd5, 0xb4, 0x25, 0xd5, 0x14, 0x30, 0xbc, 0xc7, 0xb5, 0xc8, 0x3e, 0x45, 0x47, 0x2a, 0x0d,
-	0x4e, 0x84, 0xdb, 0xe9, 0x33, 0x9f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xca, 0xdb, 0xe7,
-	0x3b, 0x02, 0x00, 0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x41, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc6, 0x99, 0x85, 0x3f, 0xff, 0xf5, 0x65, 0x59, 0x4c, 0x51, 0x6c, 0xaa, 0xd4, 0xa5, 0x31,
+	0x11, 0x45, 0x5b, 0xb2, 0xde, 0xe4, 0x24, 0x1b, 0x12, 0x88, 0x1e, 0x36, 0x03, 0xf1, 0xe0, 0x65,
+	0xed, 0xce, 0x0e, 0xbb, 0x0d, 0xdb, 0x9d, 0x3a, 0x9d, 0x25, 0xe5, 0x4b, 0x18, 0x2f, 0x7e, 0x1c,
+	0xef, 0x1e, 0xb9, 0x98, 0x78, 0x31, 0x51, 0xf8, 0x22, 0xa6, 0xd3, 0x96, 0x9d, 0xb6, 0xd0, 0x70,
+	0xf1, 0x42, 0x66, 0x78, 0x7f, 0xcf, 0xf3, 0xf4, 0xed, 0xbc, 0xb3, 0x85, 0xc7, 0x82, 0x4e, 0x06,
+	0x94, 0xfb, 0xde, 0x44, 0x38, 0x3c, 0x20, 0xce, 0x30, 0xfe, 0x23, 0xce, 0x02, 0x1a, 0xda, 0x01,
+	0x67, 0x82, 0x69, 0xab, 0x33, 0xc0, 0xe6, 0x01, 0xb1, 0x63, 0xc0, 0x78, 0xa8, 0xa8, 0xdc, 0x3e,
+	0xf1, 0x54, 0x85, 0xb1, 0xae, 0x14, 0x09, 0x3f, 0x0b, 0x04, 0x73, 0x02, 0xce, 0xd8, 0xf1, 0x35,
+	0x65, 0x29, 0x73, 0x02, 0x97, 0xbb, 0x7e, 0xa6, 0x7e, 0x54, 0x2a, 0xab, 0xde, 0xad, 0x52, 0xf5,
+	0xd4, 0x1d, 0x7b, 0x03, 0x57, 0x30, 0x9e, 0x10, 0xd6, 0x32, 0x2c, 0x61, 0xfa, 0x69, 0x4a, 0x43,
+	0xd1, 0xf5, 0x26, 0x43, 0xeb, 0x09, 0x68, 0xe9, 0x76, 0x97, 0x33, 0x77, 0x40, 0xdc, 0x50, 0x1c,
+	0x45, 0x5a, 0x13, 0x6a, 0x22, 0xd2, 0x51, 0x0b, 0x6d, 0x36, 0x70, 0x4d, 0x44, 0xd6, 0x4b, 0x58,
+	0x4d, 0xa9, 0xf7, 0x99, 0xdd, 0x21, 0x15, 0xda, 0x1a, 0x2c, 0x8e, 0xa8, 0x37, 0x1c, 0x09, 0x89,
+	0xce, 0xe3, 0x74, 0x67, 0x6d, 0xc3, 0x5a, 0x8a, 0x77, 0xd8, 0x24, 0xa4, 0x93, 0x70, 0x1a, 0x76,
+	0x65, 0x0f, 0x37, 0x2a, 0x1c, 0xb8, 0x9f, 0x2a, 0xf6, 0x22, 0xd9, 0xc2, 0xa0, 0xc3, 0x7c, 0xdf,
+	0xbb, 0x39, 0xa2, 0x09, 0x0d, 0x4c, 0xc3, 0x20, 0xb6, 0x97, 0x7d, 0x7c, 0x46, 0xb0, 0x9a, 0xfd,
+	0x43, 0xed, 0x64, 0x07, 0xea, 0x64, 0x44, 0xc9, 0x49, 0x2f, 0xed, 0x67, 0xa9, 0xdd, 0xb2, 0x95,
+	0x13, 0x8b, 0x0f, 0xc7, 0xce, 0x74, 0x9d, 0x18, 0x3c, 0x8a, 0xf0, 0xff, 0x24, 0x59, 0x68, 0xaf,
+	0xe1, 0x8e, 0x88, 0x7a, 0x9c, 0x86, 0xd3, 0xb1, 0xd0, 0x6b, 0x52, 0xbd, 0x5e, 0x52, 0xef, 0x45,
+	0x94, 0x1c, 0x45, 0x58, 0x42, 0xb8, 0x2e, 0xd2, 0x95, 0xf5, 0x03, 0xc1, 0xbd, 0xcc, 0xf8, 0x36,
+	0x2f, 0x4d, 0xeb, 0xc0, 0xf2, 0xd5, 0x59, 0xf5, 0x42, 0x9a, 0x05, 0x9a, 0x6a, 0x60, 0x72, 0xd4,
+	0xaa, 0x1d, 0x6e, 0x9c, 0xaa, 0xe6, 0xdb, 0xb1, 0xb9, 0x3b, 0xa0, 0x5c, 0x9f, 0x97, 0x6a, 0xbd,
+	0xac, 0xde, 0x97, 0x75, 0x9c, 0x72, 0x9a, 0x0d, 0xff, 0xc9, 0xe9, 0xd3, 0x17, 0xca, 0x82, 0x64,
+	0x3a, 0xed, 0x6e, 0x5c, 0xc7, 0x09, 0x66, 0xfd, 0x41, 0xf0, 0xe0, 0xea, 0x85, 0xdd, 0xee, 0x74,
+	0xb5, 0x77, 0x70, 0x97, 0x64, 0x68, 0x2f, 0x99, 0xe6, 0xb4, 0xbb, 0x8d, 0xf2, 0xf3, 0x15, 0x4c,
+	0xf1, 0x0a, 0x29, 0xa4, 0xfc, 0xfb, 0x1e, 0xbf, 0x22, 0x58, 0xcb, 0x7a, 0x2c, 0xcc, 0xe3, 0x2c,
+	0x1c, 0xdd, 0x32, 0xfc, 0x00, 0x56, 0x68, 0xea, 0xd1, 0x23, 0xd2, 0x44, 0xaf, 0x95, 0x07, 0x31,
+	0x91, 0xe6, 0xc3, 0x70, 0x93, 0xe6, 0xf6, 0xed, 0x6f, 0x08, 0x1a, 0x57, 0xc3, 0xfd, 0xa6, 0x7b,
+	0xa0, 0xbd, 0x85, 0x85, 0x78, 0xfa, 0xb5, 0x96, 0x7d, 0xcd, 0xaf, 0x90, 0xad, 0xdc, 0x73, 0x63,
+	0xe3, 0x06, 0x62, 0x76, 0x85, 0xb4, 0x8f, 0xb0, 0xa4, 0xde, 0x9c, 0xa7, 0x55, 0x9e, 0x0a, 0x68,
+	0x6c, 0x56, 0x5a, 0x2b, 0x64, 0xfb, 0x17, 0x82, 0xfa, 0xa1, 0x70, 0x05, 0x8d, 0x9f, 0x9d, 0x40,
+	0x23, 0x77, 0x2f, 0x36, 0xab, 0xf2, 0x54, 0xd2, 0x78, 0x56, 0x19, 0x98, 0x33, 0x1d, 0xc3, 0x4a,
+	0x71, 0x48, 0xb7, 0xaa, 0x72, 0x0a, 0xb0, 0xf1, 0xa2, 0x32, 0xaa, 0x40, 0xb7, 0xa7, 0x50, 0xdf,
+	0x1d, 0x33, 0x72, 0x12, 0xb7, 0xe7, 0x41, 0xb3, 0x30, 0x3a, 0xcf, 0xab, 0x82, 0xf3, 0xac, 0xb1,
+	0x55, 0x99, 0x9b, 0x87, 0x77, 0xf7, 0xbf, 0x5f, 0x98, 0xe8, 0xfc, 0xc2, 0x44, 0xbf, 0x2f, 0x4c,
+	0xf4, 0xe5, 0xd2, 0x9c, 0x3b, 0xbf, 0x34, 0xe7, 0x7e, 0x5e, 0x9a, 0x73, 0x1f, 0xec, 0xa1, 0x27,
+	0x46, 0xd3, 0xbe, 0x4d, 0x98, 0xef, 0x10, 0xe6, 0x53, 0xd1, 0x3f, 0x16, 0xb3, 0x45, 0xf6, 0x3d,
+	0xdb, 0x21, 0x8c, 0xd3, 0x78, 0xd1, 0x5f, 0x94, 0xdf, 0x88, 0x57, 0x7f, 0x07, 0x00, 0x47, 0xb9,
+	0x32, 0xab, 0xf6, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -347,84 +708,350 @@ var _BroadcastAPI_serviceDesc = grpc.ServiceDesc{
 	Metadata: "tendermint/rpc/grpc/types.proto",
 }
 
-func (m *RequestPing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// StateAPIClient is the client API for StateAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StateAPIClient interface {
+	ValidatorSet(ctx context.Context, in *RequestValidatorSet, opts ...grpc.CallOption) (*ResponseValidatorSet, error)
+	ConsensusParams(ctx context.Context, in *RequestConsensusParams, opts ...grpc.CallOption) (*ResponseConsensusParams, error)
 }
 
-func (m *RequestPing) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type stateAPIClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *RequestPing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewStateAPIClient(cc grpc1.ClientConn) StateAPIClient {
+	return &stateAPIClient{cc}
 }
 
-func (m *RequestBroadcastTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *stateAPIClient) ValidatorSet(ctx context.Context, in *RequestValidatorSet, opts ...grpc.CallOption) (*ResponseValidatorSet, error) {
+	out := new(ResponseValidatorSet)
+	err := c.cc.Invoke(ctx, "/tendermint.rpc.grpc.StateAPI/ValidatorSet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RequestBroadcastTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *stateAPIClient) ConsensusParams(ctx context.Context, in *RequestConsensusParams, opts ...grpc.CallOption) (*ResponseConsensusParams, error) {
+	out := new(ResponseConsensusParams)
+	err := c.cc.Invoke(ctx, "/tendermint.rpc.grpc.StateAPI/ConsensusParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RequestBroadcastTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tx) > 0 {
-		i -= len(m.Tx)
-		copy(dAtA[i:], m.Tx)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// StateAPIServer is the server API for StateAPI service.
+type StateAPIServer interface {
+	ValidatorSet(context.Context, *RequestValidatorSet) (*ResponseValidatorSet, error)
+	ConsensusParams(context.Context, *RequestConsensusParams) (*ResponseConsensusParams, error)
 }
 
-func (m *ResponsePing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedStateAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedStateAPIServer struct {
 }
 
-func (m *ResponsePing) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedStateAPIServer) ValidatorSet(ctx context.Context, req *RequestValidatorSet) (*ResponseValidatorSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSet not implemented")
+}
+func (*UnimplementedStateAPIServer) ConsensusParams(ctx context.Context, req *RequestConsensusParams) (*ResponseConsensusParams, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusParams not implemented")
 }
 
-func (m *ResponsePing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterStateAPIServer(s grpc1.Server, srv StateAPIServer) {
+	s.RegisterService(&_StateAPI_serviceDesc, srv)
 }
 
-func (m *ResponseBroadcastTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+func _StateAPI_ValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateAPIServer).ValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.rpc.grpc.StateAPI/ValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateAPIServer).ValidatorSet(ctx, req.(*RequestValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StateAPI_ConsensusParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestConsensusParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateAPIServer).ConsensusParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.rpc.grpc.StateAPI/ConsensusParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateAPIServer).ConsensusParams(ctx, req.(*RequestConsensusParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var StateAPI_serviceDesc = _StateAPI_serviceDesc
+var _StateAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.rpc.grpc.StateAPI",
+	HandlerType: (*StateAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidatorSet",
+			Handler:    _StateAPI_ValidatorSet_Handler,
+		},
+		{
+			MethodName: "ConsensusParams",
+			Handler:    _StateAPI_ConsensusParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/rpc/grpc/types.proto",
+}
+
+// BlockAPIClient is the client API for BlockAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlockAPIClient interface {
+	ExtendedCommit(ctx context.Context, in *RequestExtendedCommit, opts ...grpc.CallOption) (*ResponseExtendedCommit, error)
+}
+
+type blockAPIClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlockAPIClient(cc grpc1.ClientConn) BlockAPIClient {
+	return &blockAPIClient{cc}
+}
+
+func (c *blockAPIClient) ExtendedCommit(ctx context.Context, in *RequestExtendedCommit, opts ...grpc.CallOption) (*ResponseExtendedCommit, error) {
+	out := new(ResponseExtendedCommit)
+	err := c.cc.Invoke(ctx, "/tendermint.rpc.grpc.BlockAPI/ExtendedCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockAPIServer is the server API for BlockAPI service.
+type BlockAPIServer interface {
+	ExtendedCommit(context.Context, *RequestExtendedCommit) (*ResponseExtendedCommit, error)
+}
+
+// UnimplementedBlockAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedBlockAPIServer struct {
+}
+
+func (*UnimplementedBlockAPIServer) ExtendedCommit(ctx context.Context, req *RequestExtendedCommit) (*ResponseExtendedCommit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendedCommit not implemented")
+}
+
+func RegisterBlockAPIServer(s grpc1.Server, srv BlockAPIServer) {
+	s.RegisterService(&_BlockAPI_serviceDesc, srv)
+}
+
+func _BlockAPI_ExtendedCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestExtendedCommit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockAPIServer).ExtendedCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.rpc.grpc.BlockAPI/ExtendedCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockAPIServer).ExtendedCommit(ctx, req.(*RequestExtendedCommit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var BlockAPI_serviceDesc = _BlockAPI_serviceDesc
+var _BlockAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.rpc.grpc.BlockAPI",
+	HandlerType: (*BlockAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExtendedCommit",
+			Handler:    _BlockAPI_ExtendedCommit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/rpc/grpc/types.proto",
+}
+
+func (m *RequestPing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestPing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestPing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestBroadcastTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestBroadcastTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestBroadcastTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestConsensusParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestConsensusParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestExtendedCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestExtendedCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestExtendedCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponsePing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponsePing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponsePing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseBroadcastTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -470,39 +1097,250 @@ func (m *ResponseBroadcastTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ResponseValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RequestPing) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *ResponseValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestBroadcastTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ResponseValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Tx)
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValidatorSet != nil {
+		{
+			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseConsensusParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseConsensusParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ConsensusParams != nil {
+		{
+			size, err := m.ConsensusParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseExtendedCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseExtendedCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseExtendedCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtendedCommit != nil {
+		{
+			size, err := m.ExtendedCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RequestPing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RequestBroadcastTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
 }
 
+func (m *RequestValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RequestConsensusParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RequestExtendedCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *ResponsePing) Size() (n int) {
 	if m == nil {
 		return 0
@@ -529,6 +1367,71 @@ func (m *ResponseBroadcastTx) Size() (n int) {
 	return n
 }
 
+func (m *ResponseValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.ValidatorSet != nil {
+		l = m.ValidatorSet.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseConsensusParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.ConsensusParams != nil {
+		l = m.ConsensusParams.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseExtendedCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExtendedCommit != nil {
+		l = m.ExtendedCommit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -545,25 +1448,488 @@ func (m *RequestPing) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTypes
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestPing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestPing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestBroadcastTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestBroadcastTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestBroadcastTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestConsensusParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestConsensusParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestConsensusParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestExtendedCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestExtendedCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestExtendedCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponsePing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponsePing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponsePing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseBroadcastTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseBroadcastTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseBroadcastTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckTx == nil {
+				m.CheckTx = &types.ResponseCheckTx{}
+			}
+			if err := m.CheckTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.TxResult == nil {
+				m.TxResult = &types.ExecTxResult{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestPing: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestPing: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.TxResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -585,7 +1951,7 @@ func (m *RequestPing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestBroadcastTx) Unmarshal(dAtA []byte) error {
+func (m *ResponseValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -608,17 +1974,36 @@ func (m *RequestBroadcastTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestBroadcastTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestBroadcastTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -628,24 +2013,98 @@ func (m *RequestBroadcastTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
-			if m.Tx == nil {
-				m.Tx = []byte{}
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = &types1.ValidatorSet{}
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types1.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -669,7 +2128,7 @@ func (m *RequestBroadcastTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponsePing) Unmarshal(dAtA []byte) error {
+func (m *ResponseConsensusParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -692,12 +2151,139 @@ func (m *ResponsePing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponsePing: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseConsensusParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponsePing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseConsensusParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusParams == nil {
+				m.ConsensusParams = &types1.ConsensusParams{}
+			}
+			if err := m.ConsensusParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types1.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -719,7 +2305,7 @@ func (m *ResponsePing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseBroadcastTx) Unmarshal(dAtA []byte) error {
+func (m *ResponseExtendedCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -742,15 +2328,15 @@ func (m *ResponseBroadcastTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseBroadcastTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResponseExtendedCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseBroadcastTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResponseExtendedCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -777,16 +2363,16 @@ func (m *ResponseBroadcastTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CheckTx == nil {
-				m.CheckTx = &types.ResponseCheckTx{}
+			if m.Header == nil {
+				m.Header = &types1.Header{}
 			}
-			if err := m.CheckTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -813,10 +2399,10 @@ func (m *ResponseBroadcastTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxResult == nil {
-				m.TxResult = &types.ExecTxResult{}
+			if m.ExtendedCommit == nil {
+				m.ExtendedCommit = &types1.ExtendedCommit{}
 			}
-			if err := m.TxResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExtendedCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -578,6 +578,159 @@ func (m *State) GetAppHash() []byte {
 	return nil
 }
 
+// ArchiveHeader starts an archive of chain data. It is followed by an
+// ArchiveHeight for each of the archived heights, in increasing order.
+type ArchiveHeader struct {
+	// Version of the archive format.
+	Version    uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChainID    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	FromHeight int64  `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64  `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *ArchiveHeader) Reset()         { *m = ArchiveHeader{} }
+func (m *ArchiveHeader) String() string { return proto.CompactTextString(m) }
+func (*ArchiveHeader) ProtoMessage()    {}
+func (*ArchiveHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccfacf933f22bf93, []int{8}
+}
+func (m *ArchiveHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveHeader.Merge(m, src)
+}
+func (m *ArchiveHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveHeader proto.InternalMessageInfo
+
+func (m *ArchiveHeader) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ArchiveHeader) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ArchiveHeader) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *ArchiveHeader) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// ArchiveHeight holds the chain data of a height in an archive.
+type ArchiveHeight struct {
+	Block *types1.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// Commit for the block, as seen by the exporting node.
+	SeenCommit *types1.Commit `protobuf:"bytes,2,opt,name=seen_commit,json=seenCommit,proto3" json:"seen_commit,omitempty"`
+	// Commit for the block with its vote extensions, if they were enabled.
+	ExtendedCommit *types1.ExtendedCommit `protobuf:"bytes,3,opt,name=extended_commit,json=extendedCommit,proto3" json:"extended_commit,omitempty"`
+	// Response to FinalizeBlock for the block, unless it was discarded.
+	FinalizeBlockResponse *types.ResponseFinalizeBlock `protobuf:"bytes,4,opt,name=finalize_block_response,json=finalizeBlockResponse,proto3" json:"finalize_block_response,omitempty"`
+	// State after committing the block, which holds the validator sets and
+	// consensus params of the next heights.
+	State State `protobuf:"bytes,5,opt,name=state,proto3" json:"state"`
+}
+
+func (m *ArchiveHeight) Reset()         { *m = ArchiveHeight{} }
+func (m *ArchiveHeight) String() string { return proto.CompactTextString(m) }
+func (*ArchiveHeight) ProtoMessage()    {}
+func (*ArchiveHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccfacf933f22bf93, []int{9}
+}
+func (m *ArchiveHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveHeight.Merge(m, src)
+}
+func (m *ArchiveHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveHeight proto.InternalMessageInfo
+
+func (m *ArchiveHeight) GetBlock() *types1.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *ArchiveHeight) GetSeenCommit() *types1.Commit {
+	if m != nil {
+		return m.SeenCommit
+	}
+	return nil
+}
+
+func (m *ArchiveHeight) GetExtendedCommit() *types1.ExtendedCommit {
+	if m != nil {
+		return m.ExtendedCommit
+	}
+	return nil
+}
+
+func (m *ArchiveHeight) GetFinalizeBlockResponse() *types.ResponseFinalizeBlock {
+	if m != nil {
+		return m.FinalizeBlockResponse
+	}
+	return nil
+}
+
+func (m *ArchiveHeight) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return State{}
+}
+
 func init() {
 	proto.RegisterType((*LegacyABCIResponses)(nil), "tendermint.state.LegacyABCIResponses")
 	proto.RegisterType((*ResponseBeginBlock)(nil), "tendermint.state.ResponseBeginBlock")
@@ -587,73 +740,84 @@ func init() {
 	proto.RegisterType((*ABCIResponsesInfo)(nil), "tendermint.state.ABCIResponsesInfo")
 	proto.RegisterType((*Version)(nil), "tendermint.state.Version")
 	proto.RegisterType((*State)(nil), "tendermint.state.State")
+	proto.RegisterType((*ArchiveHeader)(nil), "tendermint.state.ArchiveHeader")
+	proto.RegisterType((*ArchiveHeight)(nil), "tendermint.state.ArchiveHeight")
 }
 
 func init() { proto.RegisterFile("tendermint/state/types.proto", fileDescriptor_ccfacf933f22bf93) }
 
 var fileDescriptor_ccfacf933f22bf93 = []byte{
-	// 964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xd6, 0xad, 0x7f, 0x3c, 0xc7, 0x89, 0x33, 0x26, 0xad, 0xeb, 0xb6, 0xb6, 0xb1, 0xda,
-	0x2a, 0x42, 0x68, 0x2d, 0xb5, 0x27, 0x2e, 0x45, 0xb1, 0x13, 0x88, 0xa5, 0x80, 0xd0, 0x26, 0x54,
-	0x2a, 0x87, 0xae, 0xc6, 0xbb, 0x63, 0x7b, 0x84, 0xbd, 0xbb, 0xda, 0x19, 0x1b, 0x87, 0x3b, 0x37,
-	0x0e, 0xbd, 0xf2, 0x1f, 0xf5, 0xd8, 0x23, 0x17, 0x02, 0x38, 0x12, 0x07, 0xfe, 0x0a, 0x34, 0x3f,
-	0xf6, 0x87, 0xbd, 0x41, 0x04, 0xe5, 0xe6, 0x9d, 0xf7, 0xbd, 0xef, 0x7d, 0xef, 0x9b, 0x79, 0x33,
-	0x86, 0xc7, 0x9c, 0x78, 0x2e, 0x09, 0x67, 0xd4, 0xe3, 0x5d, 0xc6, 0x31, 0x27, 0x5d, 0x7e, 0x11,
-	0x10, 0x66, 0x06, 0xa1, 0xcf, 0x7d, 0x54, 0x4d, 0xa2, 0xa6, 0x8c, 0x36, 0x3e, 0x1a, 0xfb, 0x63,
-	0x5f, 0x06, 0xbb, 0xe2, 0x97, 0xc2, 0x35, 0x5a, 0x63, 0xdf, 0x1f, 0x4f, 0x49, 0x57, 0x7e, 0x0d,
-	0xe7, 0xa3, 0x2e, 0xa7, 0x33, 0xc2, 0x38, 0x9e, 0x05, 0x1a, 0xf0, 0x28, 0x55, 0x06, 0x0f, 0x1d,
-	0x9a, 0xae, 0xd2, 0x78, 0x92, 0x0a, 0xca, 0xf5, 0x6e, 0x80, 0x43, 0x3c, 0x8b, 0xc2, 0x8f, 0x33,
-	0xe1, 0x74, 0x72, 0x3b, 0x13, 0x5d, 0xe0, 0x29, 0x75, 0x31, 0xf7, 0x43, 0x8d, 0x68, 0xa6, 0x10,
-	0x0b, 0x12, 0x32, 0xea, 0x7b, 0x69, 0x86, 0xce, 0x6f, 0x06, 0xd4, 0x4e, 0xc9, 0x18, 0x3b, 0x17,
-	0x87, 0xbd, 0xfe, 0xc0, 0x22, 0x2c, 0xf0, 0x3d, 0x46, 0x18, 0x7a, 0x05, 0x65, 0x97, 0x4c, 0xe9,
-	0x82, 0x84, 0x36, 0x5f, 0xb2, 0xba, 0xd1, 0xce, 0x1d, 0x94, 0x5f, 0x3c, 0x31, 0x53, 0x96, 0x88,
-	0x4e, 0xcc, 0xe3, 0x25, 0x71, 0xce, 0x97, 0x16, 0x61, 0xf3, 0x29, 0xb7, 0x40, 0x67, 0x9c, 0x2f,
-	0x19, 0xfa, 0x1c, 0x4a, 0xc4, 0x73, 0xed, 0xe1, 0xd4, 0x77, 0xbe, 0xaf, 0xdf, 0x69, 0x1b, 0x07,
-	0xe5, 0x17, 0x1d, 0x73, 0xd3, 0x50, 0x33, 0xaa, 0x77, 0xec, 0xb9, 0x3d, 0x81, 0xb4, 0x8a, 0x44,
-	0xff, 0x42, 0xc7, 0x50, 0x1e, 0x92, 0x31, 0xf5, 0x34, 0x45, 0x4e, 0x52, 0x3c, 0xfd, 0x77, 0x8a,
-	0x9e, 0x00, 0x2b, 0x12, 0x18, 0xc6, 0xbf, 0x3b, 0x6f, 0x01, 0x65, 0x11, 0xe8, 0x04, 0xf2, 0x64,
-	0x41, 0x3c, 0x1e, 0x35, 0x76, 0x3f, 0xdb, 0x98, 0x08, 0xf7, 0xea, 0xef, 0x2f, 0x5b, 0x5b, 0x7f,
-	0x5f, 0xb6, 0xaa, 0x0a, 0xfd, 0xa9, 0x3f, 0xa3, 0x9c, 0xcc, 0x02, 0x7e, 0x61, 0xe9, 0xfc, 0xce,
-	0xcf, 0x77, 0xa0, 0xba, 0xd9, 0x05, 0x3a, 0x83, 0xbd, 0x78, 0x1f, 0xec, 0x79, 0xe0, 0x62, 0x4e,
-	0xa2, 0x4a, 0xed, 0x4c, 0xa5, 0xd7, 0x11, 0xf2, 0x5b, 0x09, 0xec, 0xdd, 0x15, 0x35, 0xad, 0xea,
-	0x62, 0x7d, 0x99, 0xa1, 0x37, 0xf0, 0xc0, 0x11, 0x55, 0x3c, 0x36, 0x67, 0xb6, 0x3c, 0x23, 0x31,
-	0xb5, 0xf2, 0xf7, 0xe3, 0x34, 0xb5, 0xda, 0xe3, 0x7e, 0x94, 0xf0, 0x8d, 0x3c, 0x53, 0xd6, 0xbe,
-	0xb3, 0xb6, 0x10, 0x51, 0x27, 0x76, 0xe4, 0x6e, 0x69, 0xc7, 0x4f, 0x06, 0xec, 0xc4, 0x0d, 0xb1,
-	0x81, 0x37, 0xf2, 0x51, 0x1f, 0x2a, 0x89, 0x19, 0x8c, 0xf0, 0xba, 0x21, 0xd5, 0x36, 0xb3, 0x6a,
-	0xe3, 0xc4, 0x33, 0xc2, 0xad, 0xed, 0x45, 0xea, 0x0b, 0x99, 0x50, 0x9b, 0x62, 0xc6, 0xed, 0x09,
-	0xa1, 0xe3, 0x09, 0xb7, 0x9d, 0x09, 0xf6, 0xc6, 0xc4, 0x95, 0x8d, 0xe7, 0xac, 0x3d, 0x11, 0x3a,
-	0x91, 0x91, 0xbe, 0x0a, 0x74, 0x7e, 0x31, 0xa0, 0xb6, 0xd1, 0xbc, 0x14, 0x63, 0x41, 0x75, 0xc3,
-	0x44, 0xa6, 0xf5, 0xfc, 0xb7, 0x7b, 0x7a, 0x67, 0x76, 0xd7, 0x3d, 0x64, 0xff, 0x5b, 0xdb, 0x5f,
-	0x06, 0xec, 0xad, 0x0d, 0x9b, 0x54, 0xf6, 0x06, 0xf6, 0xa7, 0x72, 0x0e, 0x6d, 0x61, 0xb8, 0x1d,
-	0x46, 0x41, 0x2d, 0xef, 0x59, 0xf6, 0xe4, 0x5f, 0x33, 0xb6, 0x56, 0x4d, 0x71, 0x1c, 0x0e, 0x1d,
-	0x9a, 0xcc, 0xf2, 0x7d, 0xc8, 0x2b, 0x6d, 0x5a, 0x93, 0xfe, 0x42, 0x6f, 0xe1, 0x41, 0x54, 0xc6,
-	0x1e, 0x51, 0x0f, 0x4f, 0xe9, 0x8f, 0x64, 0x6d, 0xdc, 0x9e, 0x67, 0xce, 0x41, 0x44, 0xfa, 0x85,
-	0x86, 0xab, 0x81, 0xdb, 0x0f, 0xaf, 0x5b, 0xee, 0x4c, 0xa0, 0xf0, 0x5a, 0x5d, 0x39, 0xe8, 0x10,
-	0x4a, 0xb1, 0x6d, 0xba, 0xa3, 0xb5, 0xcb, 0x44, 0x5f, 0x4d, 0x89, 0xe5, 0xda, 0xec, 0x24, 0x0b,
-	0x35, 0xa0, 0xc8, 0xfc, 0x11, 0xff, 0x01, 0x87, 0x44, 0xf6, 0x51, 0xb2, 0xe2, 0xef, 0xce, 0x9f,
-	0x79, 0xb8, 0x77, 0x26, 0x4c, 0x41, 0x9f, 0x41, 0x41, 0x73, 0xe9, 0x32, 0x0f, 0xb3, 0xc6, 0x69,
-	0x51, 0xba, 0x44, 0x84, 0x47, 0xcf, 0xa1, 0xe8, 0x4c, 0x30, 0xf5, 0x6c, 0xaa, 0x36, 0xaf, 0xd4,
-	0x2b, 0xaf, 0x2e, 0x5b, 0x85, 0xbe, 0x58, 0x1b, 0x1c, 0x59, 0x05, 0x19, 0x1c, 0xb8, 0xe8, 0x19,
-	0xec, 0x50, 0x8f, 0x72, 0x8a, 0xa7, 0x7a, 0xcb, 0xeb, 0x3b, 0xd2, 0xd6, 0x8a, 0x5e, 0x55, 0xbb,
-	0x8d, 0x3e, 0x01, 0xb9, 0xf7, 0xca, 0xd0, 0x08, 0x99, 0x93, 0xc8, 0x5d, 0x11, 0x90, 0x1e, 0x69,
-	0xac, 0x05, 0x95, 0x14, 0x96, 0xba, 0xf5, 0xbb, 0x59, 0xed, 0xea, 0x4c, 0xca, 0xac, 0xc1, 0x51,
-	0xaf, 0x26, 0xb4, 0xaf, 0x2e, 0x5b, 0xe5, 0xd3, 0x88, 0x6a, 0x70, 0x64, 0x95, 0x63, 0xde, 0x81,
-	0x8b, 0x4e, 0x61, 0x37, 0xc5, 0x29, 0xde, 0xa4, 0xfa, 0x3d, 0xc9, 0xda, 0x30, 0xd5, 0x83, 0x65,
-	0x46, 0x0f, 0x96, 0x79, 0x1e, 0x3d, 0x58, 0xbd, 0xa2, 0xa0, 0x7d, 0xf7, 0x7b, 0xcb, 0xb0, 0x2a,
-	0x31, 0x97, 0x88, 0xa2, 0x2f, 0x61, 0xd7, 0x23, 0x4b, 0x6e, 0xc7, 0x53, 0xc9, 0xea, 0xf9, 0x1b,
-	0xcd, 0xf1, 0x8e, 0x48, 0x4b, 0xae, 0x04, 0xf4, 0x0a, 0x20, 0xc5, 0x51, 0xb8, 0x11, 0x47, 0x2a,
-	0x43, 0x08, 0x91, 0x6d, 0xa5, 0x48, 0x8a, 0x37, 0x13, 0x22, 0xd2, 0x52, 0x42, 0xfa, 0xd0, 0x4c,
-	0x8f, 0x6d, 0xc2, 0x17, 0x4f, 0x70, 0x49, 0x6e, 0xd6, 0xa3, 0x64, 0x82, 0x93, 0x6c, 0x3d, 0xcb,
-	0xd7, 0xde, 0x27, 0x70, 0xcb, 0xfb, 0xe4, 0x6b, 0x78, 0xba, 0x76, 0x9f, 0x6c, 0xf0, 0xc7, 0xf2,
-	0xca, 0x52, 0x5e, 0x3b, 0x75, 0xc1, 0xac, 0x13, 0x45, 0x1a, 0xa3, 0x83, 0x18, 0xca, 0x57, 0x9a,
-	0xd9, 0x13, 0xcc, 0x26, 0xf5, 0xed, 0xb6, 0x71, 0xb0, 0xad, 0x0e, 0xa2, 0x7a, 0xbd, 0xd9, 0x09,
-	0x66, 0x13, 0xf4, 0x10, 0x8a, 0x38, 0x08, 0x14, 0xa4, 0x22, 0x21, 0x05, 0x1c, 0x04, 0x22, 0xd4,
-	0xfb, 0xea, 0xfd, 0xaa, 0x69, 0x7c, 0x58, 0x35, 0x8d, 0x3f, 0x56, 0x4d, 0xe3, 0xdd, 0x55, 0x73,
-	0xeb, 0xc3, 0x55, 0x73, 0xeb, 0xd7, 0xab, 0xe6, 0xd6, 0x77, 0x2f, 0xc7, 0x94, 0x4f, 0xe6, 0x43,
-	0xd3, 0xf1, 0x67, 0x5d, 0xc7, 0x9f, 0x11, 0x3e, 0x1c, 0xf1, 0xe4, 0x87, 0xfa, 0xbf, 0xb4, 0xf9,
-	0x4f, 0x6b, 0x98, 0x97, 0xeb, 0x2f, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x11, 0x85, 0xd5, 0x91,
-	0x84, 0x09, 0x00, 0x00,
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x6f, 0x23, 0x45,
+	0x17, 0x4d, 0xe7, 0x65, 0xfb, 0x3a, 0xce, 0xa3, 0xf2, 0x65, 0xd2, 0x93, 0x7c, 0x63, 0x1b, 0x6b,
+	0x66, 0x14, 0x21, 0x68, 0x4b, 0x93, 0xd5, 0x6c, 0x40, 0xb1, 0x13, 0x88, 0xa5, 0x80, 0x50, 0x27,
+	0x8c, 0x34, 0x2c, 0xa6, 0xd5, 0xee, 0x2e, 0xdb, 0x25, 0xdc, 0x0f, 0x75, 0x55, 0x8c, 0xc3, 0x9e,
+	0x1d, 0x12, 0xb3, 0xe5, 0x1f, 0xcd, 0x72, 0x96, 0xb0, 0x20, 0x80, 0x23, 0xb1, 0xe0, 0x57, 0xa0,
+	0x7a, 0xf4, 0xcb, 0x9d, 0x48, 0x89, 0x66, 0xd7, 0x55, 0xe7, 0xdc, 0x73, 0x1f, 0x55, 0xf7, 0x56,
+	0xc3, 0xff, 0x19, 0xf6, 0x5d, 0x1c, 0x79, 0xc4, 0x67, 0x6d, 0xca, 0x6c, 0x86, 0xdb, 0xec, 0x2a,
+	0xc4, 0xd4, 0x08, 0xa3, 0x80, 0x05, 0x68, 0x33, 0x45, 0x0d, 0x81, 0xee, 0xfd, 0x6f, 0x18, 0x0c,
+	0x03, 0x01, 0xb6, 0xf9, 0x97, 0xe4, 0xed, 0x35, 0x86, 0x41, 0x30, 0x1c, 0xe3, 0xb6, 0x58, 0xf5,
+	0x2f, 0x07, 0x6d, 0x46, 0x3c, 0x4c, 0x99, 0xed, 0x85, 0x8a, 0xb0, 0x9f, 0x71, 0x63, 0xf7, 0x1d,
+	0x92, 0xf5, 0xb2, 0x97, 0x8d, 0x41, 0xec, 0xb7, 0xfb, 0xe3, 0xc0, 0xf9, 0x5e, 0xa1, 0x4f, 0x0a,
+	0x68, 0x68, 0x47, 0xb6, 0x77, 0xb7, 0x71, 0x56, 0xba, 0x59, 0x40, 0x27, 0xf6, 0x98, 0xb8, 0x36,
+	0x0b, 0x22, 0xc5, 0xa8, 0x67, 0x18, 0x13, 0x1c, 0x51, 0x12, 0xf8, 0x59, 0x85, 0xd6, 0x1f, 0x1a,
+	0x6c, 0x9f, 0xe1, 0xa1, 0xed, 0x5c, 0x1d, 0x75, 0xba, 0x3d, 0x13, 0xd3, 0x30, 0xf0, 0x29, 0xa6,
+	0xe8, 0x33, 0xa8, 0xba, 0x78, 0x4c, 0x26, 0x38, 0xb2, 0xd8, 0x94, 0xea, 0x5a, 0x73, 0xe9, 0xa0,
+	0xfa, 0xe2, 0x89, 0x91, 0x29, 0x18, 0xcf, 0xd3, 0x38, 0x99, 0x62, 0xe7, 0x62, 0x6a, 0x62, 0x7a,
+	0x39, 0x66, 0x26, 0x28, 0x8b, 0x8b, 0x29, 0x45, 0x9f, 0x43, 0x05, 0xfb, 0xae, 0x25, 0x32, 0xd5,
+	0x17, 0x9b, 0xda, 0x41, 0xf5, 0x45, 0xcb, 0x98, 0x2f, 0xb7, 0x11, 0xfb, 0x3b, 0xf1, 0xdd, 0x0e,
+	0x67, 0x9a, 0x65, 0xac, 0xbe, 0xd0, 0x09, 0x54, 0xfb, 0x78, 0x48, 0x7c, 0x25, 0xb1, 0x24, 0x24,
+	0x9e, 0xde, 0x2d, 0xd1, 0xe1, 0x64, 0x29, 0x02, 0xfd, 0xe4, 0xbb, 0xf5, 0x06, 0x50, 0x91, 0x81,
+	0x4e, 0x61, 0x15, 0x4f, 0xb0, 0xcf, 0xe2, 0xc4, 0x1e, 0x15, 0x13, 0xe3, 0x70, 0x47, 0x7f, 0x77,
+	0xdd, 0x58, 0xf8, 0xf7, 0xba, 0xb1, 0x29, 0xd9, 0x9f, 0x04, 0x1e, 0x61, 0xd8, 0x0b, 0xd9, 0x95,
+	0xa9, 0xec, 0x5b, 0x3f, 0x2f, 0xc2, 0xe6, 0x7c, 0x16, 0xe8, 0x1c, 0xb6, 0x92, 0x73, 0xb0, 0x2e,
+	0x43, 0xd7, 0x66, 0x38, 0xf6, 0xd4, 0x2c, 0x78, 0x7a, 0x15, 0x33, 0xbf, 0x15, 0xc4, 0xce, 0x32,
+	0xf7, 0x69, 0x6e, 0x4e, 0xf2, 0xdb, 0x14, 0xbd, 0x86, 0x5d, 0x87, 0x7b, 0xf1, 0xe9, 0x25, 0xb5,
+	0xc4, 0x1d, 0x49, 0xa4, 0x65, 0x7d, 0x3f, 0xca, 0x4a, 0xcb, 0x33, 0xee, 0xc6, 0x06, 0xdf, 0x70,
+	0x3e, 0x35, 0x77, 0x9c, 0xdc, 0x46, 0x2c, 0x9d, 0x96, 0x63, 0xe9, 0x03, 0xcb, 0xf1, 0x93, 0x06,
+	0xeb, 0x49, 0x42, 0xb4, 0xe7, 0x0f, 0x02, 0xd4, 0x85, 0x5a, 0x5a, 0x0c, 0x8a, 0x99, 0xae, 0x89,
+	0x68, 0xeb, 0xc5, 0x68, 0x13, 0xc3, 0x73, 0xcc, 0xcc, 0xb5, 0x49, 0x66, 0x85, 0x0c, 0xd8, 0x1e,
+	0xdb, 0x94, 0x59, 0x23, 0x4c, 0x86, 0x23, 0x66, 0x39, 0x23, 0xdb, 0x1f, 0x62, 0x57, 0x24, 0xbe,
+	0x64, 0x6e, 0x71, 0xe8, 0x54, 0x20, 0x5d, 0x09, 0xb4, 0x7e, 0xd5, 0x60, 0x7b, 0x2e, 0x79, 0x11,
+	0x8c, 0x09, 0x9b, 0x73, 0x45, 0xa4, 0xba, 0x76, 0xcf, 0xea, 0xa9, 0x93, 0xd9, 0xc8, 0xd7, 0x90,
+	0x3e, 0x38, 0xb6, 0x7f, 0x34, 0xd8, 0xca, 0x35, 0x9b, 0x88, 0xec, 0x35, 0xec, 0x8c, 0x45, 0x1f,
+	0x5a, 0xbc, 0xe0, 0x56, 0x14, 0x83, 0x2a, 0xbc, 0x67, 0xc5, 0x9b, 0x7f, 0x4b, 0xdb, 0x9a, 0xdb,
+	0x52, 0xe3, 0xa8, 0xef, 0x90, 0xb4, 0x97, 0x1f, 0xc1, 0xaa, 0x8c, 0x4d, 0xc5, 0xa4, 0x56, 0xe8,
+	0x0d, 0xec, 0xc6, 0x6e, 0xac, 0x01, 0xf1, 0xed, 0x31, 0xf9, 0x11, 0xe7, 0xda, 0xed, 0x79, 0xe1,
+	0x1e, 0xc4, 0xa2, 0x5f, 0x28, 0xba, 0x6c, 0xb8, 0x9d, 0xe8, 0xb6, 0xed, 0xd6, 0x08, 0x4a, 0xaf,
+	0xe4, 0xc8, 0x41, 0x47, 0x50, 0x49, 0xca, 0xa6, 0x32, 0xca, 0x0d, 0x13, 0x35, 0x9a, 0xd2, 0x92,
+	0xab, 0x62, 0xa7, 0x56, 0x68, 0x0f, 0xca, 0x34, 0x18, 0xb0, 0x1f, 0xec, 0x08, 0x8b, 0x3c, 0x2a,
+	0x66, 0xb2, 0x6e, 0xfd, 0xbd, 0x0a, 0x2b, 0xe7, 0xbc, 0x28, 0xe8, 0x25, 0x94, 0x94, 0x96, 0x72,
+	0xf3, 0xb8, 0x58, 0x38, 0x15, 0x94, 0x72, 0x11, 0xf3, 0xd1, 0x73, 0x28, 0x3b, 0x23, 0x9b, 0xf8,
+	0x16, 0x91, 0x87, 0x57, 0xe9, 0x54, 0x67, 0xd7, 0x8d, 0x52, 0x97, 0xef, 0xf5, 0x8e, 0xcd, 0x92,
+	0x00, 0x7b, 0x2e, 0x7a, 0x06, 0xeb, 0xc4, 0x27, 0x8c, 0xd8, 0x63, 0x75, 0xe4, 0xfa, 0xba, 0x28,
+	0x6b, 0x4d, 0xed, 0xca, 0xd3, 0x46, 0x1f, 0x83, 0x38, 0x7b, 0x59, 0xd0, 0x98, 0xb9, 0x24, 0x98,
+	0x1b, 0x1c, 0x10, 0x35, 0x52, 0x5c, 0x13, 0x6a, 0x19, 0x2e, 0x71, 0xf5, 0xe5, 0x62, 0xec, 0xf2,
+	0x4e, 0x0a, 0xab, 0xde, 0x71, 0x67, 0x9b, 0xc7, 0x3e, 0xbb, 0x6e, 0x54, 0xcf, 0x62, 0xa9, 0xde,
+	0xb1, 0x59, 0x4d, 0x74, 0x7b, 0x2e, 0x3a, 0x83, 0x8d, 0x8c, 0x26, 0x7f, 0xb1, 0xf4, 0x15, 0xa1,
+	0xba, 0x67, 0xc8, 0xe7, 0xcc, 0x88, 0x9f, 0x33, 0xe3, 0x22, 0x7e, 0xce, 0x3a, 0x65, 0x2e, 0xfb,
+	0xf6, 0xcf, 0x86, 0x66, 0xd6, 0x12, 0x2d, 0x8e, 0xa2, 0x2f, 0x61, 0xc3, 0xc7, 0x53, 0x66, 0x25,
+	0x5d, 0x49, 0xf5, 0xd5, 0x7b, 0xf5, 0xf1, 0x3a, 0x37, 0x4b, 0x76, 0xf8, 0xc3, 0x02, 0x19, 0x8d,
+	0xd2, 0xbd, 0x34, 0x32, 0x16, 0x3c, 0x10, 0x91, 0x56, 0x46, 0xa4, 0x7c, 0xbf, 0x40, 0xb8, 0x59,
+	0x26, 0x90, 0x2e, 0xd4, 0xb3, 0x6d, 0x9b, 0xea, 0x25, 0x1d, 0x5c, 0x11, 0x87, 0xb5, 0x9f, 0x76,
+	0x70, 0x6a, 0xad, 0x7a, 0xf9, 0xd6, 0x79, 0x02, 0x1f, 0x38, 0x4f, 0xbe, 0x86, 0xa7, 0xb9, 0x79,
+	0x32, 0xa7, 0x9f, 0x84, 0x57, 0x15, 0xe1, 0x35, 0x33, 0x03, 0x26, 0x2f, 0x14, 0xc7, 0x18, 0x5f,
+	0xc4, 0x48, 0xbc, 0xd2, 0xd4, 0x1a, 0xd9, 0x74, 0xa4, 0xaf, 0x35, 0xb5, 0x83, 0x35, 0x79, 0x11,
+	0xe5, 0xeb, 0x4d, 0x4f, 0x6d, 0x3a, 0x42, 0x8f, 0xa1, 0x6c, 0x87, 0xa1, 0xa4, 0xd4, 0x04, 0xa5,
+	0x64, 0x87, 0x21, 0x87, 0x5a, 0xbf, 0x68, 0x50, 0x3b, 0x8a, 0x9c, 0x11, 0x99, 0xe0, 0x53, 0x6c,
+	0xbb, 0x38, 0x42, 0x7a, 0xbe, 0xd7, 0x6a, 0x0f, 0x6f, 0xa5, 0x06, 0x54, 0x07, 0x51, 0xe0, 0xe5,
+	0xbb, 0x03, 0xf8, 0x96, 0x6a, 0x8c, 0x7d, 0xa8, 0xb0, 0x20, 0x86, 0x97, 0x05, 0x5c, 0x66, 0x81,
+	0x04, 0x5b, 0xbf, 0x2f, 0x66, 0x22, 0x12, 0xf4, 0x4f, 0x61, 0x45, 0xce, 0x2f, 0xd9, 0xfb, 0xbb,
+	0x77, 0xf4, 0x8f, 0x29, 0x59, 0xe8, 0x25, 0x54, 0x29, 0xc6, 0xbe, 0xe5, 0x04, 0x9e, 0x47, 0x98,
+	0x7a, 0x46, 0xf5, 0xdb, 0x0e, 0x8e, 0xe3, 0x26, 0x70, 0xb2, 0xfc, 0x46, 0x3d, 0xd8, 0xc0, 0x53,
+	0x41, 0x74, 0x63, 0x73, 0x39, 0x33, 0x9b, 0x45, 0xf3, 0x13, 0x45, 0x54, 0x32, 0xeb, 0x38, 0xb7,
+	0xe6, 0x63, 0x38, 0x3f, 0x7d, 0x93, 0xe1, 0xaf, 0x2f, 0x3f, 0x6c, 0x0c, 0x0f, 0x72, 0x4b, 0xc5,
+	0x41, 0x87, 0xb0, 0x22, 0xe6, 0x9e, 0xbe, 0x52, 0x2c, 0x8a, 0x00, 0x0c, 0x31, 0x3a, 0xd5, 0x75,
+	0x94, 0xdc, 0xce, 0x57, 0xef, 0x66, 0x75, 0xed, 0xfd, 0xac, 0xae, 0xfd, 0x35, 0xab, 0x6b, 0x6f,
+	0x6f, 0xea, 0x0b, 0xef, 0x6f, 0xea, 0x0b, 0xbf, 0xdd, 0xd4, 0x17, 0xbe, 0x3b, 0x1c, 0x12, 0x36,
+	0xba, 0xec, 0x1b, 0x4e, 0xe0, 0xb5, 0x9d, 0xc0, 0xc3, 0xac, 0x3f, 0x60, 0xe9, 0x87, 0xfc, 0x77,
+	0x9e, 0xff, 0xeb, 0xee, 0xaf, 0x8a, 0xfd, 0xc3, 0xff, 0x06, 0x00, 0x7f, 0xb1, 0xa4, 0x42, 0x90,
+	0x0b, 0x00, 0x00,
 }
 
 func (m *LegacyABCIResponses) Marshal() (dAtA []byte, err error) {
@@ -1125,6 +1289,132 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArchiveHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.FinalizeBlockResponse != nil {
+		{
+			size, err := m.FinalizeBlockResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExtendedCommit != nil {
+		{
+			size, err := m.ExtendedCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SeenCommit != nil {
+		{
+			size, err := m.SeenCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1317,6 +1607,55 @@ func (m *State) Size() (n int) {
 	return n
 }
 
+func (m *ArchiveHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovTypes(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *ArchiveHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SeenCommit != nil {
+		l = m.SeenCommit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExtendedCommit != nil {
+		l = m.ExtendedCommit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.FinalizeBlockResponse != nil {
+		l = m.FinalizeBlockResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.State.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2646,6 +2985,372 @@ func (m *State) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ArchiveHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &types1.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeenCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SeenCommit == nil {
+				m.SeenCommit = &types1.Commit{}
+			}
+			if err := m.SeenCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtendedCommit == nil {
+				m.ExtendedCommit = &types1.ExtendedCommit{}
+			}
+			if err := m.ExtendedCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlockResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizeBlockResponse == nil {
+				m.FinalizeBlockResponse = &types.ResponseFinalizeBlock{}
+			}
+			if err := m.FinalizeBlockResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -36,6 +36,7 @@ type ConsensusParams struct {
 	Version   *VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 	Abci      *ABCIParams      `protobuf:"bytes,5,opt,name=abci,proto3" json:"abci,omitempty"`
 	Authority *AuthorityParams `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
+	Timeout   *TimeoutParams   `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -113,6 +114,13 @@ func (m *ConsensusParams) GetAuthority() *AuthorityParams {
 	return nil
 }
 
+func (m *ConsensusParams) GetTimeout() *TimeoutParams {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -490,6 +498,92 @@ func (m *AuthorityParams) GetAuthority() string {
 	return ""
 }
 
+// TimeoutParams configure the timeouts of the consensus steps on chain, so that
+// all the validators use the same ones. A zero timeout isn't set on chain: the
+// one of the configuration of each node is used instead.
+type TimeoutParams struct {
+	// How long to wait for a proposal block before prevoting nil.
+	Propose time.Duration `protobuf:"bytes,1,opt,name=propose,proto3,stdduration" json:"propose"`
+	// How much the propose timeout increases with each round.
+	ProposeDelta time.Duration `protobuf:"bytes,2,opt,name=propose_delta,json=proposeDelta,proto3,stdduration" json:"propose_delta"`
+	// How long to wait after receiving +2/3 prevotes or precommits for anything
+	// (ie. not a single block or nil).
+	Vote time.Duration `protobuf:"bytes,3,opt,name=vote,proto3,stdduration" json:"vote"`
+	// How much the vote timeout increases with each round.
+	VoteDelta time.Duration `protobuf:"bytes,4,opt,name=vote_delta,json=voteDelta,proto3,stdduration" json:"vote_delta"`
+	// How long to wait after committing a block, before starting on the new
+	// height.
+	Commit time.Duration `protobuf:"bytes,5,opt,name=commit,proto3,stdduration" json:"commit"`
+}
+
+func (m *TimeoutParams) Reset()         { *m = TimeoutParams{} }
+func (m *TimeoutParams) String() string { return proto.CompactTextString(m) }
+func (*TimeoutParams) ProtoMessage()    {}
+func (*TimeoutParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{8}
+}
+func (m *TimeoutParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeoutParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeoutParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeoutParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeoutParams.Merge(m, src)
+}
+func (m *TimeoutParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeoutParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeoutParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeoutParams proto.InternalMessageInfo
+
+func (m *TimeoutParams) GetPropose() time.Duration {
+	if m != nil {
+		return m.Propose
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetProposeDelta() time.Duration {
+	if m != nil {
+		return m.ProposeDelta
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetVote() time.Duration {
+	if m != nil {
+		return m.Vote
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetVoteDelta() time.Duration {
+	if m != nil {
+		return m.VoteDelta
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetCommit() time.Duration {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ConsensusParams)(nil), "tendermint.types.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "tendermint.types.BlockParams")
@@ -499,51 +593,57 @@ func init() {
 	proto.RegisterType((*HashedParams)(nil), "tendermint.types.HashedParams")
 	proto.RegisterType((*ABCIParams)(nil), "tendermint.types.ABCIParams")
 	proto.RegisterType((*AuthorityParams)(nil), "tendermint.types.AuthorityParams")
+	proto.RegisterType((*TimeoutParams)(nil), "tendermint.types.TimeoutParams")
 }
 
 func init() { proto.RegisterFile("tendermint/types/params.proto", fileDescriptor_e12598271a686f57) }
 
 var fileDescriptor_e12598271a686f57 = []byte{
-	// 614 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc7, 0xe3, 0x3a, 0x6d, 0x93, 0x93, 0x2f, 0x4d, 0x34, 0xfa, 0x24, 0x4c, 0x69, 0x9c, 0xe0,
-	0x05, 0xaa, 0x54, 0xc9, 0x46, 0x64, 0x05, 0x42, 0xaa, 0x92, 0x12, 0xb5, 0x05, 0x95, 0x8b, 0x85,
-	0x58, 0x74, 0x63, 0x8d, 0x93, 0xa9, 0x63, 0x35, 0xf6, 0x58, 0x9e, 0x71, 0x94, 0xbc, 0x05, 0x4b,
-	0x96, 0x5d, 0xc2, 0x1b, 0xf0, 0x08, 0x5d, 0x76, 0xc9, 0x0a, 0x50, 0xb2, 0x61, 0xcf, 0x0b, 0x20,
-	0x8f, 0xed, 0x38, 0x17, 0xd8, 0x8d, 0xe7, 0xfc, 0x7e, 0x73, 0xf9, 0xcf, 0x91, 0xa1, 0xc1, 0x89,
-	0x3f, 0x20, 0xa1, 0xe7, 0xfa, 0xdc, 0xe0, 0xd3, 0x80, 0x30, 0x23, 0xc0, 0x21, 0xf6, 0x98, 0x1e,
-	0x84, 0x94, 0x53, 0x54, 0xcf, 0xcb, 0xba, 0x28, 0xef, 0xff, 0xef, 0x50, 0x87, 0x8a, 0xa2, 0x11,
-	0x8f, 0x12, 0x6e, 0x5f, 0x75, 0x28, 0x75, 0x46, 0xc4, 0x10, 0x5f, 0x76, 0x74, 0x65, 0x0c, 0xa2,
-	0x10, 0x73, 0x97, 0xfa, 0x49, 0x5d, 0xfb, 0xbd, 0x05, 0xb5, 0x13, 0xea, 0x33, 0xe2, 0xb3, 0x88,
-	0xbd, 0x15, 0x3b, 0xa0, 0x36, 0x6c, 0xdb, 0x23, 0xda, 0xbf, 0x56, 0xa4, 0x96, 0x74, 0x58, 0x79,
-	0xd2, 0xd0, 0xd7, 0xf7, 0xd2, 0xbb, 0x71, 0x39, 0xa1, 0xcd, 0x84, 0x45, 0xcf, 0xa1, 0x44, 0xc6,
-	0xee, 0x80, 0xf8, 0x7d, 0xa2, 0x6c, 0x09, 0xaf, 0xb5, 0xe9, 0xf5, 0x52, 0x22, 0x55, 0x17, 0x06,
-	0x3a, 0x86, 0xf2, 0x18, 0x8f, 0xdc, 0x01, 0xe6, 0x34, 0x54, 0x64, 0xa1, 0x3f, 0xdc, 0xd4, 0x3f,
-	0x64, 0x48, 0xea, 0xe7, 0x0e, 0x7a, 0x0a, 0xbb, 0x63, 0x12, 0x32, 0x97, 0xfa, 0x4a, 0x51, 0xe8,
-	0xcd, 0xbf, 0xe8, 0x09, 0x90, 0xca, 0x19, 0x8f, 0x1e, 0x43, 0x11, 0xdb, 0x7d, 0x57, 0xd9, 0x16,
-	0xde, 0xc1, 0xa6, 0xd7, 0xe9, 0x9e, 0x9c, 0xa7, 0x92, 0x20, 0xe3, 0xd3, 0xe2, 0x88, 0x0f, 0x69,
-	0xe8, 0xf2, 0xa9, 0xb2, 0xf3, 0xaf, 0xd3, 0x76, 0x32, 0x24, 0x3b, 0xed, 0xc2, 0xd1, 0xce, 0xa1,
-	0xb2, 0x14, 0x21, 0x7a, 0x00, 0x65, 0x0f, 0x4f, 0x2c, 0x7b, 0xca, 0x09, 0x13, 0xa1, 0xcb, 0x66,
-	0xc9, 0xc3, 0x93, 0x6e, 0xfc, 0x8d, 0xee, 0xc1, 0x6e, 0x5c, 0x74, 0x30, 0x13, 0xb9, 0xca, 0xe6,
-	0x8e, 0x87, 0x27, 0xa7, 0x98, 0xbd, 0x2c, 0x96, 0xe4, 0x7a, 0x51, 0xfb, 0x22, 0xc1, 0xde, 0x6a,
-	0xac, 0xe8, 0x08, 0x50, 0x6c, 0x60, 0x87, 0x58, 0x7e, 0xe4, 0x59, 0xe2, 0x7d, 0xb2, 0x75, 0x6b,
-	0x1e, 0x9e, 0x74, 0x1c, 0xf2, 0x3a, 0xf2, 0xc4, 0x01, 0x18, 0xba, 0x80, 0x7a, 0x06, 0x67, 0xad,
-	0x91, 0xbe, 0xdf, 0x7d, 0x3d, 0xe9, 0x1d, 0x3d, 0xeb, 0x1d, 0xfd, 0x45, 0x0a, 0x74, 0x4b, 0xb7,
-	0xdf, 0x9b, 0x85, 0x4f, 0x3f, 0x9a, 0x92, 0xb9, 0x97, 0xac, 0x97, 0x55, 0x56, 0xaf, 0x22, 0xaf,
-	0x5e, 0x45, 0x3b, 0x86, 0xda, 0xda, 0x13, 0x22, 0x0d, 0xaa, 0x41, 0x64, 0x5b, 0xd7, 0x64, 0x6a,
-	0x89, 0xd4, 0x14, 0xa9, 0x25, 0x1f, 0x96, 0xcd, 0x4a, 0x10, 0xd9, 0xaf, 0xc8, 0xf4, 0x7d, 0x3c,
-	0xf5, 0xac, 0xf4, 0xf5, 0xa6, 0x29, 0xfd, 0xba, 0x69, 0x4a, 0xda, 0x11, 0x54, 0x57, 0x1e, 0x11,
-	0xd5, 0x41, 0xc6, 0x41, 0x20, 0xee, 0x56, 0x34, 0xe3, 0xe1, 0x12, 0x7c, 0x09, 0xff, 0x9d, 0x61,
-	0x36, 0x24, 0x83, 0x94, 0x7d, 0x04, 0x35, 0x11, 0x85, 0xb5, 0x9e, 0x75, 0x55, 0x4c, 0x5f, 0x64,
-	0x81, 0x6b, 0x50, 0xcd, 0xb9, 0x3c, 0xf6, 0x4a, 0x46, 0x9d, 0x62, 0xa6, 0xbd, 0x01, 0xc8, 0xbb,
-	0x02, 0x75, 0xa0, 0x31, 0xa6, 0x9c, 0x58, 0x64, 0xc2, 0x89, 0x1f, 0x9f, 0x8e, 0x59, 0xc4, 0xc7,
-	0xf6, 0x88, 0x58, 0x43, 0xe2, 0x3a, 0x43, 0x9e, 0xee, 0xb3, 0x1f, 0x43, 0xbd, 0x05, 0xd3, 0x13,
-	0xc8, 0x99, 0x20, 0x34, 0x03, 0x6a, 0x6b, 0xfd, 0x82, 0x0e, 0x96, 0xbb, 0x2c, 0x5e, 0xa1, 0xbc,
-	0xd4, 0x42, 0xdd, 0x77, 0x9f, 0x67, 0xaa, 0x74, 0x3b, 0x53, 0xa5, 0xbb, 0x99, 0x2a, 0xfd, 0x9c,
-	0xa9, 0xd2, 0xc7, 0xb9, 0x5a, 0xb8, 0x9b, 0xab, 0x85, 0x6f, 0x73, 0xb5, 0x70, 0xd9, 0x76, 0x5c,
-	0x3e, 0x8c, 0x6c, 0xbd, 0x4f, 0x3d, 0xa3, 0x4f, 0x3d, 0xc2, 0xed, 0x2b, 0x9e, 0x0f, 0x92, 0xbf,
-	0xc4, 0xfa, 0x0f, 0xc6, 0xde, 0x11, 0xf3, 0xed, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xf7,
-	0xad, 0x2a, 0x7b, 0x04, 0x00, 0x00,
+	// 704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4b, 0x4f, 0xdb, 0x40,
+	0x10, 0x80, 0x63, 0x1c, 0xf2, 0x98, 0x10, 0x12, 0xad, 0x2a, 0xd5, 0xa5, 0xe0, 0x50, 0x1f, 0x2a,
+	0x24, 0x24, 0xa7, 0x2a, 0x87, 0xaa, 0x2f, 0xa1, 0x04, 0x10, 0xd0, 0x8a, 0x3e, 0x22, 0xd4, 0x03,
+	0x17, 0x6b, 0x9d, 0x2c, 0x8e, 0x45, 0xec, 0xb5, 0xbc, 0xeb, 0x28, 0xf9, 0x17, 0x3d, 0xf6, 0xc8,
+	0xb1, 0xbd, 0xf4, 0xdc, 0x9f, 0xc0, 0x11, 0xf5, 0xd4, 0x53, 0x5b, 0x85, 0x4b, 0x7f, 0x46, 0xb5,
+	0x6b, 0x9b, 0x3c, 0x28, 0x52, 0x7a, 0x5b, 0xef, 0x7c, 0xdf, 0xee, 0xec, 0xcc, 0xc8, 0xb0, 0xc6,
+	0x89, 0xdf, 0x21, 0xa1, 0xe7, 0xfa, 0xbc, 0xce, 0x87, 0x01, 0x61, 0xf5, 0x00, 0x87, 0xd8, 0x63,
+	0x66, 0x10, 0x52, 0x4e, 0x51, 0x75, 0x1c, 0x36, 0x65, 0x78, 0xe5, 0x8e, 0x43, 0x1d, 0x2a, 0x83,
+	0x75, 0xb1, 0x8a, 0xb9, 0x15, 0xdd, 0xa1, 0xd4, 0xe9, 0x91, 0xba, 0xfc, 0xb2, 0xa3, 0xd3, 0x7a,
+	0x27, 0x0a, 0x31, 0x77, 0xa9, 0x1f, 0xc7, 0x8d, 0xaf, 0x2a, 0x54, 0x76, 0xa8, 0xcf, 0x88, 0xcf,
+	0x22, 0xf6, 0x4e, 0xde, 0x80, 0xb6, 0x60, 0xd1, 0xee, 0xd1, 0xf6, 0x99, 0xa6, 0xac, 0x2b, 0x1b,
+	0xa5, 0xc7, 0x6b, 0xe6, 0xec, 0x5d, 0x66, 0x53, 0x84, 0x63, 0xba, 0x15, 0xb3, 0xe8, 0x05, 0x14,
+	0x48, 0xdf, 0xed, 0x10, 0xbf, 0x4d, 0xb4, 0x05, 0xe9, 0xad, 0xdf, 0xf4, 0xf6, 0x12, 0x22, 0x51,
+	0xaf, 0x0d, 0xb4, 0x0d, 0xc5, 0x3e, 0xee, 0xb9, 0x1d, 0xcc, 0x69, 0xa8, 0xa9, 0x52, 0x7f, 0x70,
+	0x53, 0xff, 0x90, 0x22, 0x89, 0x3f, 0x76, 0xd0, 0x53, 0xc8, 0xf7, 0x49, 0xc8, 0x5c, 0xea, 0x6b,
+	0x59, 0xa9, 0xd7, 0xfe, 0xa1, 0xc7, 0x40, 0x22, 0xa7, 0x3c, 0x7a, 0x04, 0x59, 0x6c, 0xb7, 0x5d,
+	0x6d, 0x51, 0x7a, 0xab, 0x37, 0xbd, 0x46, 0x73, 0xe7, 0x30, 0x91, 0x24, 0x29, 0xb2, 0xc5, 0x11,
+	0xef, 0xd2, 0xd0, 0xe5, 0x43, 0x2d, 0x77, 0x5b, 0xb6, 0x8d, 0x14, 0x49, 0xb3, 0xbd, 0x76, 0x44,
+	0xb6, 0xdc, 0xf5, 0x08, 0x8d, 0xb8, 0x96, 0xbf, 0x2d, 0xdb, 0xe3, 0x18, 0x48, 0xb3, 0x4d, 0x78,
+	0xe3, 0x10, 0x4a, 0x13, 0xd5, 0x47, 0xf7, 0xa1, 0xe8, 0xe1, 0x81, 0x65, 0x0f, 0x39, 0x61, 0xb2,
+	0x5f, 0x6a, 0xab, 0xe0, 0xe1, 0x41, 0x53, 0x7c, 0xa3, 0xbb, 0x90, 0x17, 0x41, 0x07, 0x33, 0xd9,
+	0x12, 0xb5, 0x95, 0xf3, 0xf0, 0x60, 0x1f, 0xb3, 0x57, 0xd9, 0x82, 0x5a, 0xcd, 0x1a, 0x5f, 0x14,
+	0x58, 0x9e, 0xee, 0x08, 0xda, 0x04, 0x24, 0x0c, 0xec, 0x10, 0xcb, 0x8f, 0x3c, 0x4b, 0xb6, 0x36,
+	0x3d, 0xb7, 0xe2, 0xe1, 0x41, 0xc3, 0x21, 0x6f, 0x22, 0x4f, 0x26, 0xc0, 0xd0, 0x11, 0x54, 0x53,
+	0x38, 0x9d, 0xaa, 0xa4, 0xf5, 0xf7, 0xcc, 0x78, 0xec, 0xcc, 0x74, 0xec, 0xcc, 0xdd, 0x04, 0x68,
+	0x16, 0x2e, 0x7e, 0xd6, 0x32, 0x9f, 0x7e, 0xd5, 0x94, 0xd6, 0x72, 0x7c, 0x5e, 0x1a, 0x99, 0x7e,
+	0x8a, 0x3a, 0xfd, 0x14, 0x63, 0x1b, 0x2a, 0x33, 0xdd, 0x47, 0x06, 0x94, 0x83, 0xc8, 0xb6, 0xce,
+	0xc8, 0xd0, 0x92, 0x15, 0xd3, 0x94, 0x75, 0x75, 0xa3, 0xd8, 0x2a, 0x05, 0x91, 0xfd, 0x9a, 0x0c,
+	0x8f, 0xc5, 0xd6, 0xb3, 0xc2, 0xb7, 0xf3, 0x9a, 0xf2, 0xe7, 0xbc, 0xa6, 0x18, 0x9b, 0x50, 0x9e,
+	0xea, 0x3f, 0xaa, 0x82, 0x8a, 0x83, 0x40, 0xbe, 0x2d, 0xdb, 0x12, 0xcb, 0x09, 0xf8, 0x04, 0x96,
+	0x0e, 0x30, 0xeb, 0x92, 0x4e, 0xc2, 0x3e, 0x84, 0x8a, 0x2c, 0x85, 0x35, 0x5b, 0xeb, 0xb2, 0xdc,
+	0x3e, 0x4a, 0x0b, 0x6e, 0x40, 0x79, 0xcc, 0x8d, 0xcb, 0x5e, 0x4a, 0xa9, 0x7d, 0xcc, 0x8c, 0xb7,
+	0x00, 0xe3, 0x81, 0x42, 0x0d, 0x58, 0xeb, 0x53, 0x4e, 0x2c, 0x32, 0xe0, 0xc4, 0x17, 0xd9, 0x31,
+	0x8b, 0xf8, 0xd8, 0xee, 0x11, 0xab, 0x4b, 0x5c, 0xa7, 0xcb, 0x93, 0x7b, 0x56, 0x04, 0xb4, 0x77,
+	0xcd, 0xec, 0x49, 0xe4, 0x40, 0x12, 0x46, 0x1d, 0x2a, 0x33, 0xa3, 0x86, 0x56, 0x27, 0x07, 0x54,
+	0x9c, 0x50, 0x9c, 0x98, 0x3e, 0xe3, 0xfb, 0x02, 0x94, 0xa7, 0xa6, 0x0b, 0xbd, 0x84, 0x7c, 0x10,
+	0xd2, 0x80, 0x32, 0xa2, 0x29, 0xf3, 0x37, 0x30, 0x75, 0xd0, 0x01, 0x94, 0x93, 0xa5, 0xd5, 0x21,
+	0x3d, 0x8e, 0xff, 0x67, 0x0a, 0x96, 0x12, 0x73, 0x57, 0x88, 0xe8, 0x09, 0x64, 0xc5, 0x4b, 0x35,
+	0x75, 0xfe, 0x03, 0xa4, 0x80, 0x9a, 0x00, 0xb2, 0x8e, 0xf1, 0xfd, 0xd9, 0xf9, 0xf5, 0xa2, 0xd0,
+	0xe2, 0xcb, 0x9f, 0x43, 0xae, 0x4d, 0x3d, 0xcf, 0xe5, 0xda, 0xe2, 0xfc, 0x7e, 0xa2, 0x34, 0xdf,
+	0x7f, 0x1e, 0xe9, 0xca, 0xc5, 0x48, 0x57, 0x2e, 0x47, 0xba, 0xf2, 0x7b, 0xa4, 0x2b, 0x1f, 0xaf,
+	0xf4, 0xcc, 0xe5, 0x95, 0x9e, 0xf9, 0x71, 0xa5, 0x67, 0x4e, 0xb6, 0x1c, 0x97, 0x77, 0x23, 0xdb,
+	0x6c, 0x53, 0xaf, 0xde, 0xa6, 0x1e, 0xe1, 0xf6, 0x29, 0x1f, 0x2f, 0xe2, 0xbf, 0xf6, 0xec, 0x0f,
+	0xdf, 0xce, 0xc9, 0xfd, 0xad, 0xbf, 0x03, 0x00, 0xf5, 0xa3, 0xc1, 0xf2, 0x0b, 0x06, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -583,6 +683,9 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Authority.Equal(that1.Authority) {
 		return false
 	}
+	if !this.Timeout.Equal(that1.Timeout) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -770,6 +873,42 @@ func (this *AuthorityParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TimeoutParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimeoutParams)
+	if !ok {
+		that2, ok := that.(TimeoutParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Propose != that1.Propose {
+		return false
+	}
+	if this.ProposeDelta != that1.ProposeDelta {
+		return false
+	}
+	if this.Vote != that1.Vote {
+		return false
+	}
+	if this.VoteDelta != that1.VoteDelta {
+		return false
+	}
+	if this.Commit != that1.Commit {
+		return false
+	}
+	return true
+}
 func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -790,6 +929,18 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Authority != nil {
 		{
 			size, err := m.Authority.MarshalToSizedBuffer(dAtA[:i])
@@ -923,12 +1074,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintParams(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -1090,6 +1241,69 @@ func (m *AuthorityParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TimeoutParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeoutParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeoutParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Commit, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Commit):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintParams(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VoteDelta, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VoteDelta):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintParams(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Vote, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Vote):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintParams(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposeDelta, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposeDelta):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintParams(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Propose, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Propose):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintParams(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -1223,6 +1437,10 @@ func (m *ConsensusParams) Size() (n int) {
 		l = m.Authority.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1325,6 +1543,25 @@ func (m *AuthorityParams) Size() (n int) {
 	return n
 }
 
+func (m *TimeoutParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Propose)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposeDelta)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Vote)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VoteDelta)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Commit)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1576,6 +1813,42 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &TimeoutParams{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2196,6 +2469,221 @@ func (m *AuthorityParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TimeoutParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeoutParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeoutParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Propose", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Propose, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposeDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ProposeDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Vote, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VoteDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Commit, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -98,6 +98,23 @@ func (r *remoteClientCreator) NewABCIClient() (abcicli.Client, error) {
 	return remoteApp, nil
 }
 
+// newABCIClientFor implements connClientCreator. With the "socket_mux"
+// transport, only the mempool connection uses the multiplexed socket protocol;
+// all other connections use the regular socket protocol.
+func (r *remoteClientCreator) newABCIClientFor(conn string) (abcicli.Client, error) {
+	transport := r.transport
+	if transport == "socket_mux" && conn != connMempool {
+		transport = "socket"
+	}
+
+	remoteApp, err := abcicli.NewClient(r.addr, transport, r.mustConnect)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to proxy: %w", err)
+	}
+
+	return remoteApp, nil
+}
+
 // DefaultClientCreator returns a default [ClientCreator], which will create a
 // local client if addr is one of "kvstore", "persistent_kvstore", "e2e",
 // "noop".
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"fmt"
+	"path/filepath"
 
 	abcicli "github.com/cometbft/cometbft/abci/client"
 	cmtlog "github.com/cometbft/cometbft/libs/log"
@@ -32,8 +33,8 @@ type AppConns interface {
 }
 
 // NewAppConns calls NewMultiAppConn.
-func NewAppConns(clientCreator ClientCreator, metrics *Metrics) AppConns {
-	return NewMultiAppConn(clientCreator, metrics)
+func NewAppConns(clientCreator ClientCreator, metrics *Metrics, options ...MultiAppConnOption) AppConns {
+	return NewMultiAppConn(clientCreator, metrics, options...)
 }
 
 // multiAppConn implements AppConns.
@@ -56,14 +57,37 @@ type multiAppConn struct {
 	snapshotConnClient  abcicli.Client
 
 	clientCreator ClientCreator
+
+	// directory to record ABCI traffic of the consensus and mempool
+	// connections to; recording is disabled if empty.
+	recordDir string
+}
+
+// connClientCreator is implemented by client creators that create a different
+// client depending on the connection to the application.
+type connClientCreator interface {
+	newABCIClientFor(conn string) (abcicli.Client, error)
+}
+
+// MultiAppConnOption sets an optional parameter on the multiAppConn.
+type MultiAppConnOption func(*multiAppConn)
+
+// WithRecording records all requests and responses of the consensus and
+// mempool connections to "<dir>/<connection>.rec" autofile groups, which can
+// be replayed against an application with `abci-cli replay`.
+func WithRecording(dir string) MultiAppConnOption {
+	return func(app *multiAppConn) { app.recordDir = dir }
 }
 
 // NewMultiAppConn makes all necessary abci connections to the application.
-func NewMultiAppConn(clientCreator ClientCreator, metrics *Metrics) AppConns {
+func NewMultiAppConn(clientCreator ClientCreator, metrics *Metrics, options ...MultiAppConnOption) AppConns {
 	multiAppConn := &multiAppConn{
 		metrics:       metrics,
 		clientCreator: clientCreator,
 	}
+	for _, option := range options {
+		option(multiAppConn)
+	}
 	multiAppConn.BaseService = *service.NewBaseService(nil, "multiAppConn", multiAppConn)
 	return multiAppConn
 }
@@ -181,10 +205,27 @@ func (app *multiAppConn) stopAllClients() {
 }
 
 func (app *multiAppConn) abciClientFor(conn string) (abcicli.Client, error) {
-	c, err := app.clientCreator.NewABCIClient()
+	var (
+		c   abcicli.Client
+		err error
+	)
+	if cc, ok := app.clientCreator.(connClientCreator); ok {
+		c, err = cc.newABCIClientFor(conn)
+	} else {
+		c, err = app.clientCreator.NewABCIClient()
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error creating ABCI client (%s connection): %w", conn, err)
 	}
+	if app.recordDir != "" && (conn == connConsensus || conn == connMempool) {
+		if err := cmtos.EnsureDir(app.recordDir, 0o700); err != nil {
+			return nil, fmt.Errorf("error creating ABCI recording dir: %w", err)
+		}
+		c, err = abcicli.OpenRecordingClient(c, filepath.Join(app.recordDir, conn+".rec"))
+		if err != nil {
+			return nil, err
+		}
+	}
 	c.SetLogger(app.Logger.With("module", "abci-client", "connection", conn))
 	if err := c.Start(); err != nil {
 		return nil, fmt.Errorf("error starting ABCI client (%s connection): %w", conn, err)
@@ -126,6 +126,11 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		correct, fakes := makeEvidences(t, pv, chainID)
 		t.Logf("client %d", i)
 
+		// blocks are produced fast, the evidence may be committed before
+		// BroadcastEvidence returns
+		broadcast, err := c.Status(context.Background())
+		require.NoError(t, err)
+
 		result, err := c.BroadcastEvidence(context.Background(), correct)
 		require.NoError(t, err, "BroadcastEvidence(%s) failed", correct)
 		assert.Equal(t, correct.Hash(), result.Hash, "expected result hash to match evidence hash")
@@ -152,6 +157,20 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		require.EqualValues(t, rawpub, pk, "Stored PubKey not equal with expected, value %v", string(qres.Value))
 		require.Equal(t, int64(9), v.Power, "Stored Power not equal with expected, value %v", string(qres.Value))
 
+		// the evidence is committed in one of the blocks since it was broadcast
+		committed := false
+		for h := broadcast.SyncInfo.LatestBlockHeight; h <= status.SyncInfo.LatestBlockHeight+2; h++ {
+			res, err := c.Evidence(context.Background(), &h)
+			require.NoError(t, err)
+			for _, ev := range res.Evidence {
+				committed = committed || bytes.Equal(ev.Hash(), correct.Hash())
+			}
+		}
+		require.True(t, committed, "evidence %X not committed", correct.Hash())
+		pending, err := c.PendingEvidence(context.Background())
+		require.NoError(t, err)
+		require.Zero(t, pending.Count)
+
 		for _, fake := range fakes {
 			_, err := c.BroadcastEvidence(context.Background(), fake)
 			require.Error(t, err, "BroadcastEvidence(%s) succeeded, but the evidence was fake", fake)
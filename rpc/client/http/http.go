@@ -348,6 +348,15 @@ func (c *baseRPCClient) ConsensusState(ctx context.Context) (*ctypes.ResultConse
 	return result, nil
 }
 
+func (c *baseRPCClient) ConsensusTimings(ctx context.Context) (*ctypes.ResultConsensusTimings, error) {
+	result := new(ctypes.ResultConsensusTimings)
+	_, err := c.caller.Call(ctx, "consensus_timings", map[string]any{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) ConsensusParams(
 	ctx context.Context,
 	height *int64,
@@ -364,6 +373,53 @@ func (c *baseRPCClient) ConsensusParams(
 	return result, nil
 }
 
+func (c *baseRPCClient) ConsensusParamsProof(
+	ctx context.Context,
+	height *int64,
+) (*ctypes.ResultConsensusParamsProof, error) {
+	result := new(ctypes.ResultConsensusParamsProof)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "consensus_params_proof", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) ValidatorSet(
+	ctx context.Context,
+	height *int64,
+) (*ctypes.ResultValidatorSet, error) {
+	result := new(ctypes.ResultValidatorSet)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "validator_set", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) ValidatorSetChanges(
+	ctx context.Context,
+	minHeight,
+	maxHeight int64,
+) (*ctypes.ResultValidatorSetChanges, error) {
+	result := new(ctypes.ResultValidatorSetChanges)
+	_, err := c.caller.Call(ctx, "validator_set_changes",
+		map[string]any{"minHeight": minHeight, "maxHeight": maxHeight},
+		result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
 	result := new(ctypes.ResultHealth)
 	_, err := c.caller.Call(ctx, "health", map[string]any{}, result)
@@ -472,6 +528,36 @@ func (c *baseRPCClient) HeaderByHash(ctx context.Context, hash bytes.HexBytes) (
 	return result, nil
 }
 
+func (c *baseRPCClient) TxResultProof(
+	ctx context.Context,
+	height int64,
+	index int,
+) (*ctypes.ResultTxResultProof, error) {
+	result := new(ctypes.ResultTxResultProof)
+	_, err := c.caller.Call(ctx, "tx_result_proof",
+		map[string]any{"height": height, "index": index},
+		result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) EventProof(
+	ctx context.Context,
+	height int64,
+	txIndex int,
+	index int,
+) (*ctypes.ResultEventProof, error) {
+	result := new(ctypes.ResultEventProof)
+	params := map[string]any{"height": height, "tx_index": txIndex, "index": index}
+	_, err := c.caller.Call(ctx, "event_proof", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error) {
 	result := new(ctypes.ResultCommit)
 	params := make(map[string]any)
@@ -485,6 +571,19 @@ func (c *baseRPCClient) Commit(ctx context.Context, height *int64) (*ctypes.Resu
 	return result, nil
 }
 
+func (c *baseRPCClient) ExtendedCommit(ctx context.Context, height *int64) (*ctypes.ResultExtendedCommit, error) {
+	result := new(ctypes.ResultExtendedCommit)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "extended_commit", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	result := new(ctypes.ResultTx)
 	params := map[string]any{
@@ -579,6 +678,19 @@ func (c *baseRPCClient) Validators(
 	return result, nil
 }
 
+func (c *baseRPCClient) ValidatorUptime(ctx context.Context, address []byte) (*ctypes.ResultValidatorUptime, error) {
+	result := new(ctypes.ResultValidatorUptime)
+	params := make(map[string]any)
+	if len(address) > 0 {
+		params["address"] = address
+	}
+	_, err := c.caller.Call(ctx, "validator_uptime", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) BroadcastEvidence(
 	ctx context.Context,
 	ev types.Evidence,
@@ -591,6 +703,37 @@ func (c *baseRPCClient) BroadcastEvidence(
 	return result, nil
 }
 
+func (c *baseRPCClient) PendingEvidence(ctx context.Context) (*ctypes.ResultPendingEvidence, error) {
+	result := new(ctypes.ResultPendingEvidence)
+	_, err := c.caller.Call(ctx, "pending_evidence", map[string]any{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) Evidence(ctx context.Context, height *int64) (*ctypes.ResultEvidence, error) {
+	result := new(ctypes.ResultEvidence)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "evidence", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) RejectedEvidence(ctx context.Context, hash []byte) (*ctypes.ResultRejectedEvidence, error) {
+	result := new(ctypes.ResultRejectedEvidence)
+	_, err := c.caller.Call(ctx, "rejected_evidence", map[string]any{"hash": hash}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 //-----------------------------------------------------------------------------
 // WSEvents
 
@@ -67,10 +67,14 @@ type SignClient interface {
 	Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error)
 	BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBlock, error)
 	BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
+	TxResultProof(ctx context.Context, height int64, index int) (*ctypes.ResultTxResultProof, error)
+	EventProof(ctx context.Context, height int64, txIndex int, index int) (*ctypes.ResultEventProof, error)
 	Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error)
 	HeaderByHash(ctx context.Context, hash bytes.HexBytes) (*ctypes.ResultHeader, error)
 	Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error)
+	ExtendedCommit(ctx context.Context, height *int64) (*ctypes.ResultExtendedCommit, error)
 	Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error)
+	ValidatorUptime(ctx context.Context, address []byte) (*ctypes.ResultValidatorUptime, error)
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
 	// TxSearch defines a method to search for a paginated set of transactions by
@@ -111,6 +115,7 @@ type NetworkClient interface {
 	NetInfo(context.Context) (*ctypes.ResultNetInfo, error)
 	DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(context.Context) (*ctypes.ResultConsensusState, error)
+	ConsensusTimings(context.Context) (*ctypes.ResultConsensusTimings, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 	Health(context.Context) (*ctypes.ResultHealth, error)
 }
@@ -140,9 +145,12 @@ type MempoolClient interface {
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
-// behavior.
+// behavior and inspecting the evidence known to the node.
 type EvidenceClient interface {
 	BroadcastEvidence(context.Context, types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
+	PendingEvidence(context.Context) (*ctypes.ResultPendingEvidence, error)
+	Evidence(ctx context.Context, height *int64) (*ctypes.ResultEvidence, error)
+	RejectedEvidence(ctx context.Context, hash []byte) (*ctypes.ResultRejectedEvidence, error)
 }
 
 // RemoteClient is a Client, which can also return the remote network address.
@@ -122,10 +122,29 @@ func (c *Local) ConsensusState(context.Context) (*ctypes.ResultConsensusState, e
 	return c.env.GetConsensusState(c.ctx)
 }
 
+func (c *Local) ConsensusTimings(context.Context) (*ctypes.ResultConsensusTimings, error) {
+	return c.env.ConsensusTimings(c.ctx)
+}
+
 func (c *Local) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(c.ctx, height)
 }
 
+func (c *Local) ConsensusParamsProof(_ context.Context, height *int64) (*ctypes.ResultConsensusParamsProof, error) {
+	return c.env.ConsensusParamsProof(c.ctx, height)
+}
+
+func (c *Local) ValidatorSet(_ context.Context, height *int64) (*ctypes.ResultValidatorSet, error) {
+	return c.env.ValidatorSet(c.ctx, height)
+}
+
+func (c *Local) ValidatorSetChanges(
+	_ context.Context,
+	minHeight, maxHeight int64,
+) (*ctypes.ResultValidatorSetChanges, error) {
+	return c.env.ValidatorSetChanges(c.ctx, minHeight, maxHeight)
+}
+
 func (c *Local) Health(context.Context) (*ctypes.ResultHealth, error) {
 	return c.env.Health(c.ctx)
 }
@@ -176,14 +195,35 @@ func (c *Local) HeaderByHash(_ context.Context, hash bytes.HexBytes) (*ctypes.Re
 	return c.env.HeaderByHash(c.ctx, hash)
 }
 
+func (c *Local) TxResultProof(_ context.Context, height int64, index int) (*ctypes.ResultTxResultProof, error) {
+	return c.env.TxResultProof(c.ctx, height, index)
+}
+
+func (c *Local) EventProof(
+	_ context.Context,
+	height int64,
+	txIndex int,
+	index int,
+) (*ctypes.ResultEventProof, error) {
+	return c.env.EventProof(c.ctx, height, txIndex, index)
+}
+
 func (c *Local) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit, error) {
 	return c.env.Commit(c.ctx, height)
 }
 
+func (c *Local) ExtendedCommit(_ context.Context, height *int64) (*ctypes.ResultExtendedCommit, error) {
+	return c.env.ExtendedCommit(c.ctx, height)
+}
+
 func (c *Local) Validators(_ context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
 	return c.env.Validators(c.ctx, height, page, perPage)
 }
 
+func (c *Local) ValidatorUptime(_ context.Context, address []byte) (*ctypes.ResultValidatorUptime, error) {
+	return c.env.ValidatorUptime(c.ctx, address)
+}
+
 func (c *Local) Tx(_ context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return c.env.Tx(c.ctx, hash, prove)
 }
@@ -212,6 +252,18 @@ func (c *Local) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes
 	return c.env.BroadcastEvidence(c.ctx, ev)
 }
 
+func (c *Local) PendingEvidence(context.Context) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(c.ctx)
+}
+
+func (c *Local) Evidence(_ context.Context, height *int64) (*ctypes.ResultEvidence, error) {
+	return c.env.Evidence(c.ctx, height)
+}
+
+func (c *Local) RejectedEvidence(_ context.Context, hash []byte) (*ctypes.ResultRejectedEvidence, error) {
+	return c.env.RejectedEvidence(c.ctx, hash)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
@@ -128,6 +128,10 @@ func (c Client) ConsensusState(_ context.Context) (*ctypes.ResultConsensusState,
 	return c.env.GetConsensusState(&rpctypes.Context{})
 }
 
+func (c Client) ConsensusTimings(_ context.Context) (*ctypes.ResultConsensusTimings, error) {
+	return c.env.ConsensusTimings(&rpctypes.Context{})
+}
+
 func (c Client) DumpConsensusState(_ context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.env.DumpConsensusState(&rpctypes.Context{})
 }
@@ -174,10 +178,30 @@ func (c Client) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit,
 	return c.env.Commit(&rpctypes.Context{}, height)
 }
 
+func (c Client) ExtendedCommit(_ context.Context, height *int64) (*ctypes.ResultExtendedCommit, error) {
+	return c.env.ExtendedCommit(&rpctypes.Context{}, height)
+}
+
 func (c Client) Validators(_ context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
 	return c.env.Validators(&rpctypes.Context{}, height, page, perPage)
 }
 
+func (c Client) ValidatorUptime(_ context.Context, address []byte) (*ctypes.ResultValidatorUptime, error) {
+	return c.env.ValidatorUptime(&rpctypes.Context{}, address)
+}
+
 func (c Client) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.env.BroadcastEvidence(&rpctypes.Context{}, ev)
 }
+
+func (c Client) PendingEvidence(context.Context) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(&rpctypes.Context{})
+}
+
+func (c Client) Evidence(_ context.Context, height *int64) (*ctypes.ResultEvidence, error) {
+	return c.env.Evidence(&rpctypes.Context{}, height)
+}
+
+func (c Client) RejectedEvidence(_ context.Context, hash []byte) (*ctypes.ResultRejectedEvidence, error) {
+	return c.env.RejectedEvidence(&rpctypes.Context{}, hash)
+}
@@ -390,6 +390,29 @@ func (_m *Client) ConsensusState(_a0 context.Context) (*coretypes.ResultConsensu
 	return r0, r1
 }
 
+// ConsensusTimings provides a mock function with given fields: _a0
+func (_m *Client) ConsensusTimings(_a0 context.Context) (*coretypes.ResultConsensusTimings, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultConsensusTimings
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultConsensusTimings); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultConsensusTimings)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DumpConsensusState provides a mock function with given fields: _a0
 func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDumpConsensusState, error) {
 	ret := _m.Called(_a0)
@@ -413,6 +436,75 @@ func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDump
 	return r0, r1
 }
 
+// EventProof provides a mock function with given fields: ctx, height, txIndex, index
+func (_m *Client) EventProof(ctx context.Context, height int64, txIndex int, index int) (*coretypes.ResultEventProof, error) {
+	ret := _m.Called(ctx, height, txIndex, index)
+
+	var r0 *coretypes.ResultEventProof
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) *coretypes.ResultEventProof); ok {
+		r0 = rf(ctx, height, txIndex, index)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultEventProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int) error); ok {
+		r1 = rf(ctx, height, txIndex, index)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Evidence provides a mock function with given fields: ctx, height
+func (_m *Client) Evidence(ctx context.Context, height *int64) (*coretypes.ResultEvidence, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultEvidence); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExtendedCommit provides a mock function with given fields: ctx, height
+func (_m *Client) ExtendedCommit(ctx context.Context, height *int64) (*coretypes.ResultExtendedCommit, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultExtendedCommit
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultExtendedCommit); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultExtendedCommit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Genesis provides a mock function with given fields: _a0
 func (_m *Client) Genesis(_a0 context.Context) (*coretypes.ResultGenesis, error) {
 	ret := _m.Called(_a0)
@@ -588,6 +680,29 @@ func (_m *Client) NumUnconfirmedTxs(_a0 context.Context) (*coretypes.ResultUncon
 	return r0, r1
 }
 
+// PendingEvidence provides a mock function with given fields: _a0
+func (_m *Client) PendingEvidence(_a0 context.Context) (*coretypes.ResultPendingEvidence, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultPendingEvidence
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultPendingEvidence); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultPendingEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // OnReset provides a mock function with given fields:
 func (_m *Client) OnReset() error {
 	ret := _m.Called()
@@ -637,6 +752,29 @@ func (_m *Client) Quit() <-chan struct{} {
 	return r0
 }
 
+// RejectedEvidence provides a mock function with given fields: ctx, hash
+func (_m *Client) RejectedEvidence(ctx context.Context, hash []byte) (*coretypes.ResultRejectedEvidence, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *coretypes.ResultRejectedEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *coretypes.ResultRejectedEvidence); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultRejectedEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Reset provides a mock function with given fields:
 func (_m *Client) Reset() error {
 	ret := _m.Called()
@@ -774,6 +912,29 @@ func (_m *Client) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.R
 	return r0, r1
 }
 
+// TxResultProof provides a mock function with given fields: ctx, height, index
+func (_m *Client) TxResultProof(ctx context.Context, height int64, index int) (*coretypes.ResultTxResultProof, error) {
+	ret := _m.Called(ctx, height, index)
+
+	var r0 *coretypes.ResultTxResultProof
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) *coretypes.ResultTxResultProof); ok {
+		r0 = rf(ctx, height, index)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultTxResultProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, height, index)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TxSearch provides a mock function with given fields: ctx, query, prove, page, perPage, orderBy
 func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *int, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
 	ret := _m.Called(ctx, query, prove, page, perPage, orderBy)
@@ -848,6 +1009,29 @@ func (_m *Client) UnsubscribeAll(ctx context.Context, subscriber string) error {
 	return r0
 }
 
+// ValidatorUptime provides a mock function with given fields: ctx, address
+func (_m *Client) ValidatorUptime(ctx context.Context, address []byte) (*coretypes.ResultValidatorUptime, error) {
+	ret := _m.Called(ctx, address)
+
+	var r0 *coretypes.ResultValidatorUptime
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *coretypes.ResultValidatorUptime); ok {
+		r0 = rf(ctx, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultValidatorUptime)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Validators provides a mock function with given fields: ctx, height, page, perPage
 func (_m *Client) Validators(ctx context.Context, height *int64, page *int, perPage *int) (*coretypes.ResultValidators, error) {
 	ret := _m.Called(ctx, height, page, perPage)
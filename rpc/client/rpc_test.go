@@ -186,6 +186,31 @@ func TestGenesisAndValidators(t *testing.T) {
 	}
 }
 
+func TestValidatorUptime(t *testing.T) {
+	for i, c := range GetClients() {
+		// the commit of a block is known once the next block is committed
+		require.NoError(t, client.WaitForHeight(c, 3, nil))
+
+		h := int64(1)
+		vals, err := c.Validators(context.Background(), &h, nil, nil)
+		require.NoError(t, err)
+		address := vals.Validators[0].Address
+
+		res, err := c.ValidatorUptime(context.Background(), address)
+		require.NoError(t, err, "%d: %+v", i, err)
+		require.Len(t, res.Validators, 1)
+		vu := res.Validators[0]
+		assert.Equal(t, address, vu.Address)
+		// a single validator signs all the blocks
+		assert.Equal(t, res.ToHeight-res.FromHeight+1, vu.Signed)
+		assert.Zero(t, vu.Absent+vu.Nil+vu.ConsecutiveMissed)
+		assert.Equal(t, res.ToHeight, vu.LastSignedHeight)
+
+		_, err = c.ValidatorUptime(context.Background(), []byte{1, 2, 3})
+		assert.Error(t, err)
+	}
+}
+
 func TestGenesisChunked(t *testing.T) {
 	ctx := t.Context()
 
@@ -174,6 +174,27 @@ func (env *Environment) Commit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.R
 	return ctypes.NewResultCommit(&header, commit, true), nil
 }
 
+// ExtendedCommit gets the extended commit of the block at a given height, with
+// the vote extensions of the precommits and their signatures. If no height is
+// provided, it will fetch the extended commit for the latest block.
+func (env *Environment) ExtendedCommit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultExtendedCommit, error) {
+	height, err := env.getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	blockMeta := env.BlockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, fmt.Errorf("block meta not found for height %d", height)
+	}
+
+	extCommit := env.BlockStore.LoadBlockExtendedCommit(height)
+	if extCommit == nil {
+		return nil, fmt.Errorf("extended commit not found for height %d; are vote extensions enabled?", height)
+	}
+	return &ctypes.ResultExtendedCommit{Header: blockMeta.Header, ExtendedCommit: extCommit}, nil
+}
+
 // BlockResults gets ABCIResults at a given height.
 // If no height is provided, it will fetch results for the latest block.
 //
@@ -203,6 +224,80 @@ func (env *Environment) BlockResults(_ *rpctypes.Context, heightPtr *int64) (*ct
 	}, nil
 }
 
+// TxResultProof gets the result of the tx at the given index of the block at
+// the given height, along with a Merkle proof of it against the
+// LastResultsHash of the next header. Only the deterministic fields of the
+// result (code, data, gas wanted and gas used) are covered by the proof.
+func (env *Environment) TxResultProof(
+	_ *rpctypes.Context,
+	height int64,
+	index int,
+) (*ctypes.ResultTxResultProof, error) {
+	height, err := env.getHeight(env.BlockStore.Height(), &height)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := env.StateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= len(results.TxResults) {
+		return nil, fmt.Errorf("tx index %d out of range, block %d has %d txs",
+			index, height, len(results.TxResults))
+	}
+
+	return &ctypes.ResultTxResultProof{
+		Height:   height,
+		Index:    index,
+		TxResult: results.TxResults[index],
+		Proof:    types.NewResults(results.TxResults).ProveResult(index),
+	}, nil
+}
+
+// EventProof gets an event of the tx at the given index of the block at the
+// given height, along with a Merkle proof of it against the hash of the events
+// of the tx (see types.ABCIEvents).
+//
+// CometBFT does not commit to events: the proof only binds the event to the
+// chain if the application sets the data of the tx result to this hash, in
+// which case the hash is proven with TxResultProof. FinalizeBlock events are
+// not part of any tx result, so there are no proofs for them.
+func (env *Environment) EventProof(
+	_ *rpctypes.Context,
+	height int64,
+	txIndex int,
+	index int,
+) (*ctypes.ResultEventProof, error) {
+	height, err := env.getHeight(env.BlockStore.Height(), &height)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := env.StateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return nil, err
+	}
+	if txIndex < 0 || txIndex >= len(results.TxResults) {
+		return nil, fmt.Errorf("tx index %d out of range, block %d has %d txs",
+			txIndex, height, len(results.TxResults))
+	}
+	events := results.TxResults[txIndex].Events
+	if index < 0 || index >= len(events) {
+		return nil, fmt.Errorf("event index %d out of range, there are %d events", index, len(events))
+	}
+
+	commitment := types.NewEvents(events)
+	return &ctypes.ResultEventProof{
+		Height:     height,
+		TxIndex:    txIndex,
+		Index:      index,
+		Event:      events[index],
+		EventsHash: commitment.Hash(),
+		Proof:      commitment.ProveEvent(index),
+	}, nil
+}
+
 // BlockSearch searches for a paginated set of blocks matching
 // FinalizeBlock event search criteria.
 func (env *Environment) BlockSearch(
@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -10,10 +11,13 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestBlockchainInfo(t *testing.T) {
@@ -116,3 +120,98 @@ func TestBlockResults(t *testing.T) {
 		}
 	}
 }
+
+func TestTxResultAndEventProofs(t *testing.T) {
+	txEvents := []abci.Event{
+		{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "sender", Value: "alice", Index: true}}},
+		{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "recipient", Value: "bob"}}},
+	}
+	results := &abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			{Code: 0, Data: []byte{0x01}, Log: "ok"},
+			{Code: 0, Data: types.NewEvents(txEvents).Hash(), Log: "ok", Events: txEvents},
+		},
+		Events:  []abci.Event{{Type: "begin"}},
+		AppHash: make([]byte, 1),
+	}
+
+	env := &Environment{}
+	env.StateStore = sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	err := env.StateStore.SaveFinalizeBlockResponse(100, results)
+	require.NoError(t, err)
+	mockstore := &mocks.BlockStore{}
+	mockstore.On("Height").Return(int64(100))
+	mockstore.On("Base").Return(int64(1))
+	env.BlockStore = mockstore
+
+	lastResultsHash := types.NewResults(results.TxResults).Hash()
+	for i, txResult := range results.TxResults {
+		res, err := env.TxResultProof(&rpctypes.Context{}, 100, i)
+		require.NoError(t, err)
+		require.Equal(t, txResult, res.TxResult)
+
+		bz, err := abci.DeterministicExecTxResult(res.TxResult).Marshal()
+		require.NoError(t, err)
+		require.NoError(t, res.Proof.Verify(lastResultsHash, bz))
+	}
+	_, err = env.TxResultProof(&rpctypes.Context{}, 100, 2)
+	require.Error(t, err)
+	_, err = env.TxResultProof(&rpctypes.Context{}, 101, 0)
+	require.Error(t, err)
+
+	res, err := env.EventProof(&rpctypes.Context{}, 100, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, txEvents[1], res.Event)
+	require.EqualValues(t, results.TxResults[1].Data, res.EventsHash)
+	bz, err := abci.DeterministicEvent(res.Event).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, res.Proof.Verify(res.EventsHash, bz))
+
+	_, err = env.EventProof(&rpctypes.Context{}, 100, 1, 2)
+	require.Error(t, err)
+	_, err = env.EventProof(&rpctypes.Context{}, 100, 0, 0)
+	require.Error(t, err)
+	_, err = env.EventProof(&rpctypes.Context{}, 100, 2, 0)
+	require.Error(t, err)
+}
+
+func TestExtendedCommit(t *testing.T) {
+	const (
+		chainID = "extended-commit"
+		height  = int64(10)
+	)
+	vals, privVals := types.RandValidatorSet(4, 10)
+	header := types.Header{ChainID: chainID, Height: height, ValidatorsHash: vals.Hash()}
+	blockID := types.BlockID{Hash: header.Hash(), PartSetHeader: types.PartSetHeader{Total: 1, Hash: header.Hash()}}
+	voteSet := types.NewExtendedVoteSet(chainID, height, 0, cmtproto.PrecommitType, vals)
+	extCommit, err := types.MakeExtCommit(blockID, height, 0, voteSet, privVals, time.Now(), true)
+	require.NoError(t, err)
+
+	mockstore := &mocks.BlockStore{}
+	mockstore.On("Height").Return(height + 1)
+	mockstore.On("Base").Return(int64(1))
+	mockstore.On("LoadBlockMeta", height).Return(&types.BlockMeta{BlockID: blockID, Header: header})
+	mockstore.On("LoadBlockExtendedCommit", height).Return(extCommit)
+	mockstore.On("LoadBlockMeta", height+1).Return(&types.BlockMeta{Header: types.Header{Height: height + 1}})
+	mockstore.On("LoadBlockExtendedCommit", height+1).Return(nil)
+	env := &Environment{BlockStore: mockstore}
+
+	h := height
+	res, err := env.ExtendedCommit(&rpctypes.Context{}, &h)
+	require.NoError(t, err)
+	assert.Equal(t, header, res.Header)
+
+	// the vote extensions can be verified by the clients
+	bz, err := cmtjson.Marshal(res)
+	require.NoError(t, err)
+	var decoded ctypes.ResultExtendedCommit
+	require.NoError(t, cmtjson.Unmarshal(bz, &decoded))
+	require.NoError(t, decoded.ExtendedCommit.VerifyExtensions(chainID, vals))
+	require.NoError(t, vals.VerifyCommit(chainID, blockID, height, decoded.ExtendedCommit.ToCommit()))
+
+	// vote extensions aren't enabled at the latest height
+	_, err = env.ExtendedCommit(&rpctypes.Context{}, nil)
+	require.Error(t, err)
+}
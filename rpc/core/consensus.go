@@ -1,6 +1,8 @@
 package core
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 
 	cm "github.com/cometbft/cometbft/consensus"
@@ -8,6 +10,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/uptime"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -24,7 +27,7 @@ func (env *Environment) Validators(
 	pagePtr, perPagePtr *int,
 ) (*ctypes.ResultValidators, error) {
 	// The latest validator that we know is the NextValidator of the last block.
-	height, err := env.getHeight(env.latestUncommittedHeight(), heightPtr)
+	height, err := env.getStateHeight(env.latestUncommittedHeight(), heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -53,6 +56,39 @@ func (env *Environment) Validators(
 	}, nil
 }
 
+// ValidatorUptime gets the number of blocks each validator signed, was absent
+// from and precommitted nil for over the window of the latest heights, or only
+// those of the validator with the given address if any.
+func (env *Environment) ValidatorUptime(
+	_ *rpctypes.Context,
+	address []byte,
+) (*ctypes.ResultValidatorUptime, error) {
+	if env.UptimeTracker == nil {
+		return nil, errors.New("validator uptime tracking is disabled")
+	}
+
+	from, to, uptimes := env.UptimeTracker.Uptimes()
+	if len(address) > 0 {
+		var found []uptime.ValidatorUptime
+		for _, vu := range uptimes {
+			if bytes.Equal(vu.Address, address) {
+				found = append(found, vu)
+			}
+		}
+		if len(found) == 0 {
+			return nil, fmt.Errorf("validator %X wasn't in the validator set between heights %d and %d",
+				address, from, to)
+		}
+		uptimes = found
+	}
+
+	return &ctypes.ResultValidatorUptime{
+		FromHeight: from,
+		ToHeight:   to,
+		Validators: uptimes,
+	}, nil
+}
+
 // DumpConsensusState dumps consensus state.
 // UNSTABLE
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Info/dump_consensus_state
@@ -101,6 +137,13 @@ func (env *Environment) GetConsensusState(*rpctypes.Context) (*ctypes.ResultCons
 	return &ctypes.ResultConsensusState{RoundState: bz}, err
 }
 
+// ConsensusTimings returns the timings of the consensus steps, of the
+// proposals and of the votes of the validators for the latest heights.
+// UNSTABLE
+func (env *Environment) ConsensusTimings(*rpctypes.Context) (*ctypes.ResultConsensusTimings, error) {
+	return &ctypes.ResultConsensusTimings{Timings: env.ConsensusState.GetTimings()}, nil
+}
+
 // ConsensusParams gets the consensus parameters at the given block height.
 // If no height is provided, it will fetch the latest consensus params.
 // More: https://docs.cometbft.com/v0.38/spec/rpc/#consensusparams
@@ -110,7 +153,7 @@ func (env *Environment) ConsensusParams(
 ) (*ctypes.ResultConsensusParams, error) {
 	// The latest consensus params that we know is the consensus params after the
 	// last block.
-	height, err := env.getHeight(env.latestUncommittedHeight(), heightPtr)
+	height, err := env.getStateHeight(env.latestUncommittedHeight(), heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -124,3 +167,132 @@ func (env *Environment) ConsensusParams(
 		ConsensusParams: consensusParams,
 	}, nil
 }
+
+// ValidatorSet gets the full validator set at the given block height, along
+// with the header at that height and a Merkle proof of the set's hash against
+// the header's ValidatorsHash. The header and proof are omitted if the block
+// is not available (not yet committed or pruned), while the validator set may
+// still be, see the storage.history_retain_heights config option.
+//
+// If no height is provided, it will fetch the validator set of the latest
+// block.
+func (env *Environment) ValidatorSet(
+	_ *rpctypes.Context,
+	heightPtr *int64,
+) (*ctypes.ResultValidatorSet, error) {
+	height, err := env.getStateHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := env.StateStore.LoadValidators(height)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ctypes.ResultValidatorSet{
+		BlockHeight:  height,
+		ValidatorSet: validators,
+	}
+	if blockMeta := env.BlockStore.LoadBlockMeta(height); blockMeta != nil {
+		proof, err := blockMeta.Header.ValidatorsHashProof()
+		if err != nil {
+			return nil, err
+		}
+		result.Header = &blockMeta.Header
+		result.Proof = proof
+	}
+	return result, nil
+}
+
+// ConsensusParamsProof gets the consensus parameters at the given block
+// height, along with the header at that height and a Merkle proof of the
+// params' hash against the header's ConsensusHash. As for ValidatorSet, the
+// header and proof are omitted if the block is not available.
+//
+// If no height is provided, it will fetch the consensus params of the latest
+// block.
+func (env *Environment) ConsensusParamsProof(
+	_ *rpctypes.Context,
+	heightPtr *int64,
+) (*ctypes.ResultConsensusParamsProof, error) {
+	height, err := env.getStateHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusParams, err := env.StateStore.LoadConsensusParams(height)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ctypes.ResultConsensusParamsProof{
+		BlockHeight:     height,
+		ConsensusParams: consensusParams,
+	}
+	if blockMeta := env.BlockStore.LoadBlockMeta(height); blockMeta != nil {
+		proof, err := blockMeta.Header.ConsensusHashProof()
+		if err != nil {
+			return nil, err
+		}
+		result.Header = &blockMeta.Header
+		result.Proof = proof
+	}
+	return result, nil
+}
+
+// ValidatorSetChanges returns the heights in [minHeight, maxHeight] at which
+// the validator set changed, along with the hash of the new set. At most
+// maxValidatorSetChanges changes, the lowest ones, are returned; query the
+// remaining ones by setting minHeight past the last returned height. On a
+// pruned node, the changes below the lowest retained height are not returned.
+//
+// If maxHeight is 0, the latest height is used.
+func (env *Environment) ValidatorSetChanges(
+	_ *rpctypes.Context,
+	minHeight, maxHeight int64,
+) (*ctypes.ResultValidatorSetChanges, error) {
+	latest := env.latestUncommittedHeight()
+	if maxHeight == 0 || maxHeight > latest {
+		maxHeight = latest
+	}
+	if minHeight <= 0 {
+		minHeight = 1
+	}
+	if minHeight > maxHeight {
+		return nil, fmt.Errorf("min height %d can't be greater than max height %d", minHeight, maxHeight)
+	}
+
+	heights, err := env.StateStore.LoadValidatorSetChanges(minHeight, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only load the validator sets of the returned changes.
+	if len(heights) > maxValidatorSetChanges {
+		heights = heights[:maxValidatorSetChanges]
+	}
+
+	changes := make([]ctypes.ValidatorSetChange, 0, len(heights))
+	var lastHash []byte
+	for _, height := range heights {
+		validators, err := env.StateStore.LoadValidators(height)
+		if err != nil {
+			return nil, err
+		}
+		// Pruning may leave entries for heights at which the set did not
+		// actually change.
+		hash := validators.Hash()
+		if bytes.Equal(hash, lastHash) {
+			continue
+		}
+		lastHash = hash
+
+		changes = append(changes, ctypes.ValidatorSetChange{
+			Height:         height,
+			ValidatorsHash: hash,
+		})
+	}
+
+	return &ctypes.ResultValidatorSetChanges{Changes: changes}, nil
+}
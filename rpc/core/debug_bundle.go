@@ -0,0 +1,245 @@
+package core
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cm "github.com/cometbft/cometbft/consensus"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// DefaultDebugBundleWALEntries is the number of WAL entries of the debug bundle
+// if the wal_entries query parameter is not set.
+const DefaultDebugBundleWALEntries = 1000
+
+// DebugBundle streams a gzipped tarball with the same debug data as collected by
+// "cometbft debug dump", for the nodes whose host can't be accessed, e.g. in
+// containerised deployments:
+//
+//   - goroutine.out, heap.out: the goroutine and heap profiles
+//   - logs.txt: the recent logs, see rpc.debug_log_buffer_size
+//   - config.toml: the config file of the node
+//   - status.json, net_info.json, consensus_state.json: the results of the
+//     /status, /net_info and /dump_consensus_state routes
+//   - wal.jsonl: the last entries of the consensus WAL, one JSON per line
+//
+// The number of WAL entries is set by the wal_entries query parameter, see
+// DefaultDebugBundleWALEntries. The data which can't be collected is replaced
+// by a <file>.err entry with the error, and every entry is flushed as soon as
+// it's written, so that the profiles are still received from a node whose
+// consensus is stuck.
+//
+// It is an unsafe route, served over plain HTTP only at /unsafe_debug_bundle.
+func (env *Environment) DebugBundle(w http.ResponseWriter, r *http.Request) {
+	walEntries := DefaultDebugBundleWALEntries
+	if s := r.URL.Query().Get("wal_entries"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			http.Error(w, "wal_entries must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		walEntries = n
+	}
+
+	now := cmttime.Now()
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=%q", now.Format(time.RFC3339)+".tar.gz"))
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	flusher, _ := w.(http.Flusher)
+
+	entries := []struct {
+		name    string
+		content func() ([]byte, error)
+	}{
+		{"goroutine.out", func() ([]byte, error) { return profile("goroutine", 2) }},
+		{"heap.out", func() ([]byte, error) { return profile("heap", 2) }},
+		{"logs.txt", env.recentLogs},
+		{"config.toml", env.configFile},
+		{"status.json", func() ([]byte, error) { return indentedJSON(env.Status(nil)) }},
+		{"net_info.json", func() ([]byte, error) { return indentedJSON(env.NetInfo(nil)) }},
+		{"consensus_state.json", func() ([]byte, error) { return indentedJSON(env.DumpConsensusState(nil)) }},
+		{"wal.jsonl", func() ([]byte, error) { return walTail(env.WALFile, walEntries) }},
+	}
+	for _, entry := range entries {
+		name := entry.name
+		content, err := entry.content()
+		if err != nil {
+			env.Logger.Error("Failed to collect debug data", "file", name, "err", err)
+			name, content = name+".err", []byte(err.Error()+"\n")
+		}
+
+		if err := writeTarEntry(tw, name, content, now); err != nil {
+			env.Logger.Error("Failed to write debug bundle", "err", err)
+			return
+		}
+		if err := gw.Flush(); err != nil {
+			env.Logger.Error("Failed to write debug bundle", "err", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		env.Logger.Error("Failed to write debug bundle", "err", err)
+		return
+	}
+	if err := gw.Close(); err != nil {
+		env.Logger.Error("Failed to write debug bundle", "err", err)
+	}
+}
+
+func writeTarEntry(tw *tar.Writer, name string, content []byte, modTime time.Time) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(content)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(content)
+	return err
+}
+
+func profile(name string, debug int) ([]byte, error) {
+	p := pprof.Lookup(name)
+	if p == nil {
+		return nil, fmt.Errorf("unknown profile %q", name)
+	}
+	var buf bytes.Buffer
+	if err := p.WriteTo(&buf, debug); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func indentedJSON(result any, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	return cmtjson.MarshalIndent(result, "", "  ")
+}
+
+func (env *Environment) recentLogs() ([]byte, error) {
+	if env.LogBuffer == nil {
+		return nil, errors.New("the log buffer is disabled, see rpc.debug_log_buffer_size")
+	}
+	var buf bytes.Buffer
+	if _, err := env.LogBuffer.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (env *Environment) configFile() ([]byte, error) {
+	return os.ReadFile(filepath.Join(env.Config.RootDir, cfg.DefaultConfigDir, cfg.DefaultConfigFileName))
+}
+
+// walTail returns the last n messages of the WAL whose head file is walFile, one
+// JSON per line. The WAL is a group of files: the head, which is written to,
+// and the files rotated out of it, suffixed by their index. They are read from
+// the newest one until n messages are found.
+func walTail(walFile string, n int) ([]byte, error) {
+	if walFile == "" {
+		return nil, errors.New("the node has no WAL")
+	}
+	files, err := walFiles(walFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []*cm.TimedWALMessage // oldest first
+	for i := len(files) - 1; i >= 0 && len(msgs) < n; i-- {
+		// the last message of the head may be partially written
+		fileMsgs, err := decodeWALFile(files[i], n-len(msgs), i == len(files)-1)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(fileMsgs, msgs...)
+	}
+
+	var buf bytes.Buffer
+	for _, msg := range msgs {
+		bz, err := cmtjson.Marshal(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal WAL message: %w", err)
+		}
+		buf.Write(bz)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// walFiles returns the files of the WAL whose head file is walFile, oldest
+// first.
+func walFiles(walFile string) ([]string, error) {
+	rotated, err := filepath.Glob(walFile + ".*")
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]int, len(rotated))
+	files := make([]string, 0, len(rotated)+1)
+	for _, file := range rotated {
+		index, err := strconv.Atoi(strings.TrimPrefix(file, walFile+"."))
+		if err != nil {
+			continue
+		}
+		indexes[file] = index
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool { return indexes[files[i]] < indexes[files[j]] })
+	return append(files, walFile), nil
+}
+
+// decodeWALFile returns the last n messages of a WAL file. If isHead, a message
+// which can't be decoded is taken as the end of the file.
+func decodeWALFile(file string, n int, isHead bool) ([]*cm.TimedWALMessage, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var msgs []*cm.TimedWALMessage
+	dec := cm.NewWALDecoder(f)
+	for {
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			if isHead {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode %s: %w", file, err)
+		}
+		msgs = append(msgs, msg)
+		// keep the last n messages without growing msgs indefinitely
+		if len(msgs) >= 2*n {
+			msgs = append(msgs[:0], msgs[len(msgs)-n:]...)
+		}
+	}
+	if len(msgs) > n {
+		msgs = msgs[len(msgs)-n:]
+	}
+	return msgs, nil
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cm "github.com/cometbft/cometbft/consensus"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+func TestWALTail(t *testing.T) {
+	walFile := filepath.Join(t.TempDir(), "wal")
+
+	// heights 1-5 in the rotated files, 6-8 in the head
+	writeWAL := func(file string, heights ...int64) {
+		var buf bytes.Buffer
+		enc := cm.NewWALEncoder(&buf)
+		for _, height := range heights {
+			msg := &cm.TimedWALMessage{Time: cmttime.Now(), Msg: cm.EndHeightMessage{Height: height}}
+			require.NoError(t, enc.Encode(msg))
+		}
+		require.NoError(t, os.WriteFile(file, buf.Bytes(), 0o600))
+	}
+	writeWAL(walFile+".000", 1, 2)
+	writeWAL(walFile+".001", 3, 4, 5)
+	writeWAL(walFile, 6, 7, 8)
+
+	heights := func(bz []byte) []int64 {
+		var heights []int64
+		scanner := bufio.NewScanner(bytes.NewReader(bz))
+		for scanner.Scan() {
+			var msg cm.TimedWALMessage
+			require.NoError(t, cmtjson.Unmarshal(scanner.Bytes(), &msg))
+			heights = append(heights, msg.Msg.(cm.EndHeightMessage).Height)
+		}
+		return heights
+	}
+
+	for _, tc := range []struct {
+		n    int
+		want []int64
+	}{
+		{0, nil},
+		{2, []int64{7, 8}},
+		{4, []int64{5, 6, 7, 8}},
+		{100, []int64{1, 2, 3, 4, 5, 6, 7, 8}},
+	} {
+		t.Run(fmt.Sprintf("n=%d", tc.n), func(t *testing.T) {
+			bz, err := walTail(walFile, tc.n)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, heights(bz))
+		})
+	}
+
+	// a partially written message at the end of the head is skipped
+	f, err := os.OpenFile(walFile, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0x01, 0x02, 0x03})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	bz, err := walTail(walFile, 2)
+	require.NoError(t, err)
+	require.Equal(t, []int64{7, 8}, heights(bz))
+
+	_, err = walTail(filepath.Join(t.TempDir(), "wal"), 2)
+	require.Error(t, err)
+}
+
+func TestDebugBundleInvalidWALEntries(t *testing.T) {
+	env := &Environment{Logger: log.TestingLogger()}
+
+	rec := httptest.NewRecorder()
+	env.DebugBundle(rec, httptest.NewRequest(http.MethodGet, "/unsafe_debug_bundle?wal_entries=-1", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cometbft/cometbft/blocksync"
 	cfg "github.com/cometbft/cometbft/config"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
 	"github.com/cometbft/cometbft/crypto"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
@@ -15,6 +17,7 @@ import (
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/uptime"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -30,6 +33,10 @@ const (
 	// genesisChunkSize is the maximum size, in bytes, of each
 	// chunk in the genesis structure for the chunked API
 	genesisChunkSize = 16 * 1024 * 1024 // 16
+
+	// maxValidatorSetChanges is the maximum number of changes returned by
+	// the validator_set_changes endpoint.
+	maxValidatorSetChanges = 100
 )
 
 //----------------------------------------------
@@ -41,6 +48,7 @@ type Consensus interface {
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
+	GetTimings() []cstypes.HeightTiming
 }
 
 type transport interface {
@@ -62,6 +70,16 @@ type syncReactor interface {
 	WaitSync() bool
 }
 
+// A reactor that tracks the block sync statistics of peers.
+type blockSyncReactor interface {
+	PeerBlockSyncStats(peerID p2p.ID) (blocksync.PeerStats, bool)
+}
+
+// A tracker of the commit signatures of the validators.
+type uptimeTracker interface {
+	Uptimes() (from, to int64, uptimes []uptime.ValidatorUptime)
+}
+
 // ----------------------------------------------
 // Environment contains objects and interfaces used by the RPC. It is expected
 // to be setup once during startup.
@@ -77,6 +95,8 @@ type Environment struct {
 	ConsensusState   Consensus
 	ConsensusReactor syncReactor
 	MempoolReactor   syncReactor
+	BlockSyncReactor blockSyncReactor
+	UptimeTracker    uptimeTracker
 	P2PPeers         peers
 	P2PTransport     transport
 
@@ -94,6 +114,10 @@ type Environment struct {
 
 	Logger log.Logger
 
+	// recent logs and WAL of the node, for the debug bundle
+	LogBuffer *log.RingBuffer
+	WALFile   string
+
 	Config cfg.RPCConfig
 
 	// cache of chunked genesis data.
@@ -196,6 +220,23 @@ func (env *Environment) getHeight(latestHeight int64, heightPtr *int64) (int64,
 	return latestHeight, nil
 }
 
+// getStateHeight is like getHeight, but for data served from the state store,
+// which may retain heights below the block store base.
+func (env *Environment) getStateHeight(latestHeight int64, heightPtr *int64) (int64, error) {
+	if heightPtr != nil {
+		height := *heightPtr
+		if height <= 0 {
+			return 0, fmt.Errorf("height must be greater than 0, but got %d", height)
+		}
+		if height > latestHeight {
+			return 0, fmt.Errorf("height %d must be less than or equal to the current blockchain height %d",
+				height, latestHeight)
+		}
+		return height, nil
+	}
+	return latestHeight, nil
+}
+
 func (env *Environment) latestUncommittedHeight() int64 {
 	nodeIsSyncing := env.ConsensusReactor.WaitSync()
 	if nodeIsSyncing {
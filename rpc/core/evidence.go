@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/cometbft/cometbft/evidence"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/cometbft/cometbft/types"
@@ -28,3 +29,49 @@ func (env *Environment) BroadcastEvidence(
 	}
 	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
 }
+
+// PendingEvidence returns the evidence in the pool which hasn't been committed
+// yet.
+func (env *Environment) PendingEvidence(*rpctypes.Context) (*ctypes.ResultPendingEvidence, error) {
+	evList, _ := env.EvidencePool.PendingEvidence(-1)
+	return &ctypes.ResultPendingEvidence{Count: len(evList), Evidence: evList}, nil
+}
+
+// Evidence returns the evidence committed in the block at the given height. If
+// no height is provided, it will fetch the evidence of the latest block.
+func (env *Environment) Evidence(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultEvidence, error) {
+	height, err := env.getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	block := env.BlockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	evList := block.Evidence.Evidence
+	return &ctypes.ResultEvidence{Height: height, Count: len(evList), Evidence: evList}, nil
+}
+
+type evidenceRejections interface {
+	RejectedEvidence(hash []byte) (evidence.RejectedEvidence, bool)
+}
+
+// RejectedEvidence returns why the evidence with the given hash was rejected
+// by the evidence pool. Only the most recent rejections are kept.
+func (env *Environment) RejectedEvidence(_ *rpctypes.Context, hash []byte) (*ctypes.ResultRejectedEvidence, error) {
+	pool, ok := env.EvidencePool.(evidenceRejections)
+	if !ok {
+		return nil, errors.New("evidence pool doesn't record rejections")
+	}
+
+	rejected, ok := pool.RejectedEvidence(hash)
+	if !ok {
+		return nil, fmt.Errorf("no rejection recorded for evidence %X", hash)
+	}
+	return &ctypes.ResultRejectedEvidence{
+		Hash:   rejected.Hash,
+		Height: rejected.Height,
+		Reason: rejected.Reason,
+	}, nil
+}
@@ -21,12 +21,25 @@ func (env *Environment) NetInfo(*rpctypes.Context) (*ctypes.ResultNetInfo, error
 			err = fmt.Errorf("peer %v has the invalid node info type: %T ", peer.ID(), peer.NodeInfo())
 			return
 		}
-		peers = append(peers, ctypes.Peer{
+		p := ctypes.Peer{
 			NodeInfo:         nodeInfo,
 			IsOutbound:       peer.IsOutbound(),
 			ConnectionStatus: peer.Status(),
 			RemoteIP:         peer.RemoteIP().String(),
-		})
+		}
+		if env.BlockSyncReactor != nil {
+			if stats, ok := env.BlockSyncReactor.PeerBlockSyncStats(peer.ID()); ok {
+				p.BlockSync = &ctypes.PeerBlockSyncStats{
+					Base:            stats.Base,
+					Height:          stats.Height,
+					PendingRequests: stats.PendingRequests,
+					RecvRate:        stats.RecvRate,
+					Latency:         stats.Latency,
+					BlocksReceived:  stats.BlocksReceived,
+				}
+			}
+		}
+		peers = append(peers, p)
 	})
 	if err != nil {
 		return nil, err
@@ -17,28 +17,36 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"unsubscribe_all": rpc.NewWSRPCFunc(env.UnsubscribeAll, ""),
 
 		// info AP
-		"health":               rpc.NewRPCFunc(env.Health, ""),
-		"status":               rpc.NewRPCFunc(env.Status, ""),
-		"net_info":             rpc.NewRPCFunc(env.NetInfo, ""),
-		"blockchain":           rpc.NewRPCFunc(env.BlockchainInfo, "minHeight,maxHeight", rpc.Cacheable()),
-		"genesis":              rpc.NewRPCFunc(env.Genesis, "", rpc.Cacheable()),
-		"genesis_chunked":      rpc.NewRPCFunc(env.GenesisChunked, "chunk", rpc.Cacheable()),
-		"block":                rpc.NewRPCFunc(env.Block, "height", rpc.Cacheable("height")),
-		"block_by_hash":        rpc.NewRPCFunc(env.BlockByHash, "hash", rpc.Cacheable()),
-		"block_results":        rpc.NewRPCFunc(env.BlockResults, "height", rpc.Cacheable("height")),
-		"commit":               rpc.NewRPCFunc(env.Commit, "height", rpc.Cacheable("height")),
-		"header":               rpc.NewRPCFunc(env.Header, "height", rpc.Cacheable("height")),
-		"header_by_hash":       rpc.NewRPCFunc(env.HeaderByHash, "hash", rpc.Cacheable()),
-		"check_tx":             rpc.NewRPCFunc(env.CheckTx, "tx"),
-		"tx":                   rpc.NewRPCFunc(env.Tx, "hash,prove", rpc.Cacheable()),
-		"tx_search":            rpc.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
-		"block_search":         rpc.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
-		"validators":           rpc.NewRPCFunc(env.Validators, "height,page,per_page", rpc.Cacheable("height")),
-		"dump_consensus_state": rpc.NewRPCFunc(env.DumpConsensusState, ""),
-		"consensus_state":      rpc.NewRPCFunc(env.GetConsensusState, ""),
-		"consensus_params":     rpc.NewRPCFunc(env.ConsensusParams, "height", rpc.Cacheable("height")),
-		"unconfirmed_txs":      rpc.NewRPCFunc(env.UnconfirmedTxs, "limit"),
-		"num_unconfirmed_txs":  rpc.NewRPCFunc(env.NumUnconfirmedTxs, ""),
+		"health":                 rpc.NewRPCFunc(env.Health, ""),
+		"status":                 rpc.NewRPCFunc(env.Status, ""),
+		"net_info":               rpc.NewRPCFunc(env.NetInfo, ""),
+		"blockchain":             rpc.NewRPCFunc(env.BlockchainInfo, "minHeight,maxHeight", rpc.Cacheable()),
+		"genesis":                rpc.NewRPCFunc(env.Genesis, "", rpc.Cacheable()),
+		"genesis_chunked":        rpc.NewRPCFunc(env.GenesisChunked, "chunk", rpc.Cacheable()),
+		"block":                  rpc.NewRPCFunc(env.Block, "height", rpc.Cacheable("height")),
+		"block_by_hash":          rpc.NewRPCFunc(env.BlockByHash, "hash", rpc.Cacheable()),
+		"block_results":          rpc.NewRPCFunc(env.BlockResults, "height", rpc.Cacheable("height")),
+		"tx_result_proof":        rpc.NewRPCFunc(env.TxResultProof, "height,index", rpc.Cacheable()),
+		"event_proof":            rpc.NewRPCFunc(env.EventProof, "height,tx_index,index", rpc.Cacheable()),
+		"commit":                 rpc.NewRPCFunc(env.Commit, "height", rpc.Cacheable("height")),
+		"extended_commit":        rpc.NewRPCFunc(env.ExtendedCommit, "height", rpc.Cacheable("height")),
+		"header":                 rpc.NewRPCFunc(env.Header, "height", rpc.Cacheable("height")),
+		"header_by_hash":         rpc.NewRPCFunc(env.HeaderByHash, "hash", rpc.Cacheable()),
+		"check_tx":               rpc.NewRPCFunc(env.CheckTx, "tx"),
+		"tx":                     rpc.NewRPCFunc(env.Tx, "hash,prove", rpc.Cacheable()),
+		"tx_search":              rpc.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
+		"block_search":           rpc.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
+		"validators":             rpc.NewRPCFunc(env.Validators, "height,page,per_page", rpc.Cacheable("height")),
+		"validator_uptime":       rpc.NewRPCFunc(env.ValidatorUptime, "address"),
+		"dump_consensus_state":   rpc.NewRPCFunc(env.DumpConsensusState, ""),
+		"consensus_state":        rpc.NewRPCFunc(env.GetConsensusState, ""),
+		"consensus_timings":      rpc.NewRPCFunc(env.ConsensusTimings, ""),
+		"consensus_params":       rpc.NewRPCFunc(env.ConsensusParams, "height", rpc.Cacheable("height")),
+		"validator_set":          rpc.NewRPCFunc(env.ValidatorSet, "height", rpc.Cacheable("height")),
+		"consensus_params_proof": rpc.NewRPCFunc(env.ConsensusParamsProof, "height", rpc.Cacheable("height")),
+		"validator_set_changes":  rpc.NewRPCFunc(env.ValidatorSetChanges, "minHeight,maxHeight"),
+		"unconfirmed_txs":        rpc.NewRPCFunc(env.UnconfirmedTxs, "limit"),
+		"num_unconfirmed_txs":    rpc.NewRPCFunc(env.NumUnconfirmedTxs, ""),
 
 		// tx broadcast API
 		"broadcast_tx_commit": rpc.NewRPCFunc(env.BroadcastTxCommit, "tx"),
@@ -51,6 +59,9 @@ func (env *Environment) GetRoutes() RoutesMap {
 
 		// evidence API
 		"broadcast_evidence": rpc.NewRPCFunc(env.BroadcastEvidence, "evidence"),
+		"pending_evidence":   rpc.NewRPCFunc(env.PendingEvidence, ""),
+		"evidence":           rpc.NewRPCFunc(env.Evidence, "height", rpc.Cacheable("height")),
+		"rejected_evidence":  rpc.NewRPCFunc(env.RejectedEvidence, "hash"),
 	}
 }
 
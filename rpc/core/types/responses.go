@@ -5,10 +5,13 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/p2p"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/state/uptime"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -50,6 +53,12 @@ type ResultCommit struct {
 	CanonicalCommit    bool `json:"canonical"`
 }
 
+// Extended commit, with the vote extensions, and header of a block
+type ResultExtendedCommit struct {
+	Header         types.Header          `json:"header"`
+	ExtendedCommit *types.ExtendedCommit `json:"extended_commit"`
+}
+
 // ABCI results from a block
 type ResultBlockResults struct {
 	Height                int64                     `json:"height"`
@@ -60,6 +69,25 @@ type ResultBlockResults struct {
 	AppHash               []byte                    `json:"app_hash"`
 }
 
+// Result of a tx, with a proof of it against the LastResultsHash of the next
+// header.
+type ResultTxResultProof struct {
+	Height   int64              `json:"height"`
+	Index    int                `json:"index"`
+	TxResult *abci.ExecTxResult `json:"tx_result"`
+	Proof    merkle.Proof       `json:"proof"`
+}
+
+// Event of a tx, with a proof of it against the hash of the events of the tx.
+type ResultEventProof struct {
+	Height     int64          `json:"height"`
+	TxIndex    int            `json:"tx_index"`
+	Index      int            `json:"index"`
+	Event      abci.Event     `json:"event"`
+	EventsHash bytes.HexBytes `json:"events_hash"`
+	Proof      merkle.Proof   `json:"proof"`
+}
+
 // NewResultCommit is a helper to initialize the ResultCommit with
 // the embedded struct
 func NewResultCommit(header *types.Header, commit *types.Commit,
@@ -135,6 +163,17 @@ type Peer struct {
 	IsOutbound       bool                 `json:"is_outbound"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         string               `json:"remote_ip"`
+	BlockSync        *PeerBlockSyncStats  `json:"block_sync,omitempty"`
+}
+
+// PeerBlockSyncStats holds the block sync statistics of a peer.
+type PeerBlockSyncStats struct {
+	Base            int64         `json:"base"`
+	Height          int64         `json:"height"`
+	PendingRequests int32         `json:"pending_requests"`
+	RecvRate        int64         `json:"recv_rate"` // bytes/s
+	Latency         time.Duration `json:"latency"`
+	BlocksReceived  int64         `json:"blocks_received"`
 }
 
 // Validators for a height.
@@ -153,6 +192,43 @@ type ResultConsensusParams struct {
 	ConsensusParams types.ConsensusParams `json:"consensus_params"`
 }
 
+// Validator set at a height, with a proof of its hash against the header's
+// ValidatorsHash. Header and Proof are nil if the block is not available.
+type ResultValidatorSet struct {
+	BlockHeight  int64               `json:"block_height"`
+	ValidatorSet *types.ValidatorSet `json:"validator_set"`
+	Header       *types.Header       `json:"header,omitempty"`
+	Proof        *merkle.Proof       `json:"proof,omitempty"`
+}
+
+// Consensus params at a height, with a proof of their hash against the
+// header's ConsensusHash. Header and Proof are nil if the block is not
+// available.
+type ResultConsensusParamsProof struct {
+	BlockHeight     int64                 `json:"block_height"`
+	ConsensusParams types.ConsensusParams `json:"consensus_params"`
+	Header          *types.Header         `json:"header,omitempty"`
+	Proof           *merkle.Proof         `json:"proof,omitempty"`
+}
+
+// ValidatorSetChange is a height at which the validator set changed.
+type ValidatorSetChange struct {
+	Height         int64          `json:"height"`
+	ValidatorsHash bytes.HexBytes `json:"validators_hash"`
+}
+
+// List of validator set changes, in ascending height order.
+type ResultValidatorSetChanges struct {
+	Changes []ValidatorSetChange `json:"changes"`
+}
+
+// Uptime of the validators over the heights from and to.
+type ResultValidatorUptime struct {
+	FromHeight int64                    `json:"from_height"`
+	ToHeight   int64                    `json:"to_height"`
+	Validators []uptime.ValidatorUptime `json:"validators"`
+}
+
 // Info about the consensus state.
 // UNSTABLE
 type ResultDumpConsensusState struct {
@@ -171,6 +247,13 @@ type ResultConsensusState struct {
 	RoundState json.RawMessage `json:"round_state"`
 }
 
+// Timings of the latest heights, oldest first. The last one is the height in
+// progress.
+// UNSTABLE
+type ResultConsensusTimings struct {
+	Timings []cstypes.HeightTiming `json:"timings"`
+}
+
 // CheckTx result
 type ResultBroadcastTx struct {
 	Code      uint32         `json:"code"`
@@ -239,6 +322,26 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// List of evidence pending in the pool
+type ResultPendingEvidence struct {
+	Count    int              `json:"n_evidence"`
+	Evidence []types.Evidence `json:"evidence"`
+}
+
+// List of evidence committed in a block
+type ResultEvidence struct {
+	Height   int64            `json:"height"`
+	Count    int              `json:"n_evidence"`
+	Evidence []types.Evidence `json:"evidence"`
+}
+
+// Reason for which the evidence pool rejected evidence
+type ResultRejectedEvidence struct {
+	Hash   bytes.HexBytes `json:"hash"`
+	Height int64          `json:"height"`
+	Reason string         `json:"reason"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
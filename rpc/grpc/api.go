@@ -38,3 +38,64 @@ func (bapi *broadcastAPI) BroadcastTx(_ context.Context, req *RequestBroadcastTx
 		},
 	}, nil
 }
+
+type stateAPI struct {
+	env *core.Environment
+}
+
+func (sapi *stateAPI) ValidatorSet(_ context.Context, req *RequestValidatorSet) (*ResponseValidatorSet, error) {
+	res, err := sapi.env.ValidatorSet(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := res.ValidatorSet.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseValidatorSet{
+		Height:       res.BlockHeight,
+		ValidatorSet: vals,
+		Header:       res.Header.ToProto(),
+		Proof:        res.Proof.ToProto(),
+	}, nil
+}
+
+func (sapi *stateAPI) ConsensusParams(_ context.Context, req *RequestConsensusParams) (*ResponseConsensusParams, error) {
+	res, err := sapi.env.ConsensusParamsProof(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	params := res.ConsensusParams.ToProto()
+	return &ResponseConsensusParams{
+		Height:          res.BlockHeight,
+		ConsensusParams: &params,
+		Header:          res.Header.ToProto(),
+		Proof:           res.Proof.ToProto(),
+	}, nil
+}
+
+type blockAPI struct {
+	env *core.Environment
+}
+
+func (bapi *blockAPI) ExtendedCommit(_ context.Context, req *RequestExtendedCommit) (*ResponseExtendedCommit, error) {
+	res, err := bapi.env.ExtendedCommit(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResponseExtendedCommit{
+		Header:         res.Header.ToProto(),
+		ExtendedCommit: res.ExtendedCommit.ToProto(),
+	}, nil
+}
+
+// heightPtr maps the zero height of the gRPC requests to the latest height.
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
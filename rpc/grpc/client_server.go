@@ -25,6 +25,8 @@ type Config struct {
 func StartGRPCServer(env *core.Environment, ln net.Listener) error {
 	grpcServer := grpc.NewServer()
 	RegisterBroadcastAPIServer(grpcServer, &broadcastAPI{env: env})
+	RegisterStateAPIServer(grpcServer, &stateAPI{env: env})
+	RegisterBlockAPIServer(grpcServer, &blockAPI{env: env})
 	return grpcServer.Serve(ln)
 }
 
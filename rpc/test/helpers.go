@@ -103,6 +103,8 @@ func createConfig() *cfg.Config {
 	c.RPC.ListenAddress = rpc
 	c.RPC.CORSAllowedOrigins = []string{"https://cometbft.com/"}
 	c.RPC.GRPCListenAddress = grpc
+	// served under /validator_uptime
+	c.Instrumentation.ValidatorUptimeWindow = 100
 	return c
 }
 
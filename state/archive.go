@@ -0,0 +1,360 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/protoio"
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ArchiveVersion is the version of the format of the archives written by
+// ExportArchive.
+const ArchiveVersion = 1
+
+// maxArchiveMsgSize is the maximum size of a message read from an archive. A
+// height holds a block along with its commits, results and state.
+const maxArchiveMsgSize = 2 * types.MaxBlockSizeBytes
+
+// ExportArchive writes the chain data of the heights from to to, inclusive, to
+// w. The archive is a stream of length-prefixed protobuf messages: an
+// ArchiveHeader, followed by an ArchiveHeight for each height holding its
+// block, seen commit, extended commit, FinalizeBlock response and the state
+// after committing it, which holds the validator sets and consensus params.
+// The caller should pass a buffered writer.
+func ExportArchive(w io.Writer, bs BlockStore, ss Store, from, to int64) error {
+	latestState, err := ss.Load()
+	if err != nil {
+		return err
+	}
+	if latestState.IsEmpty() {
+		return errors.New("no state found")
+	}
+	base := max(latestState.InitialHeight, bs.Base())
+	if from < base || to > latestState.LastBlockHeight || from > to {
+		return fmt.Errorf("cannot export heights %d to %d: must be between %d and %d",
+			from, to, base, latestState.LastBlockHeight)
+	}
+
+	pw := protoio.NewDelimitedWriter(w)
+	header := &cmtstate.ArchiveHeader{
+		Version:    ArchiveVersion,
+		ChainID:    latestState.ChainID,
+		FromHeight: from,
+		ToHeight:   to,
+	}
+	if _, err := pw.WriteMsg(header); err != nil {
+		return fmt.Errorf("failed to write archive header: %w", err)
+	}
+	for h := from; h <= to; h++ {
+		pb, err := exportHeight(bs, ss, latestState, h)
+		if err != nil {
+			return fmt.Errorf("failed to export height %d: %w", h, err)
+		}
+		if _, err := pw.WriteMsg(pb); err != nil {
+			return fmt.Errorf("failed to write height %d: %w", h, err)
+		}
+	}
+	return nil
+}
+
+func exportHeight(bs BlockStore, ss Store, latestState State, height int64) (*cmtstate.ArchiveHeight, error) {
+	block := bs.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	// The seen commit may be missing, e.g. for the blocks received by block
+	// sync before v0.38, in which case the commit of the next block is used.
+	seenCommit := bs.LoadSeenCommit(height)
+	if seenCommit == nil {
+		seenCommit = bs.LoadBlockCommit(height)
+	}
+	if seenCommit == nil {
+		return nil, fmt.Errorf("commit at height %d not found", height)
+	}
+
+	st := latestState
+	if height < latestState.LastBlockHeight {
+		st, err = loadStateAt(bs, ss, height, latestState.ChainID, latestState.InitialHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pbState, err := st.ToProto()
+	if err != nil {
+		return nil, err
+	}
+
+	pb := &cmtstate.ArchiveHeight{
+		Block:      pbBlock,
+		SeenCommit: seenCommit.ToProto(),
+		State:      *pbState,
+	}
+	if extCommit := bs.LoadBlockExtendedCommit(height); extCommit != nil {
+		pb.ExtendedCommit = extCommit.ToProto()
+	}
+	resp, err := ss.LoadFinalizeBlockResponse(height)
+	switch {
+	case err == nil:
+		pb.FinalizeBlockResponse = resp
+	case errors.Is(err, ErrFinalizeBlockResponsesNotPersisted), errors.As(err, &ErrNoABCIResponsesForHeight{}):
+		// the responses were discarded
+	default:
+		return nil, err
+	}
+	return pb, nil
+}
+
+// ArchiveTrustOptions anchor the import of an archive into empty stores, whose
+// first block can't be validated against the state of the previous height.
+type ArchiveTrustOptions struct {
+	// Genesis of the chain. If the archive starts at its initial height, the
+	// first block must be agreed upon by the genesis validators.
+	Genesis *types.GenesisDoc
+	// Height and hash of a trusted block, which must be the first block of the
+	// archive. Required unless the archive starts at the initial height.
+	Height int64
+	Hash   []byte
+}
+
+// ImportArchive loads an archive written by ExportArchive into the given
+// stores, and returns the state after its last height. Each block is validated
+// against the state of the previous height, and its commits are verified
+// against its validator set.
+//
+// The stores must either hold the heights below the archive, or be empty, in
+// which case the first block of the archive is checked against the trust
+// options, and the state after it is trusted, as with state sync.
+//
+// The next validators, consensus params and app hash of the state after the
+// last height aren't checked by any block of the archive, and are trusted
+// as-is. If they were tampered with, the node rejects the next block.
+func ImportArchive(r io.Reader, bs BlockStore, ss Store, trust ArchiveTrustOptions) (State, error) {
+	pr := protoio.NewDelimitedReader(r, maxArchiveMsgSize)
+	var header cmtstate.ArchiveHeader
+	if _, err := pr.ReadMsg(&header); err != nil {
+		return State{}, fmt.Errorf("failed to read archive header: %w", err)
+	}
+	if header.Version != ArchiveVersion {
+		return State{}, fmt.Errorf("unsupported archive version %d, expected %d", header.Version, ArchiveVersion)
+	}
+	if header.FromHeight < 1 || header.FromHeight > header.ToHeight {
+		return State{}, fmt.Errorf("invalid archive heights %d to %d", header.FromHeight, header.ToHeight)
+	}
+
+	lastState, err := ss.Load()
+	if err != nil {
+		return State{}, err
+	}
+	// The validator sets and consensus params are stored from the first
+	// imported height on. Later heights must not point to earlier ones.
+	var minHeightChanged int64
+	if lastState.IsEmpty() {
+		if bs.Height() > 0 {
+			return State{}, fmt.Errorf("no state found, but the blockstore is at height %d", bs.Height())
+		}
+		minHeightChanged = header.FromHeight + 1
+	} else {
+		switch {
+		case lastState.ChainID != header.ChainID:
+			return State{}, fmt.Errorf("archive is for chain %q, expected %q", header.ChainID, lastState.ChainID)
+		case lastState.LastBlockHeight != header.FromHeight-1:
+			return State{}, fmt.Errorf("archive starts at height %d, expected %d",
+				header.FromHeight, lastState.LastBlockHeight+1)
+		case bs.Height() != lastState.LastBlockHeight:
+			return State{}, fmt.Errorf("statestore height (%d) is not equal to blockstore height (%d)",
+				lastState.LastBlockHeight, bs.Height())
+		}
+	}
+
+	var prevState *State
+	if !lastState.IsEmpty() {
+		prevState = &lastState
+	}
+	for h := header.FromHeight; h <= header.ToHeight; h++ {
+		var pb cmtstate.ArchiveHeight
+		if _, err := pr.ReadMsg(&pb); err != nil {
+			return State{}, fmt.Errorf("failed to read height %d: %w", h, err)
+		}
+		ah, err := archivedHeightFromProto(&pb)
+		if err != nil {
+			return State{}, fmt.Errorf("invalid height %d: %w", h, err)
+		}
+		if prevState == nil {
+			if err := ah.verifyTrusted(trust, header.ChainID); err != nil {
+				return State{}, fmt.Errorf("invalid height %d: %w", h, err)
+			}
+		}
+		if err := ah.verify(prevState, header.ChainID, h); err != nil {
+			return State{}, fmt.Errorf("invalid height %d: %w", h, err)
+		}
+
+		if ah.extCommit != nil {
+			bs.SaveBlockWithExtendedCommit(ah.block, ah.blockParts, ah.extCommit)
+		} else {
+			bs.SaveBlock(ah.block, ah.blockParts, ah.seenCommit)
+		}
+		if ah.finalizeBlockResponse != nil {
+			if err := ss.SaveFinalizeBlockResponse(h, ah.finalizeBlockResponse); err != nil {
+				return State{}, fmt.Errorf("failed to save results of height %d: %w", h, err)
+			}
+		}
+
+		st := ah.state
+		st.LastHeightValidatorsChanged = max(st.LastHeightValidatorsChanged, minHeightChanged)
+		st.LastHeightConsensusParamsChanged = max(st.LastHeightConsensusParamsChanged, minHeightChanged)
+		if prevState == nil {
+			err = ss.Bootstrap(st)
+		} else {
+			err = ss.Save(st)
+		}
+		if err != nil {
+			return State{}, fmt.Errorf("failed to save state of height %d: %w", h, err)
+		}
+		prevState = &st
+	}
+	return *prevState, nil
+}
+
+// archivedHeight is the chain data of a height read from an archive.
+type archivedHeight struct {
+	block                 *types.Block
+	blockParts            *types.PartSet
+	seenCommit            *types.Commit
+	extCommit             *types.ExtendedCommit
+	finalizeBlockResponse *abci.ResponseFinalizeBlock
+	state                 State
+}
+
+func archivedHeightFromProto(pb *cmtstate.ArchiveHeight) (*archivedHeight, error) {
+	block, err := types.BlockFromProto(pb.Block)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block: %w", err)
+	}
+	blockParts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	if pb.SeenCommit == nil {
+		return nil, errors.New("missing seen commit")
+	}
+	seenCommit, err := types.CommitFromProto(pb.SeenCommit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid seen commit: %w", err)
+	}
+	st, err := FromProto(&pb.State)
+	if err != nil {
+		return nil, fmt.Errorf("invalid state: %w", err)
+	}
+
+	ah := &archivedHeight{
+		block:                 block,
+		blockParts:            blockParts,
+		seenCommit:            seenCommit,
+		finalizeBlockResponse: pb.FinalizeBlockResponse,
+		state:                 *st,
+	}
+	if pb.ExtendedCommit != nil {
+		ah.extCommit, err = types.ExtendedCommitFromProto(pb.ExtendedCommit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid extended commit: %w", err)
+		}
+	}
+	return ah, nil
+}
+
+// verifyTrusted checks the first block imported into empty stores against the
+// trust options: its hash must be the trusted one, or, at the initial height,
+// its validators must be the genesis ones. Its commits are then verified
+// against these validators by verify.
+func (ah *archivedHeight) verifyTrusted(trust ArchiveTrustOptions, chainID string) error {
+	block := ah.block
+	if len(trust.Hash) > 0 {
+		if trust.Height != block.Height {
+			return fmt.Errorf("trusted height %d isn't the first height of the archive", trust.Height)
+		}
+		if !bytes.Equal(block.Hash(), trust.Hash) {
+			return fmt.Errorf("block hash %X doesn't match the trusted hash %X", block.Hash(), trust.Hash)
+		}
+		return nil
+	}
+
+	genDoc := trust.Genesis
+	if genDoc == nil || block.Height != genDoc.InitialHeight {
+		return errors.New("a trusted hash is required to import an archive which doesn't start at the initial height")
+	}
+	if genDoc.ChainID != chainID {
+		return fmt.Errorf("archive is for chain %q, but the genesis is for chain %q", chainID, genDoc.ChainID)
+	}
+	if len(genDoc.Validators) == 0 {
+		return errors.New("a trusted hash is required, as the genesis has no validators")
+	}
+	validators := make([]*types.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = types.NewValidator(val.PubKey, val.Power)
+	}
+	if !bytes.Equal(types.NewValidatorSet(validators).Hash(), block.ValidatorsHash) {
+		return errors.New("block validators don't match the genesis ones, a trusted hash is required")
+	}
+	return nil
+}
+
+// verify checks that the block is valid against the previous state, if any,
+// that its commits are signed by its validators, that the state follows the
+// block, and that the results match the state.
+//
+// The next validators, consensus params and app hash of the state are only
+// checked by the block of the next height. Those of the last archived height
+// are trusted as-is.
+func (ah *archivedHeight) verify(prevState *State, chainID string, height int64) error {
+	block, st := ah.block, ah.state
+	if block.Height != height {
+		return fmt.Errorf("wrong block height %d", block.Height)
+	}
+	if block.ChainID != chainID {
+		return fmt.Errorf("wrong block chain ID %q", block.ChainID)
+	}
+	if prevState != nil {
+		if err := validateBlock(*prevState, block); err != nil {
+			return fmt.Errorf("invalid block: %w", err)
+		}
+	}
+
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: ah.blockParts.Header()}
+	switch {
+	case st.ChainID != chainID, st.LastBlockHeight != height, !st.LastBlockID.Equals(blockID):
+		return errors.New("state isn't the one after the block")
+	case st.LastValidators.IsNilOrEmpty(), !bytes.Equal(st.LastValidators.Hash(), block.ValidatorsHash):
+		return errors.New("state validators don't match the block")
+	case !bytes.Equal(st.Validators.Hash(), block.NextValidatorsHash):
+		return errors.New("state next validators don't match the block")
+	}
+
+	if err := st.LastValidators.VerifyCommit(chainID, blockID, height, ah.seenCommit); err != nil {
+		return fmt.Errorf("invalid seen commit: %w", err)
+	}
+	if ah.extCommit != nil {
+		if err := st.LastValidators.VerifyCommit(chainID, blockID, height, ah.extCommit.ToCommit()); err != nil {
+			return fmt.Errorf("invalid extended commit: %w", err)
+		}
+	}
+
+	if resp := ah.finalizeBlockResponse; resp != nil {
+		if !bytes.Equal(TxResultsHash(resp.TxResults), st.LastResultsHash) {
+			return errors.New("FinalizeBlock response results don't match the state")
+		}
+		// The responses loaded from the legacy format have no app hash.
+		if len(resp.AppHash) > 0 && !bytes.Equal(resp.AppHash, st.AppHash) {
+			return errors.New("FinalizeBlock response app hash doesn't match the state")
+		}
+	}
+	return nil
+}
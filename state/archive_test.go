@@ -0,0 +1,198 @@
+package state_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/protoio"
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestExportImportArchive(t *testing.T) {
+	const chainHeight int64 = 10
+	blockStore, stateStore, states := makeRollbackChain(t, chainHeight)
+
+	exportArchive := func(from, to int64) *bytes.Buffer {
+		var buf bytes.Buffer
+		require.NoError(t, state.ExportArchive(&buf, blockStore, stateStore, from, to))
+		return &buf
+	}
+	requireState := func(expected, actual state.State) {
+		t.Helper()
+		// the heights the validator sets and consensus params changed at may
+		// be moved up to the first imported height
+		expected.LastHeightValidatorsChanged = actual.LastHeightValidatorsChanged
+		expected.LastHeightConsensusParamsChanged = actual.LastHeightConsensusParamsChanged
+		require.Equal(t, expected.Bytes(), actual.Bytes())
+	}
+
+	// import into empty stores, then continue from them
+	importBlockStore := store.NewBlockStore(dbm.NewMemDB())
+	importStateStore := state.NewStore(dbm.NewMemDB(), state.StoreOptions{})
+	trust := state.ArchiveTrustOptions{Height: 4, Hash: blockStore.LoadBlock(4).Hash()}
+	importedState, err := state.ImportArchive(exportArchive(4, 8), importBlockStore, importStateStore, trust)
+	require.NoError(t, err)
+	requireState(states[8], importedState)
+	importedState, err = state.ImportArchive(exportArchive(9, chainHeight), importBlockStore, importStateStore,
+		state.ArchiveTrustOptions{})
+	require.NoError(t, err)
+	requireState(states[chainHeight], importedState)
+
+	loadedState, err := importStateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, importedState, loadedState)
+	require.EqualValues(t, 4, importBlockStore.Base())
+	require.Equal(t, chainHeight, importBlockStore.Height())
+	for h := int64(4); h <= chainHeight; h++ {
+		require.Equal(t, blockStore.LoadBlock(h).Hash(), importBlockStore.LoadBlock(h).Hash())
+		require.Equal(t, blockStore.LoadSeenCommit(h), importBlockStore.LoadSeenCommit(h))
+		require.Equal(t, blockStore.LoadBlockExtendedCommit(h), importBlockStore.LoadBlockExtendedCommit(h))
+
+		vals, err := importStateStore.LoadValidators(h)
+		require.NoError(t, err)
+		require.Equal(t, states[h].LastValidators.Hash(), vals.Hash())
+		params, err := importStateStore.LoadConsensusParams(h + 1)
+		require.NoError(t, err)
+		require.Equal(t, states[h].ConsensusParams.Hash(), params.Hash())
+		expectedResp, err := stateStore.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+		resp, err := importStateStore.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+		require.Equal(t, expectedResp, resp)
+	}
+
+	// an archive must continue the stores
+	_, err = state.ImportArchive(exportArchive(4, 8), importBlockStore, importStateStore, trust)
+	require.Error(t, err)
+}
+
+func TestImportArchiveTrust(t *testing.T) {
+	blockStore, stateStore, states := makeRollbackChain(t, 5)
+	exportArchive := func(from, to int64) *bytes.Buffer {
+		var buf bytes.Buffer
+		require.NoError(t, state.ExportArchive(&buf, blockStore, stateStore, from, to))
+		return &buf
+	}
+	makeGenesis := func(vals *types.ValidatorSet) *types.GenesisDoc {
+		genVals := make([]types.GenesisValidator, vals.Size())
+		for i, val := range vals.Validators {
+			genVals[i] = types.GenesisValidator{PubKey: val.PubKey, Power: val.VotingPower}
+		}
+		return &types.GenesisDoc{ChainID: states[0].ChainID, InitialHeight: 1, Validators: genVals}
+	}
+	otherVals, _ := types.RandValidatorSet(2, 1000)
+	genesis := makeGenesis(states[0].Validators)
+
+	testCases := []struct {
+		name     string
+		from     int64
+		trust    state.ArchiveTrustOptions
+		errorMsg string
+	}{
+		{"genesis", 1, state.ArchiveTrustOptions{Genesis: genesis}, ""},
+		{"trusted hash", 3, state.ArchiveTrustOptions{Height: 3, Hash: blockStore.LoadBlock(3).Hash()}, ""},
+		{"no trust", 3, state.ArchiveTrustOptions{}, "a trusted hash is required"},
+		{"genesis above the initial height", 3, state.ArchiveTrustOptions{Genesis: genesis}, "a trusted hash is required"},
+		{"other genesis validators", 1, state.ArchiveTrustOptions{Genesis: makeGenesis(otherVals)}, "don't match the genesis"},
+		{"wrong trusted hash", 3, state.ArchiveTrustOptions{Height: 3, Hash: blockStore.LoadBlock(4).Hash()}, "doesn't match the trusted hash"},
+		{"wrong trusted height", 3, state.ArchiveTrustOptions{Height: 4, Hash: blockStore.LoadBlock(3).Hash()}, "isn't the first height"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			importBlockStore := store.NewBlockStore(dbm.NewMemDB())
+			importStateStore := state.NewStore(dbm.NewMemDB(), state.StoreOptions{})
+			importedState, err := state.ImportArchive(exportArchive(tc.from, 5), importBlockStore, importStateStore, tc.trust)
+			if tc.errorMsg != "" {
+				require.ErrorContains(t, err, tc.errorMsg)
+				require.Zero(t, importBlockStore.Height())
+				return
+			}
+			require.NoError(t, err)
+			require.EqualValues(t, 5, importedState.LastBlockHeight)
+			require.Equal(t, states[5].AppHash, importedState.AppHash)
+		})
+	}
+}
+
+func TestExportArchiveInvalidHeights(t *testing.T) {
+	blockStore, stateStore, _ := makeRollbackChain(t, 3)
+
+	for _, heights := range [][2]int64{{0, 3}, {1, 4}, {3, 2}} {
+		var buf bytes.Buffer
+		err := state.ExportArchive(&buf, blockStore, stateStore, heights[0], heights[1])
+		require.Error(t, err)
+	}
+}
+
+func TestImportArchiveInvalidCommit(t *testing.T) {
+	blockStore, stateStore, _ := makeRollbackChain(t, 3)
+	tampered := tamperArchive(t, blockStore, stateStore, 3, 2, func(pb *cmtstate.ArchiveHeight) {
+		pb.SeenCommit.Signatures[0].Signature[0] ^= 0xff
+	})
+
+	importBlockStore := store.NewBlockStore(dbm.NewMemDB())
+	importStateStore := state.NewStore(dbm.NewMemDB(), state.StoreOptions{})
+	_, err := state.ImportArchive(tampered, importBlockStore, importStateStore,
+		state.ArchiveTrustOptions{Height: 1, Hash: blockStore.LoadBlock(1).Hash()})
+	require.ErrorContains(t, err, "invalid height 2: invalid seen commit")
+	require.EqualValues(t, 1, importBlockStore.Height())
+}
+
+func TestImportArchiveInvalidResults(t *testing.T) {
+	blockStore, stateStore, _ := makeRollbackChain(t, 3)
+	// without an app hash, the results must still be checked
+	tampered := tamperArchive(t, blockStore, stateStore, 3, 2, func(pb *cmtstate.ArchiveHeight) {
+		pb.FinalizeBlockResponse.AppHash = nil
+		pb.FinalizeBlockResponse.TxResults[0].Code = 1
+	})
+
+	importBlockStore := store.NewBlockStore(dbm.NewMemDB())
+	importStateStore := state.NewStore(dbm.NewMemDB(), state.StoreOptions{})
+	_, err := state.ImportArchive(tampered, importBlockStore, importStateStore,
+		state.ArchiveTrustOptions{Height: 1, Hash: blockStore.LoadBlock(1).Hash()})
+	require.ErrorContains(t, err, "invalid height 2: FinalizeBlock response results don't match the state")
+	require.EqualValues(t, 1, importBlockStore.Height())
+	_, err = importStateStore.LoadFinalizeBlockResponse(2)
+	require.Error(t, err)
+}
+
+// tamperArchive exports the heights 1 to the given height, and applies tamper
+// to the archived height at tamperHeight.
+func tamperArchive(
+	t *testing.T,
+	bs state.BlockStore,
+	ss state.Store,
+	height, tamperHeight int64,
+	tamper func(*cmtstate.ArchiveHeight),
+) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, state.ExportArchive(&buf, bs, ss, 1, height))
+
+	var tampered bytes.Buffer
+	r := protoio.NewDelimitedReader(&buf, buf.Len())
+	w := protoio.NewDelimitedWriter(&tampered)
+	var header cmtstate.ArchiveHeader
+	_, err := r.ReadMsg(&header)
+	require.NoError(t, err)
+	_, err = w.WriteMsg(&header)
+	require.NoError(t, err)
+	for h := int64(1); h <= height; h++ {
+		var pb cmtstate.ArchiveHeight
+		_, err := r.ReadMsg(&pb)
+		require.NoError(t, err)
+		if h == tamperHeight {
+			tamper(&pb)
+		}
+		_, err = w.WriteMsg(&pb)
+		require.NoError(t, err)
+	}
+	return &tampered
+}
@@ -0,0 +1,118 @@
+package state
+
+import (
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// storeCaches are the in-memory caches of a dbStore: an LRU cache of the
+// validator sets by height, and the latest state. They are disabled if the
+// cache size is 0.
+type storeCaches struct {
+	validators *lru.Cache[int64, *types.ValidatorSet]
+	metrics    *Metrics
+
+	mtx   cmtsync.Mutex
+	state *State
+}
+
+func newStoreCaches(options StoreOptions) *storeCaches {
+	c := &storeCaches{metrics: options.Metrics}
+	if c.metrics == nil {
+		c.metrics = NopMetrics()
+	}
+	if options.CacheSize > 0 {
+		var err error
+		// err can only occur if the size is non-positive.
+		c.validators, err = lru.New[int64, *types.ValidatorSet](options.CacheSize)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return c
+}
+
+func (c *storeCaches) enabled() bool {
+	return c.validators != nil
+}
+
+// loadState returns a copy of the latest state, if cached.
+func (c *storeCaches) loadState() (State, bool) {
+	if !c.enabled() {
+		return State{}, false
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.state == nil {
+		c.metrics.CacheMisses.With("cache", "state").Add(1)
+		return State{}, false
+	}
+	c.metrics.CacheHits.With("cache", "state").Add(1)
+	return c.state.Copy(), true
+}
+
+// initState caches state, loaded from the db, unless a state saved since then
+// is already cached.
+func (c *storeCaches) initState(state State) {
+	if !c.enabled() {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.state == nil {
+		stateCopy := state.Copy()
+		c.state = &stateCopy
+	}
+}
+
+// saveState caches state, just saved to the db.
+func (c *storeCaches) saveState(state State) {
+	if !c.enabled() {
+		return
+	}
+	stateCopy := state.Copy()
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.state = &stateCopy
+}
+
+// loadValidators returns a copy of the validator set of a height, if cached.
+func (c *storeCaches) loadValidators(height int64) (*types.ValidatorSet, bool) {
+	if !c.enabled() {
+		return nil, false
+	}
+	vals, ok := c.validators.Get(height)
+	if !ok {
+		c.metrics.CacheMisses.With("cache", "validators").Add(1)
+		return nil, false
+	}
+	c.metrics.CacheHits.With("cache", "validators").Add(1)
+	return vals.Copy(), true
+}
+
+func (c *storeCaches) addValidators(height int64, vals *types.ValidatorSet) {
+	if c.enabled() {
+		c.validators.Add(height, vals.Copy())
+	}
+}
+
+// removeValidators removes the validator sets of the heights from from to to,
+// exclusive, from the cache.
+func (c *storeCaches) removeValidators(from, to int64) {
+	if !c.enabled() {
+		return
+	}
+	if to-from <= int64(c.validators.Len()) {
+		for height := from; height < to; height++ {
+			c.validators.Remove(height)
+		}
+		return
+	}
+	for _, height := range c.validators.Keys() {
+		if height >= from && height < to {
+			c.validators.Remove(height)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	sm "github.com/cometbft/cometbft/state"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
 	"github.com/stretchr/testify/require"
 )
@@ -113,8 +114,29 @@ func TestLegacySaveAndLoadFinalizeBlock(t *testing.T) {
 	require.Equal(t, 1, len(legacyABCIResponses.BeginBlock.Events))
 	require.Equal(t, 1, len(legacyABCIResponses.EndBlock.Events))
 
+	// and with an ABCI Response missing fields
+	legacyABCIResponsesWithNullFields := newLegacyABCIResponsesWithNullFields()
+	require.Equal(t, 1, len(legacyABCIResponsesWithNullFields.DeliverTxs))
+	require.Equal(t, 1, len(legacyABCIResponsesWithNullFields.BeginBlock.Events))
+	require.Nil(t, legacyABCIResponsesWithNullFields.EndBlock)
+	err = multiStore.SaveABCIResponses(height+1, &legacyABCIResponsesWithNullFields)
+	require.NoError(t, err)
+
+	// the legacy responses are converted when loaded until the database is
+	// migrated
+	unmigratedResponseFinalizeBlock, err := multiStore.LoadFinalizeBlockResponse(height)
+	require.NoError(t, err)
+	unmigratedLastResponseFinalizeBlock, err := multiStore.LoadLastFinalizeBlockResponse(height + 1)
+	require.NoError(t, err)
+
+	// the legacy responses are converted by the migration of the database
+	from, err := sm.MigrateDB(stateDB, log.TestingLogger())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, from)
+
 	responseFinalizeBlock, err := multiStore.LoadFinalizeBlockResponse(height)
 	require.NoError(t, err)
+	require.Equal(t, unmigratedResponseFinalizeBlock, responseFinalizeBlock)
 
 	// Test for not nil
 	require.NotNil(t, responseFinalizeBlock.TxResults)
@@ -165,18 +187,50 @@ func TestLegacySaveAndLoadFinalizeBlock(t *testing.T) {
 
 	// try with an ABCI Response missing fields
 	height = int64(2)
-	legacyABCIResponses = newLegacyABCIResponsesWithNullFields()
-	require.Equal(t, 1, len(legacyABCIResponses.DeliverTxs))
-	require.Equal(t, 1, len(legacyABCIResponses.BeginBlock.Events))
-	require.Nil(t, legacyABCIResponses.EndBlock)
-	err = multiStore.SaveABCIResponses(height, &legacyABCIResponses)
-	require.NoError(t, err)
+	legacyABCIResponses = legacyABCIResponsesWithNullFields
 	responseFinalizeBlock, err = multiStore.LoadFinalizeBlockResponse(height)
 	require.NoError(t, err)
 
 	require.Equal(t, len(legacyABCIResponses.DeliverTxs), len(responseFinalizeBlock.TxResults))
 	require.Equal(t, legacyABCIResponses.DeliverTxs[0].String(), responseFinalizeBlock.TxResults[0].String())
 	require.Equal(t, len(legacyABCIResponses.BeginBlock.Events), len(responseFinalizeBlock.Events))
+
+	// the last response, kept for crash recovery, is converted as well
+	lastResponseFinalizeBlock, err := multiStore.LoadLastFinalizeBlockResponse(height)
+	require.NoError(t, err)
+	require.Equal(t, responseFinalizeBlock, lastResponseFinalizeBlock)
+	require.Equal(t, unmigratedLastResponseFinalizeBlock, lastResponseFinalizeBlock)
+}
+
+// TestMigrateLegacyABCIResponsesResumes tests that an interrupted migration of
+// the legacy ABCI responses doesn't convert the converted responses again.
+func TestMigrateLegacyABCIResponsesResumes(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	options := sm.StoreOptions{DiscardABCIResponses: false}
+	multiStore := NewMultiStore(stateDB, options, sm.NewStore(stateDB, options))
+
+	legacyABCIResponses := newLegacyABCIResponses()
+	require.NoError(t, multiStore.SaveABCIResponses(1, &legacyABCIResponses))
+	legacyABCIResponses = newLegacyABCIResponses()
+	require.NoError(t, multiStore.SaveABCIResponses(2, &legacyABCIResponses))
+
+	// height 1 was converted before the migration was interrupted
+	converted, err := stateDB.Get(calcABCIResponsesKey(2))
+	require.NoError(t, err)
+	require.NoError(t, stateDB.Set(calcABCIResponsesKey(1), []byte("converted")))
+	require.NoError(t, stateDB.Set([]byte("legacyABCIResponsesMigrationKey"), calcABCIResponsesKey(1)))
+
+	_, err = sm.MigrateDB(stateDB, log.TestingLogger())
+	require.NoError(t, err)
+	bz, err := stateDB.Get(calcABCIResponsesKey(1))
+	require.NoError(t, err)
+	require.Equal(t, []byte("converted"), bz)
+	bz, err = stateDB.Get(calcABCIResponsesKey(2))
+	require.NoError(t, err)
+	require.NotEqual(t, converted, bz)
+	resp, err := multiStore.LoadFinalizeBlockResponse(2)
+	require.NoError(t, err)
+	require.Len(t, resp.TxResults, 1)
 }
 
 // Generate a Legacy ABCIResponses with data for all fields.
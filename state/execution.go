@@ -50,6 +50,10 @@ type BlockExecutor struct {
 
 	// blockTimeTolerance is the maximum allowed difference between proposed block time and wall clock.
 	blockTimeTolerance time.Duration
+
+	// historyRetainHeights is the number of most recent heights for which
+	// validator sets and consensus params are kept when pruning.
+	historyRetainHeights int64
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
@@ -66,6 +70,14 @@ func BlockExecutorWithBlockTimeTolerance(d time.Duration) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithHistoryRetainHeights makes pruning keep the validator sets
+// and consensus params of the last n heights, even if their blocks are pruned.
+func BlockExecutorWithHistoryRetainHeights(n int64) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.historyRetainHeights = n
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -871,7 +883,12 @@ func (blockExec *BlockExecutor) pruneBlocks(retainHeight int64, state State) (ui
 		return 0, fmt.Errorf("failed to prune block store: %w", err)
 	}
 
-	err = blockExec.Store().PruneStates(base, retainHeight, prunedHeaderHeight)
+	historyThreshold := retainHeight
+	if blockExec.historyRetainHeights > 0 {
+		historyThreshold = state.LastBlockHeight + 1 - blockExec.historyRetainHeights
+	}
+
+	err = blockExec.Store().PruneStates(base, retainHeight, prunedHeaderHeight, historyThreshold)
 	if err != nil {
 		return 0, fmt.Errorf("failed to prune state store: %w", err)
 	}
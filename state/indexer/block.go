@@ -23,5 +23,9 @@ type BlockIndexer interface {
 	// event search criteria.
 	Search(ctx context.Context, q *query.Query) ([]int64, error)
 
+	// DeleteAboveHeight removes the blocks indexed above the given height,
+	// e.g. when rolling back the chain.
+	DeleteAboveHeight(height int64) error
+
 	SetLogger(l log.Logger)
 }
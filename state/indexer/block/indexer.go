@@ -7,6 +7,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/state/indexer"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	blockidxnull "github.com/cometbft/cometbft/state/indexer/block/null"
@@ -37,6 +38,11 @@ func IndexerFromConfigWithDisabledIndexers(cfg *config.Config, dbProvider config
 		if err != nil {
 			return nil, nil, false, err
 		}
+		// No logger is at hand, the migrations of the tx_index database
+		// aren't logged.
+		if _, err := kv.MigrateDB(store, log.NewNopLogger()); err != nil {
+			return nil, nil, false, err
+		}
 
 		return kv.NewTxIndex(store), blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events"))), false, nil
 
@@ -86,6 +86,37 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockEvents) error {
 	return batch.WriteSync()
 }
 
+// DeleteAboveHeight removes the blocks indexed above the given height, along
+// with the keys of their events. As the keys aren't ordered by height, the
+// whole index is scanned.
+func (idx *BlockerIndexer) DeleteAboveHeight(height int64) error {
+	it, err := idx.store.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := idx.store.NewBatch()
+	defer batch.Close()
+
+	for ; it.Valid(); it.Next() {
+		keyHeight, err := parseHeightFromKey(it.Key())
+		if err != nil {
+			continue
+		}
+		if keyHeight > height {
+			if err := batch.Delete(it.Key()); err != nil {
+				return err
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
 // Search performs a query for block heights that match a given FinalizeBlock
 // event search criteria. The given query can match against zero,
 // one or more block heights. In the case of height queries, i.e. block.height=H,
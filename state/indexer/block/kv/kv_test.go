@@ -142,6 +142,34 @@ func TestBlockIndexer(t *testing.T) {
 	}
 }
 
+func TestBlockIndexerDeleteAboveHeight(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	indexer := blockidxkv.New(store)
+
+	for h := int64(1); h <= 4; h++ {
+		require.NoError(t, indexer.Index(types.EventDataNewBlockEvents{
+			Height: h,
+			Events: []abci.Event{
+				{
+					Type:       "end_event",
+					Attributes: []abci.EventAttribute{{Key: "foo", Value: "100", Index: true}},
+				},
+			},
+		}))
+	}
+
+	require.NoError(t, indexer.DeleteAboveHeight(2))
+
+	for h := int64(1); h <= 4; h++ {
+		has, err := indexer.Has(h)
+		require.NoError(t, err)
+		require.Equal(t, h <= 2, has)
+	}
+	results, err := indexer.Search(context.Background(), query.MustCompile("end_event.foo = 100"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, results)
+}
+
 func TestBlockIndexerMulti(t *testing.T) {
 	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
 	indexer := blockidxkv.New(store)
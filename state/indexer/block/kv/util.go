@@ -61,6 +61,20 @@ func eventKey(compositeKey, eventValue string, height int64, eventSeq int64) ([]
 	)
 }
 
+// parseHeightFromKey returns the height of either a primary key or an event
+// key.
+func parseHeightFromKey(key []byte) (int64, error) {
+	var (
+		compositeKey string
+		height       int64
+	)
+	remaining, err := orderedcode.Parse(string(key), &compositeKey, &height)
+	if err == nil && len(remaining) == 0 && compositeKey == types.BlockHeightKey {
+		return height, nil
+	}
+	return parseHeightFromEventKey(key)
+}
+
 func parseValueFromPrimaryKey(key []byte) (string, error) {
 	var (
 		compositeKey string
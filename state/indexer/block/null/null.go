@@ -27,5 +27,9 @@ func (idx *BlockerIndexer) Search(context.Context, *query.Query) ([]int64, error
 	return []int64{}, nil
 }
 
+func (idx *BlockerIndexer) DeleteAboveHeight(int64) error {
+	return nil
+}
+
 func (idx *BlockerIndexer) SetLogger(log.Logger) {
 }
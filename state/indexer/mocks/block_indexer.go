@@ -19,6 +19,24 @@ type BlockIndexer struct {
 	mock.Mock
 }
 
+// DeleteAboveHeight provides a mock function with given fields: height
+func (_m *BlockIndexer) DeleteAboveHeight(height int64) error {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAboveHeight")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(height)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Has provides a mock function with given fields: height
 func (_m *BlockIndexer) Has(height int64) (bool, error) {
 	ret := _m.Called(height)
@@ -60,6 +60,13 @@ func (BackportTxIndexer) Search(context.Context, *query.Query) ([]*abci.TxResult
 	return nil, errors.New("the TxIndexer.Search method is not supported")
 }
 
+// DeleteAboveHeight removes the transactions indexed above the given height,
+// as part of TxIndexer. As transactions belong to blocks, the blocks above the
+// height are removed as well.
+func (b BackportTxIndexer) DeleteAboveHeight(height int64) error {
+	return b.psql.DeleteAboveHeight(height)
+}
+
 func (BackportTxIndexer) SetLogger(log.Logger) {}
 
 // BlockIndexer returns a bridge that implements the CometBFT v0.34 block
@@ -90,4 +97,10 @@ func (BackportBlockIndexer) Search(context.Context, *query.Query) ([]int64, erro
 	return nil, errors.New("the BlockIndexer.Search method is not supported")
 }
 
+// DeleteAboveHeight removes the blocks indexed above the given height, along
+// with their transactions, as part of BlockIndexer.
+func (b BackportBlockIndexer) DeleteAboveHeight(height int64) error {
+	return b.psql.DeleteAboveHeight(height)
+}
+
 func (BackportBlockIndexer) SetLogger(log.Logger) {}
@@ -253,6 +253,26 @@ func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
 	return nil
 }
 
+// DeleteAboveHeight removes the blocks indexed above the given height, along
+// with their transactions, events and attributes.
+func (es *EventSink) DeleteAboveHeight(height int64) error {
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		const blockIDs = `SELECT rowid FROM ` + tableBlocks + ` WHERE height > $1 AND chain_id = $2`
+		for _, query := range []string{
+			`DELETE FROM ` + tableAttributes + ` WHERE event_id IN
+  (SELECT rowid FROM ` + tableEvents + ` WHERE block_id IN (` + blockIDs + `));`,
+			`DELETE FROM ` + tableEvents + ` WHERE block_id IN (` + blockIDs + `);`,
+			`DELETE FROM ` + tableTxResults + ` WHERE block_id IN (` + blockIDs + `);`,
+			`DELETE FROM ` + tableBlocks + ` WHERE height > $1 AND chain_id = $2;`,
+		} {
+			if _, err := dbtx.Exec(query, height, es.chainID); err != nil {
+				return fmt.Errorf("deleting indexed blocks above height %d: %w", height, err)
+			}
+		}
+		return nil
+	})
+}
+
 // SearchBlockEvents is not implemented by this sink, and reports an error for all queries.
 func (es *EventSink) SearchBlockEvents(_ context.Context, _ *query.Query) ([]int64, error) {
 	return nil, errors.New("block search is not supported via the postgres event sink")
@@ -34,6 +34,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "validator_set_updates",
 			Help:      "ValidatorSetUpdates is the total number of times the application has updated the validator set since process start. metrics:Number of validator set updates returned by the application since process start.",
 		}, labels).With(labelsAndValues...),
+		CacheHits: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_hits",
+			Help:      "Number of lookups served by the in-memory caches of the state store, by cache.",
+		}, append(labels, "cache")).With(labelsAndValues...),
+		CacheMisses: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_misses",
+			Help:      "Number of lookups missed by the in-memory caches of the state store, by cache.",
+		}, append(labels, "cache")).With(labelsAndValues...),
 	}
 }
 
@@ -42,5 +54,7 @@ func NopMetrics() *Metrics {
 		BlockProcessingTime:   discard.NewHistogram(),
 		ConsensusParamUpdates: discard.NewCounter(),
 		ValidatorSetUpdates:   discard.NewCounter(),
+		CacheHits:             discard.NewCounter(),
+		CacheMisses:           discard.NewCounter(),
 	}
 }
@@ -26,4 +26,11 @@ type Metrics struct {
 	// updated the validator set since process start.
 	// metrics:Number of validator set updates returned by the application since process start.
 	ValidatorSetUpdates metrics.Counter
+
+	// Number of lookups served by the in-memory caches of the state store, by
+	// cache.
+	CacheHits metrics.Counter `metrics_labels:"cache"`
+	// Number of lookups missed by the in-memory caches of the state store, by
+	// cache.
+	CacheMisses metrics.Counter `metrics_labels:"cache"`
 }
@@ -52,6 +52,24 @@ func (_m *Store) Close() error {
 	return r0
 }
 
+// DeleteStatesAbove provides a mock function with given fields: _a0, _a1
+func (_m *Store) DeleteStatesAbove(_a0 int64, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStatesAbove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetOfflineStateSyncHeight provides a mock function with no fields
 func (_m *Store) GetOfflineStateSyncHeight() (int64, error) {
 	ret := _m.Called()
@@ -252,6 +270,71 @@ func (_m *Store) LoadLastFinalizeBlockResponse(_a0 int64) (*abcitypes.ResponseFi
 	return r0, r1
 }
 
+// LoadLastHeightsChanged provides a mock function with given fields: _a0
+func (_m *Store) LoadLastHeightsChanged(_a0 int64) (int64, int64, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadLastHeightsChanged")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int64) (int64, int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) int64); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int64) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// LoadValidatorSetChanges provides a mock function with given fields: from, to
+func (_m *Store) LoadValidatorSetChanges(from int64, to int64) ([]int64, error) {
+	ret := _m.Called(from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadValidatorSetChanges")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) ([]int64, error)); ok {
+		return rf(from, to)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) []int64); ok {
+		r0 = rf(from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LoadValidators provides a mock function with given fields: _a0
 func (_m *Store) LoadValidators(_a0 int64) (*types.ValidatorSet, error) {
 	ret := _m.Called(_a0)
@@ -282,17 +365,17 @@ func (_m *Store) LoadValidators(_a0 int64) (*types.ValidatorSet, error) {
 	return r0, r1
 }
 
-// PruneStates provides a mock function with given fields: _a0, _a1, _a2
-func (_m *Store) PruneStates(_a0 int64, _a1 int64, _a2 int64) error {
-	ret := _m.Called(_a0, _a1, _a2)
+// PruneStates provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *Store) PruneStates(_a0 int64, _a1 int64, _a2 int64, _a3 int64) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PruneStates")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int64, int64, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(int64, int64, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
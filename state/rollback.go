@@ -1,11 +1,13 @@
 package state
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
 )
 
@@ -124,3 +126,203 @@ func Rollback(bs BlockStore, ss Store, removeBlock bool) (int64, []byte, error)
 
 	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
 }
+
+// RollbackPlan describes what rolling back the state to a target height
+// removes.
+type RollbackPlan struct {
+	// Heights of the latest state before and after the rollback.
+	FromHeight int64
+	ToHeight   int64
+	// Heights of the first and last blocks removed from the block store, zero
+	// if no block is removed.
+	FirstRemovedBlock int64
+	LastRemovedBlock  int64
+	// Number of transactions committed in the heights rolled back, whose
+	// results are removed.
+	Txs int
+	// Evidence committed in the heights rolled back.
+	Evidence types.EvidenceList
+
+	// state rebuilt at the target height
+	state State
+}
+
+// PlanRollback returns what RollbackTo removes when rolling back to the given
+// height, without modifying the stores. The state at the given height is
+// rebuilt and checked against the blocks, so that an error is returned before
+// anything is removed.
+func PlanRollback(bs BlockStore, ss Store, height int64, removeBlocks bool) (RollbackPlan, error) {
+	latestState, err := loadRollbackState(bs, ss, height)
+	if err != nil {
+		return RollbackPlan{}, err
+	}
+	rolledBackState, err := loadStateAt(bs, ss, height, latestState.ChainID, latestState.InitialHeight)
+	if err != nil {
+		return RollbackPlan{}, err
+	}
+
+	plan := RollbackPlan{
+		FromHeight: latestState.LastBlockHeight,
+		ToHeight:   height,
+		state:      rolledBackState,
+	}
+	// Unless the blocks are removed, the block above the target height is kept,
+	// so that it is executed again once the node restarts.
+	firstRemovedBlock := height + 2
+	if removeBlocks {
+		firstRemovedBlock = height + 1
+	}
+	if firstRemovedBlock <= bs.Height() {
+		plan.FirstRemovedBlock, plan.LastRemovedBlock = firstRemovedBlock, bs.Height()
+	}
+
+	for h := height + 1; h <= latestState.LastBlockHeight; h++ {
+		block := bs.LoadBlock(h)
+		if block == nil {
+			return RollbackPlan{}, fmt.Errorf("block at height %d not found", h)
+		}
+		plan.Txs += len(block.Txs)
+		plan.Evidence = append(plan.Evidence, block.Evidence.Evidence...)
+	}
+	return plan, nil
+}
+
+// RollbackTo overwrites the current CometBFT state with the state at the given
+// height, rebuilt from the state and block stores, and removes what was stored
+// for the heights above it. Unless removeBlocks is true, the block above the
+// given height is kept, as with Rollback, and the ones above it are removed.
+// Returns the rolled back state.
+// Note that this function does not affect application state, nor the indexers
+// and the evidence pool.
+func RollbackTo(bs BlockStore, ss Store, height int64, removeBlocks bool) (State, error) {
+	plan, err := PlanRollback(bs, ss, height, removeBlocks)
+	if err != nil {
+		return State{}, err
+	}
+	rolledBackState := plan.state
+
+	// Persist the new state first, so that the state store is never above the
+	// block store.
+	if err := ss.Save(rolledBackState); err != nil {
+		return State{}, fmt.Errorf("failed to save rolled back state: %w", err)
+	}
+	if err := ss.DeleteStatesAbove(height, plan.FromHeight); err != nil {
+		return State{}, fmt.Errorf("failed to remove rolled back states: %w", err)
+	}
+	if plan.FirstRemovedBlock > 0 {
+		for h := plan.LastRemovedBlock; h >= plan.FirstRemovedBlock; h-- {
+			if err := bs.DeleteLatestBlock(); err != nil {
+				return State{}, fmt.Errorf("failed to remove block %d from blockstore: %w", h, err)
+			}
+		}
+	}
+
+	return rolledBackState, nil
+}
+
+// loadStateAt rebuilds the state at the given height from the state and block
+// stores. The block above the given height must be stored, as it holds the app
+// hash and last results hash of the state.
+func loadStateAt(bs BlockStore, ss Store, height int64, chainID string, initialHeight int64) (State, error) {
+	block := bs.LoadBlockMeta(height)
+	if block == nil {
+		return State{}, fmt.Errorf("block at height %d not found", height)
+	}
+	// The app hash and last results hash are only agreed upon in the
+	// following block.
+	nextBlock := bs.LoadBlockMeta(height + 1)
+	if nextBlock == nil {
+		return State{}, fmt.Errorf("block at height %d not found", height+1)
+	}
+
+	lastValidators, err := ss.LoadValidators(height)
+	if err != nil {
+		return State{}, err
+	}
+	validators, err := ss.LoadValidators(height + 1)
+	if err != nil {
+		return State{}, err
+	}
+	nextValidators, err := ss.LoadValidators(height + 2)
+	if err != nil {
+		return State{}, err
+	}
+	params, err := ss.LoadConsensusParams(height + 1)
+	if err != nil {
+		return State{}, err
+	}
+	valsChangeHeight, paramsChangeHeight, err := ss.LoadLastHeightsChanged(height)
+	if err != nil {
+		return State{}, err
+	}
+
+	// The stored validator sets and consensus params must be the ones the
+	// blocks were agreed upon with.
+	switch {
+	case !bytes.Equal(lastValidators.Hash(), block.Header.ValidatorsHash):
+		return State{}, fmt.Errorf("validators at height %d don't match the block", height)
+	case !bytes.Equal(validators.Hash(), nextBlock.Header.ValidatorsHash):
+		return State{}, fmt.Errorf("validators at height %d don't match the block", height+1)
+	case !bytes.Equal(nextValidators.Hash(), nextBlock.Header.NextValidatorsHash):
+		return State{}, fmt.Errorf("validators at height %d don't match the block at height %d", height+2, height+1)
+	case !bytes.Equal(params.Hash(), nextBlock.Header.ConsensusHash):
+		return State{}, fmt.Errorf("consensus params at height %d don't match the block", height+1)
+	}
+
+	return State{
+		Version: cmtstate.Version{
+			Consensus: cmtversion.Consensus{
+				Block: version.BlockProtocol,
+				// the app version of the next block is the one of the state
+				App: nextBlock.Header.Version.App,
+			},
+			Software: version.TMCoreSemVer,
+		},
+		// immutable fields
+		ChainID:       chainID,
+		InitialHeight: initialHeight,
+
+		LastBlockHeight: block.Header.Height,
+		LastBlockID:     block.BlockID,
+		LastBlockTime:   block.Header.Time,
+
+		NextValidators:              nextValidators,
+		Validators:                  validators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: valsChangeHeight,
+
+		ConsensusParams:                  params,
+		LastHeightConsensusParamsChanged: paramsChangeHeight,
+
+		LastResultsHash: nextBlock.Header.LastResultsHash,
+		AppHash:         nextBlock.Header.AppHash,
+	}, nil
+}
+
+// loadRollbackState loads the latest state, checking that it can be rolled
+// back to the given height.
+func loadRollbackState(bs BlockStore, ss Store, height int64) (State, error) {
+	latestState, err := ss.Load()
+	if err != nil {
+		return State{}, err
+	}
+	if latestState.IsEmpty() {
+		return State{}, errors.New("no state found")
+	}
+
+	// As in Rollback, the block store may be one block ahead of the state
+	// store.
+	if bs.Height() != latestState.LastBlockHeight && bs.Height() != latestState.LastBlockHeight+1 {
+		return State{}, fmt.Errorf("statestore height (%d) is not one below or equal to blockstore height (%d)",
+			latestState.LastBlockHeight, bs.Height())
+	}
+	if height < latestState.InitialHeight || height >= latestState.LastBlockHeight {
+		return State{}, fmt.Errorf("cannot roll back to height %d: must be at least %d and below %d",
+			height, latestState.InitialHeight, latestState.LastBlockHeight)
+	}
+	if height < bs.Base() {
+		return State{}, fmt.Errorf("cannot roll back to height %d: below the blockstore base height %d",
+			height, bs.Base())
+	}
+	return latestState, nil
+}
@@ -5,14 +5,21 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	dbm "github.com/cometbft/cometbft-db"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/mocks"
 	"github.com/cometbft/cometbft/store"
@@ -202,6 +209,135 @@ func TestRollbackHard(t *testing.T) {
 	require.Equal(t, rollbackHash, currState.AppHash)
 }
 
+func TestRollbackTo(t *testing.T) {
+	const (
+		chainHeight  int64 = 10
+		targetHeight int64 = 5
+	)
+	for _, removeBlocks := range []bool{false, true} {
+		blockStore, stateStore, states := makeRollbackChain(t, chainHeight)
+
+		plan, err := state.PlanRollback(blockStore, stateStore, targetHeight, removeBlocks)
+		require.NoError(t, err)
+		require.Equal(t, chainHeight, plan.FromHeight)
+		require.Equal(t, targetHeight, plan.ToHeight)
+		require.Equal(t, int(chainHeight-targetHeight)*10, plan.Txs)
+		require.Empty(t, plan.Evidence)
+		firstRemovedBlock := targetHeight + 2
+		if removeBlocks {
+			firstRemovedBlock = targetHeight + 1
+		}
+		require.Equal(t, firstRemovedBlock, plan.FirstRemovedBlock)
+		require.Equal(t, chainHeight, plan.LastRemovedBlock)
+		require.Equal(t, chainHeight, blockStore.Height(), "planning must not modify the stores")
+
+		rolledBackState, err := state.RollbackTo(blockStore, stateStore, targetHeight, removeBlocks)
+		require.NoError(t, err)
+		loadedState, err := stateStore.Load()
+		require.NoError(t, err)
+		require.Equal(t, rolledBackState, loadedState)
+		require.Equal(t, states[targetHeight].Bytes(), loadedState.Bytes())
+		require.EqualValues(t, 5, loadedState.LastHeightValidatorsChanged)
+
+		require.Equal(t, firstRemovedBlock-1, blockStore.Height())
+		_, err = stateStore.LoadValidators(targetHeight + 3)
+		require.Error(t, err)
+		_, err = stateStore.LoadFinalizeBlockResponse(targetHeight + 1)
+		require.Error(t, err)
+		_, err = stateStore.LoadFinalizeBlockResponse(targetHeight)
+		require.NoError(t, err)
+	}
+}
+
+func TestRollbackToInvalidHeight(t *testing.T) {
+	blockStore, stateStore, _ := makeRollbackChain(t, 3)
+
+	for _, height := range []int64{0, 3, 4} {
+		_, err := state.PlanRollback(blockStore, stateStore, height, false)
+		require.Error(t, err)
+		_, err = state.RollbackTo(blockStore, stateStore, height, false)
+		require.Error(t, err)
+	}
+}
+
+func TestRollbackToMismatchingValidators(t *testing.T) {
+	const (
+		chainHeight  int64 = 10
+		targetHeight int64 = 5
+	)
+	blockStore, stateStore, states := makeRollbackChain(t, chainHeight)
+
+	// overwrite the validators at the height above the target height
+	tampered := states[targetHeight-1].Copy()
+	tampered.NextValidators, _ = types.RandValidatorSet(2, 10)
+	tampered.LastHeightValidatorsChanged = targetHeight + 1
+	require.NoError(t, stateStore.Save(tampered))
+	require.NoError(t, stateStore.Save(states[chainHeight]))
+
+	_, err := state.PlanRollback(blockStore, stateStore, targetHeight, false)
+	require.ErrorContains(t, err, "don't match the block")
+	_, err = state.RollbackTo(blockStore, stateStore, targetHeight, false)
+	require.ErrorContains(t, err, "don't match the block")
+
+	// nothing is removed
+	require.Equal(t, chainHeight, blockStore.Height())
+	loadedState, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, chainHeight, loadedState.LastBlockHeight)
+	_, err = stateStore.LoadFinalizeBlockResponse(chainHeight)
+	require.NoError(t, err)
+}
+
+// makeRollbackChain commits a chain of the given height, whose validator set
+// changes at heights 3 and 7, and returns the stores and the state at each height.
+func makeRollbackChain(t *testing.T, height int64) (*store.BlockStore, state.Store, map[int64]state.State) {
+	t.Helper()
+
+	app := &testApp{AppHash: []byte("app_hash")}
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app), proxy.NopMetrics())
+	require.NoError(t, proxyApp.Start())
+	t.Cleanup(func() { _ = proxyApp.Stop() })
+
+	currState, stateDB, privVals := makeState(2, 1)
+	stateStore := state.NewStore(stateDB, state.StoreOptions{DiscardABCIResponses: false})
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	mp := &mpmocks.Mempool{}
+	mp.On("Lock").Return()
+	mp.On("Unlock").Return()
+	mp.On("FlushAppConn", mock.Anything).Return(nil)
+	mp.On("Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	blockExec := state.NewBlockExecutor(stateStore, log.NewNopLogger(), proxyApp.Consensus(), mp,
+		state.EmptyEvidencePool{}, blockStore)
+
+	states := map[int64]state.State{0: currState}
+	lastCommit := &types.Commit{}
+	for h := int64(1); h <= height; h++ {
+		app.ValidatorUpdates = nil
+		if h == 3 || h == 7 {
+			pk, err := cryptoenc.PubKeyToProto(currState.Validators.Validators[0].PubKey)
+			require.NoError(t, err)
+			app.ValidatorUpdates = []abci.ValidatorUpdate{{PubKey: pk, Power: 1000 * h}}
+		}
+
+		block, err := currState.MakeBlock(h, test.MakeNTxs(h, 10), lastCommit, nil,
+			currState.Validators.GetProposer().Address)
+		require.NoError(t, err)
+		partSet, err := block.MakePartSet(testPartSize)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+
+		currState, err = blockExec.ApplyBlock(currState, blockID, block)
+		require.NoError(t, err)
+		commit, _, err := makeValidCommit(h, blockID, currState.LastValidators, privVals)
+		require.NoError(t, err)
+		blockStore.SaveBlockWithExtendedCommit(block, partSet, commit)
+		states[h] = currState
+		lastCommit = commit.ToCommit()
+	}
+	return blockStore, stateStore, states
+}
+
 func TestRollbackNoState(t *testing.T) {
 	stateStore := state.NewStore(dbm.NewMemDB(),
 		state.StoreOptions{
@@ -0,0 +1,178 @@
+package state
+
+import (
+	"bytes"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/dbschema"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+)
+
+// dbSchema is the layout of the state database. A change of the layout must
+// come with a migration from the previous one.
+var dbSchema = dbschema.Schema{
+	Name: "state",
+	Migrations: []dbschema.Migration{
+		{
+			Version:     legacyABCIResponsesVersion,
+			Description: "convert the legacy ABCI responses to FinalizeBlock responses",
+			Migrate:     migrateLegacyABCIResponses,
+		},
+	},
+}
+
+// legacyABCIResponsesVersion is the schema version from which the legacy ABCI
+// responses are converted to FinalizeBlock responses.
+const legacyABCIResponsesVersion = 2
+
+// migrateBatchSize is the number of ABCI responses converted per batch.
+const migrateBatchSize = 1000
+
+var (
+	abciResponsesKeyPrefix = []byte("abciResponsesKey:")
+	// abciResponsesKeyEnd is the smallest key above the ones of the ABCI
+	// responses.
+	abciResponsesKeyEnd = []byte("abciResponsesKey;")
+	// legacyABCIResponsesMigrationKey holds the key of the last ABCI responses
+	// converted, so that an interrupted migration resumes after them rather
+	// than converting the converted responses again.
+	legacyABCIResponsesMigrationKey = []byte("legacyABCIResponsesMigrationKey")
+)
+
+// SchemaVersion returns the current schema version of the state database.
+func SchemaVersion() uint64 {
+	return dbSchema.Version()
+}
+
+// MigrateDB upgrades the state database db to the current schema version, and
+// returns the version it was at. Until it is called, the legacy ABCI responses
+// are converted when a Store loads them. Databases with a newer schema version
+// are refused.
+func MigrateDB(db dbm.DB, logger log.Logger) (uint64, error) {
+	return dbSchema.Migrate(db, logger)
+}
+
+// migrateLegacyABCIResponses rewrites the ABCI responses saved before v0.38,
+// made of the DeliverTx, BeginBlock and EndBlock responses, as FinalizeBlock
+// responses. The app hash of the converted responses is unknown and left
+// empty.
+func migrateLegacyABCIResponses(db dbm.DB) error {
+	start, err := db.Get(legacyABCIResponsesMigrationKey)
+	if err != nil {
+		return err
+	}
+	if start == nil {
+		start = abciResponsesKeyPrefix
+	} else {
+		// the smallest key above the last converted one
+		start = append(start, 0)
+	}
+	for {
+		keys, values, err := loadKeys(db, start, abciResponsesKeyEnd)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			break
+		}
+
+		batch := db.NewBatch()
+		for i, key := range keys {
+			resp, ok := responseFinalizeBlockFromLegacyBytes(values[i])
+			if !ok {
+				continue
+			}
+			bz, err := resp.Marshal()
+			if err != nil {
+				batch.Close()
+				return err
+			}
+			if err := batch.Set(key, bz); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		lastKey := keys[len(keys)-1]
+		if err := batch.Set(legacyABCIResponsesMigrationKey, lastKey); err != nil {
+			batch.Close()
+			return err
+		}
+		err = batch.WriteSync()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+		start = append(lastKey, 0)
+	}
+
+	// The last ABCI responses, kept for crash recovery, are converted in
+	// place, so running this again is harmless.
+	bz, err := db.Get(lastABCIResponseKey)
+	if err != nil || len(bz) == 0 {
+		return err
+	}
+	info := new(cmtstate.ABCIResponsesInfo)
+	if err := info.Unmarshal(bz); err != nil {
+		return err
+	}
+	if info.ResponseFinalizeBlock != nil || info.LegacyAbciResponses == nil {
+		return nil
+	}
+	info.ResponseFinalizeBlock = responseFinalizeBlockFromLegacy(info.LegacyAbciResponses)
+	info.LegacyAbciResponses = nil
+	bz, err = info.Marshal()
+	if err != nil {
+		return err
+	}
+	return db.SetSync(lastABCIResponseKey, bz)
+}
+
+// legacyABCIResponsesConverted returns whether the legacy ABCI responses of db
+// were converted by MigrateDB.
+func legacyABCIResponsesConverted(db dbm.DB) (bool, error) {
+	version, err := dbschema.LoadVersion(db)
+	if err != nil {
+		return false, err
+	}
+	return version >= legacyABCIResponsesVersion, nil
+}
+
+// responseFinalizeBlockFromLegacyBytes returns the FinalizeBlock response of
+// the given legacy ABCI responses, or false if they aren't legacy ones.
+//
+// The legacy responses can't be told apart from a FinalizeBlock response by
+// their encoding: they may be decoded as a FinalizeBlock response without an
+// error, but with zero values, including an empty app hash. This can be
+// verified in the /state/compatibility_test.go file.
+func responseFinalizeBlockFromLegacyBytes(bz []byte) (*abci.ResponseFinalizeBlock, bool) {
+	resp := new(abci.ResponseFinalizeBlock)
+	if err := resp.Unmarshal(bz); err == nil && resp.AppHash != nil {
+		return nil, false
+	}
+	legacyResp := new(cmtstate.LegacyABCIResponses)
+	if err := legacyResp.Unmarshal(bz); err != nil {
+		// Either a FinalizeBlock response with an empty app hash, or corrupted
+		// data, which is reported when it is loaded.
+		return nil, false
+	}
+	return responseFinalizeBlockFromLegacy(legacyResp), true
+}
+
+// loadKeys loads a batch of the keys from start to end, along with their
+// values.
+func loadKeys(db dbm.DB, start, end []byte) ([][]byte, [][]byte, error) {
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+	var keys, values [][]byte
+	for ; it.Valid() && len(keys) < migrateBatchSize; it.Next() {
+		keys = append(keys, bytes.Clone(it.Key()))
+		values = append(values, bytes.Clone(it.Value()))
+	}
+	return keys, values, it.Error()
+}
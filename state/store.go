@@ -63,6 +63,9 @@ type Store interface {
 	Load() (State, error)
 	// LoadValidators loads the validator set at a given height
 	LoadValidators(int64) (*types.ValidatorSet, error)
+	// LoadValidatorSetChanges returns the heights, within the given range, at
+	// which the validator set changed
+	LoadValidatorSetChanges(from, to int64) ([]int64, error)
 	// LoadFinalizeBlockResponse loads the abciResponse for a given height
 	LoadFinalizeBlockResponse(int64) (*abci.ResponseFinalizeBlock, error)
 	// LoadLastFinalizeBlockResponse loads the last abciResponse for a given height
@@ -76,7 +79,13 @@ type Store interface {
 	// Bootstrap is used for bootstrapping state when not starting from a initial height.
 	Bootstrap(State) error
 	// PruneStates takes the height from which to start pruning and which height stop at
-	PruneStates(int64, int64, int64) error
+	PruneStates(int64, int64, int64, int64) error
+	// LoadLastHeightsChanged loads the heights at which the validator set and
+	// the consensus params last changed, as of the state at a given height
+	LoadLastHeightsChanged(int64) (int64, int64, error)
+	// DeleteStatesAbove deletes what was stored for the states above a given
+	// height, up to a last height
+	DeleteStatesAbove(int64, int64) error
 	// Saves the height at which the store is bootstrapped after out of band statesync
 	SetOfflineStateSyncHeight(height int64) error
 	// Gets the height at which the store is bootstrapped after out of band statesync
@@ -90,6 +99,8 @@ type dbStore struct {
 	db dbm.DB
 
 	StoreOptions
+
+	caches *storeCaches
 }
 
 type StoreOptions struct {
@@ -98,6 +109,14 @@ type StoreOptions struct {
 	// the store will maintain only the response object from the latest
 	// height.
 	DiscardABCIResponses bool
+
+	// CacheSize is the number of validator sets kept in memory in an LRU
+	// cache, along with the latest state. 0 disables the caches. Only the
+	// store caching a db may write to it.
+	CacheSize int
+
+	// Metrics of the store caches, NopMetrics if nil.
+	Metrics *Metrics
 }
 
 var _ Store = (*dbStore)(nil)
@@ -112,7 +131,7 @@ func IsEmpty(store dbStore) (bool, error) {
 
 // NewStore creates the dbStore of the state pkg.
 func NewStore(db dbm.DB, options StoreOptions) Store {
-	return dbStore{db, options}
+	return dbStore{db, options, newStoreCaches(options)}
 }
 
 // LoadStateFromDBOrGenesisFile loads the most recent state from the database,
@@ -154,7 +173,15 @@ func (store dbStore) LoadFromDBOrGenesisDoc(genesisDoc *types.GenesisDoc) (State
 
 // LoadState loads the State from the database.
 func (store dbStore) Load() (State, error) {
-	return store.loadState(stateKey)
+	if state, ok := store.caches.loadState(); ok {
+		return state, nil
+	}
+	state, err := store.loadState(stateKey)
+	if err != nil {
+		return state, err
+	}
+	store.caches.initState(state)
+	return state, nil
 }
 
 func (store dbStore) loadState(key []byte) (state State, err error) {
@@ -185,7 +212,11 @@ func (store dbStore) loadState(key []byte) (state State, err error) {
 // Save persists the State, the ValidatorsInfo, and the ConsensusParamsInfo to the database.
 // This flushes the writes (e.g. calls SetSync).
 func (store dbStore) Save(state State) error {
-	return store.save(state, stateKey)
+	if err := store.save(state, stateKey); err != nil {
+		return err
+	}
+	store.caches.saveState(state)
+	return nil
 }
 
 func (store dbStore) save(state State, key []byte) error {
@@ -264,6 +295,7 @@ func (store dbStore) Bootstrap(state State) error {
 	if err := batch.WriteSync(); err != nil {
 		panic(err)
 	}
+	store.caches.saveState(state)
 
 	return batch.Close()
 }
@@ -272,11 +304,15 @@ func (store dbStore) Bootstrap(state State) error {
 // guaranteed to delete all states, since the last checkpointed state and states being pointed to by
 // e.g. `LastHeightChanged` must remain. The state at to must also exist.
 //
+// Validator sets at heights greater or equal to evidenceThresholdHeight or historyThresholdHeight
+// are kept, as they may be needed for evidence verification or history queries. Consensus params
+// are only kept at heights greater or equal to historyThresholdHeight.
+//
 // The from parameter is necessary since we can't do a key scan in a performant way due to the key
 // encoding not preserving ordering: https://github.com/tendermint/tendermint/issues/4567
 // This will cause some old states to be left behind when doing incremental partial prunes,
 // specifically older checkpoints and LastHeightChanged targets.
-func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight int64) error {
+func (store dbStore) PruneStates(from, to, evidenceThresholdHeight, historyThresholdHeight int64) error {
 	if from <= 0 || to <= 0 {
 		return fmt.Errorf("from height %v and to height %v must be greater than 0", from, to)
 	}
@@ -284,19 +320,22 @@ func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight i
 		return fmt.Errorf("from height %v must be lower than to height %v", from, to)
 	}
 
-	valInfo, err := loadValidatorsInfo(store.db, min(to, evidenceThresholdHeight))
+	paramsThreshold := min(max(historyThresholdHeight, from), to)
+	threshold := min(max(evidenceThresholdHeight, from), paramsThreshold)
+
+	valInfo, err := loadValidatorsInfo(store.db, threshold)
 	if err != nil {
-		return fmt.Errorf("validators at height %v not found: %w", to, err)
+		return fmt.Errorf("validators at height %v not found: %w", threshold, err)
 	}
-	paramsInfo, err := store.loadConsensusParamsInfo(to)
+	paramsInfo, err := store.loadConsensusParamsInfo(paramsThreshold)
 	if err != nil {
-		return fmt.Errorf("consensus params at height %v not found: %w", to, err)
+		return fmt.Errorf("consensus params at height %v not found: %w", paramsThreshold, err)
 	}
 
 	keepVals := make(map[int64]bool)
 	if valInfo.ValidatorSet == nil {
 		keepVals[valInfo.LastHeightChanged] = true
-		keepVals[lastStoredHeightFor(to, valInfo.LastHeightChanged)] = true // keep last checkpoint too
+		keepVals[lastStoredHeightFor(threshold, valInfo.LastHeightChanged)] = true // keep last checkpoint too
 	}
 	keepParams := make(map[int64]bool)
 	if paramsInfo.ConsensusParams.Equal(&cmtproto.ConsensusParams{}) {
@@ -338,7 +377,7 @@ func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight i
 					return err
 				}
 			}
-		} else if h < evidenceThresholdHeight {
+		} else if h < threshold {
 			err = batch.Delete(calcValidatorsKey(h))
 			if err != nil {
 				return err
@@ -371,7 +410,7 @@ func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight i
 					return err
 				}
 			}
-		} else {
+		} else if h < paramsThreshold {
 			err = batch.Delete(calcConsensusParamsKey(h))
 			if err != nil {
 				return err
@@ -400,7 +439,88 @@ func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight i
 	if err != nil {
 		return err
 	}
+	store.caches.removeValidators(from, to)
+
+	return nil
+}
+
+// LoadLastHeightsChanged returns the LastHeightValidatorsChanged and
+// LastHeightConsensusParamsChanged of the state at the given height, as
+// recorded with the validator set and consensus params it introduced.
+func (store dbStore) LoadLastHeightsChanged(height int64) (int64, int64, error) {
+	nextHeight := height + 1
+	valInfo, err := loadValidatorsInfo(store.db, nextHeight+1)
+	if err != nil {
+		return 0, 0, ErrNoValSetForHeight{nextHeight + 1}
+	}
+	paramsInfo, err := store.loadConsensusParamsInfo(nextHeight)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not find consensus params for height #%d: %w", nextHeight, err)
+	}
+	return valInfo.LastHeightChanged, paramsInfo.LastHeightChanged, nil
+}
+
+// DeleteStatesAbove deletes what was stored for the states above the given
+// height, up to lastHeight: their FinalizeBlock responses, and the validator
+// sets and consensus params they introduced. It is used to roll back the state,
+// and doesn't modify the latest state, which must be saved separately.
+//
+// Like in PruneStates, lastHeight is necessary since the key encoding doesn't
+// preserve ordering.
+func (store dbStore) DeleteStatesAbove(height int64, lastHeight int64) error {
+	if height <= 0 || height > lastHeight {
+		return fmt.Errorf("height %v must be greater than 0 and not greater than last height %v", height, lastHeight)
+	}
+
+	batch := store.db.NewBatch()
+	defer batch.Close()
+	deleted := uint64(0)
+
+	for h := lastHeight; h > height; h-- {
+		if err := batch.Delete(calcValidatorsKey(h + 2)); err != nil {
+			return err
+		}
+		if err := batch.Delete(calcConsensusParamsKey(h + 1)); err != nil {
+			return err
+		}
+		if err := batch.Delete(calcABCIResponsesKey(h)); err != nil {
+			return err
+		}
+		deleted++
+
+		// avoid batches growing too large by flushing to database regularly
+		if deleted%1000 == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = store.db.NewBatch()
+			defer batch.Close()
+		}
+	}
+
+	// The last FinalizeBlock response is kept for crash recovery, and must not
+	// be replayed above the height.
+	bz, err := store.db.Get(lastABCIResponseKey)
+	if err != nil {
+		return err
+	}
+	if len(bz) > 0 {
+		info := new(cmtstate.ABCIResponsesInfo)
+		if err := info.Unmarshal(bz); err != nil {
+			return err
+		}
+		if info.Height > height {
+			if err := batch.Delete(lastABCIResponseKey); err != nil {
+				return err
+			}
+		}
+	}
 
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	store.caches.removeValidators(height+3, lastHeight+3)
 	return nil
 }
 
@@ -430,28 +550,23 @@ func (store dbStore) LoadFinalizeBlockResponse(height int64) (*abci.ResponseFina
 		return nil, ErrNoABCIResponsesForHeight{height}
 	}
 
+	// The legacy ABCI responses are converted when the database is migrated,
+	// see MigrateDB. Until then, they are converted here.
+	converted, err := legacyABCIResponsesConverted(store.db)
+	if err != nil {
+		return nil, err
+	}
+	if !converted {
+		if resp, ok := responseFinalizeBlockFromLegacyBytes(buf); ok {
+			return resp, nil
+		}
+	}
+
 	resp := new(abci.ResponseFinalizeBlock)
-	err = resp.Unmarshal(buf)
-	// Check for an error or if the resp.AppHash is nil if so
-	// this means the unmarshalling should be a LegacyABCIResponses
-	// Depending on a source message content (serialized as ABCIResponses)
-	// there are instances where it can be deserialized as a FinalizeBlockResponse
-	// without causing an error. But the values will not be deserialized properly
-	// and, it will contain zero values, and one of them is an AppHash == nil
-	// This can be verified in the /state/compatibility_test.go file
-	if err != nil || resp.AppHash == nil {
-		// The data might be of the legacy ABCI response type, so
-		// we try to unmarshal that
-		legacyResp := new(cmtstate.LegacyABCIResponses)
-		if err := legacyResp.Unmarshal(buf); err != nil {
-			// only return an error, this method is only invoked through the `/block_results` not for state logic and
-			// some tests, so no need to exit cometbft if there's an error, just return it.
-			return nil, ErrABCIResponseCorruptedOrSpecChangeForHeight{Height: height, Err: err}
-		}
-		// The state store contains the old format. Migrate to
-		// the new ResponseFinalizeBlock format. Note that the
-		// new struct expects the AppHash which we don't have.
-		return responseFinalizeBlockFromLegacy(legacyResp), nil
+	if err := resp.Unmarshal(buf); err != nil {
+		// only return an error, this method is only invoked through the `/block_results` not for state logic and
+		// some tests, so no need to exit cometbft if there's an error, just return it.
+		return nil, ErrABCIResponseCorruptedOrSpecChangeForHeight{Height: height, Err: err}
 	}
 
 	// TODO: ensure that buf is completely read.
@@ -487,9 +602,9 @@ func (store dbStore) LoadLastFinalizeBlockResponse(height int64) (*abci.Response
 		return nil, fmt.Errorf("expected height %d but last stored abci responses was at height %d", height, info.GetHeight())
 	}
 
-	// It is possible if this is called directly after an upgrade that
+	// It is possible if the database wasn't migrated yet that
 	// ResponseFinalizeBlock is nil. In which case we use the legacy
-	// ABCI responses
+	// ABCI responses, see MigrateDB.
 	if info.ResponseFinalizeBlock == nil {
 		// sanity check
 		if info.LegacyAbciResponses == nil {
@@ -548,6 +663,9 @@ func (store dbStore) SaveFinalizeBlockResponse(height int64, resp *abci.Response
 // LoadValidators loads the ValidatorSet for a given height.
 // Returns ErrNoValSetForHeight if the validator set can't be found for this height.
 func (store dbStore) LoadValidators(height int64) (*types.ValidatorSet, error) {
+	if vals, ok := store.caches.loadValidators(height); ok {
+		return vals, nil
+	}
 	valInfo, err := loadValidatorsInfo(store.db, height)
 	if err != nil {
 		return nil, ErrNoValSetForHeight{height}
@@ -583,10 +701,52 @@ func (store dbStore) LoadValidators(height int64) (*types.ValidatorSet, error) {
 	if err != nil {
 		return nil, err
 	}
+	store.caches.addValidators(height, vip)
 
 	return vip, nil
 }
 
+// LoadValidatorSetChanges returns the heights in [from, to] at which the
+// validator set changed, in ascending order. It follows the LastHeightChanged
+// pointers stored with each height, so it only reads one entry per change.
+//
+// On a pruned store, the walk stops at the lowest retained height: the
+// changes below it are not returned.
+//
+// NOTE: pruning may record a retained height as a change, so consecutive
+// entries may refer to the same validator set.
+func (store dbStore) LoadValidatorSetChanges(from, to int64) ([]int64, error) {
+	if from <= 0 || from > to {
+		return nil, fmt.Errorf("invalid height range [%d, %d]", from, to)
+	}
+
+	var heights []int64
+	for h := to; h >= from; {
+		valInfo, err := loadValidatorsInfo(store.db, h)
+		if err != nil {
+			if h < to {
+				// h was pruned, the previous entry is the lowest retained one
+				break
+			}
+			return nil, ErrNoValSetForHeight{h}
+		}
+		changed := valInfo.LastHeightChanged
+		if changed > h {
+			return nil, fmt.Errorf("validator set at height %d changed at a later height %d", h, changed)
+		}
+		if changed < from {
+			break
+		}
+		heights = append(heights, changed)
+		h = changed - 1
+	}
+
+	for i, j := 0, len(heights)-1; i < j; i, j = i+1, j-1 {
+		heights[i], heights[j] = heights[j], heights[i]
+	}
+	return heights, nil
+}
+
 func lastStoredHeightFor(height, lastHeightChanged int64) int64 {
 	checkpointHeight := height - height%valSetCheckpointInterval
 	return cmtmath.MaxInt64(checkpointHeight, lastHeightChanged)
@@ -646,6 +806,8 @@ func (store dbStore) saveValidatorsInfo(height, lastHeightChanged int64, valSet
 	if err != nil {
 		return err
 	}
+	// the validator set is overwritten, e.g. after a rollback
+	store.caches.removeValidators(height, height+1)
 
 	return nil
 }
@@ -13,6 +13,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
@@ -90,29 +91,36 @@ func TestPruneStates(t *testing.T) {
 		pruneFrom               int64
 		pruneTo                 int64
 		evidenceThresholdHeight int64
+		historyThresholdHeight  int64
 		expectErr               bool
 		expectVals              []int64
 		expectParams            []int64
 		expectABCI              []int64
 	}{
-		"error on pruning from 0":      {100, 0, 5, 100, true, nil, nil, nil},
-		"error when from > to":         {100, 3, 2, 2, true, nil, nil, nil},
-		"error when from == to":        {100, 3, 3, 3, true, nil, nil, nil},
-		"error when to does not exist": {100, 1, 101, 101, true, nil, nil, nil},
-		"prune all":                    {100, 1, 100, 100, false, []int64{93, 100}, []int64{95, 100}, []int64{100}},
+		"error on pruning from 0":      {100, 0, 5, 100, 5, true, nil, nil, nil},
+		"error when from > to":         {100, 3, 2, 2, 2, true, nil, nil, nil},
+		"error when from == to":        {100, 3, 3, 3, 3, true, nil, nil, nil},
+		"error when to does not exist": {100, 1, 101, 101, 101, true, nil, nil, nil},
+		"prune all":                    {100, 1, 100, 100, 100, false, []int64{93, 100}, []int64{95, 100}, []int64{100}},
 		"prune some": {
-			10, 2, 8, 8, false,
+			10, 2, 8, 8, 8, false,
 			[]int64{1, 3, 8, 9, 10},
 			[]int64{1, 5, 8, 9, 10},
 			[]int64{1, 8, 9, 10},
 		},
 		"prune across checkpoint": {
-			100001, 1, 100001, 100001, false,
+			100001, 1, 100001, 100001, 100001, false,
 			[]int64{99993, 100000, 100001},
 			[]int64{99995, 100001},
 			[]int64{100001},
 		},
-		"prune when evidence height < height": {20, 1, 18, 17, false, []int64{13, 17, 18, 19, 20}, []int64{15, 18, 19, 20}, []int64{18, 19, 20}},
+		"prune when evidence height < height": {20, 1, 18, 17, 18, false, []int64{13, 17, 18, 19, 20}, []int64{15, 18, 19, 20}, []int64{18, 19, 20}},
+		"prune when history height < height": {
+			20, 1, 18, 18, 12, false,
+			[]int64{3, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+			[]int64{5, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+			[]int64{18, 19, 20},
+		},
 	}
 	for name, tc := range testcases {
 
@@ -120,6 +128,7 @@ func TestPruneStates(t *testing.T) {
 			db := dbm.NewMemDB()
 			stateStore := sm.NewStore(db, sm.StoreOptions{
 				DiscardABCIResponses: false,
+				CacheSize:            200,
 			})
 			pk := ed25519.GenPrivKey().PubKey()
 
@@ -171,8 +180,13 @@ func TestPruneStates(t *testing.T) {
 				require.NoError(t, err)
 			}
 
+			// the cached validator sets of the pruned heights must be removed
+			for h := int64(1); h <= min(tc.makeHeights, 200); h++ {
+				_, _ = stateStore.LoadValidators(h)
+			}
+
 			// Test assertions
-			err := stateStore.PruneStates(tc.pruneFrom, tc.pruneTo, tc.evidenceThresholdHeight)
+			err := stateStore.PruneStates(tc.pruneFrom, tc.pruneTo, tc.evidenceThresholdHeight, tc.historyThresholdHeight)
 			if tc.expectErr {
 				require.Error(t, err)
 				return
@@ -215,6 +229,113 @@ func TestPruneStates(t *testing.T) {
 	}
 }
 
+func TestStoreCachesLatestState(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{CacheSize: 10})
+	state, err := sm.MakeGenesisState(&types.GenesisDoc{
+		ChainID:    "test",
+		Validators: []types.GenesisValidator{{PubKey: ed25519.GenPrivKey().PubKey(), Power: 10}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, stateStore.Save(state))
+
+	loaded, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, state.Bytes(), loaded.Bytes())
+
+	// the cached state is a copy
+	loaded.LastBlockHeight = 10
+	loaded.Validators.Validators[0].VotingPower = 1
+	loaded, err = stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, state.Bytes(), loaded.Bytes())
+
+	// the saved states are cached
+	state.LastBlockHeight = 1
+	require.NoError(t, stateStore.Save(state))
+	loaded, err = stateStore.Load()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, loaded.LastBlockHeight)
+
+	// the validator sets deleted on rollback are removed from the cache
+	vals, err := stateStore.LoadValidators(3)
+	require.NoError(t, err)
+	require.NotNil(t, vals)
+	require.NoError(t, stateStore.DeleteStatesAbove(0+1, 1+1))
+	_, err = stateStore.LoadValidators(4)
+	require.Error(t, err)
+}
+
+func TestLoadValidatorSetChanges(t *testing.T) {
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	pk := ed25519.GenPrivKey().PubKey()
+	validator := &types.Validator{Address: pk.Address(), VotingPower: 100, PubKey: pk}
+	validatorSet := &types.ValidatorSet{
+		Validators: []*types.Validator{validator},
+		Proposer:   validator,
+	}
+
+	// Validators change for the heights following heights ending with 2.
+	valsChanged := int64(0)
+	for h := int64(1); h <= 30; h++ {
+		if valsChanged == 0 || h%10 == 2 {
+			valsChanged = h + 1
+		}
+		err := stateStore.Save(sm.State{
+			InitialHeight:   1,
+			LastBlockHeight: h - 1,
+			Validators:      validatorSet,
+			NextValidators:  validatorSet,
+			ConsensusParams: types.ConsensusParams{
+				Block: types.BlockParams{MaxBytes: 10e6},
+			},
+			LastHeightValidatorsChanged:      valsChanged,
+			LastHeightConsensusParamsChanged: 1,
+		})
+		require.NoError(t, err)
+	}
+
+	changes, err := stateStore.LoadValidatorSetChanges(1, 31)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 13, 23}, changes)
+
+	changes, err = stateStore.LoadValidatorSetChanges(4, 22)
+	require.NoError(t, err)
+	require.Equal(t, []int64{13}, changes)
+
+	_, err = stateStore.LoadValidatorSetChanges(5, 4)
+	require.Error(t, err)
+
+	// Pruning keeps the validator sets above the threshold...
+	require.NoError(t, stateStore.PruneStates(1, 25, 25, 20))
+	for h := int64(20); h < 25; h++ {
+		_, err := stateStore.LoadValidators(h)
+		require.NoError(t, err)
+	}
+	changes, err = stateStore.LoadValidatorSetChanges(20, 31)
+	require.NoError(t, err)
+	require.Equal(t, []int64{23}, changes)
+
+	// ...while still pruning the ones below it.
+	require.NoError(t, stateStore.PruneStates(25, 28, 28, 28))
+	for h := int64(25); h < 28; h++ {
+		_, err := stateStore.LoadValidators(h)
+		require.Equal(t, sm.ErrNoValSetForHeight{Height: h}, err)
+	}
+	changes, err = stateStore.LoadValidatorSetChanges(28, 31)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	// The walk stops at the lowest retained height.
+	changes, err = stateStore.LoadValidatorSetChanges(1, 31)
+	require.NoError(t, err)
+	require.Equal(t, []int64{13, 23}, changes)
+	for _, h := range changes {
+		_, err := stateStore.LoadValidators(h)
+		require.NoError(t, err)
+	}
+}
+
 func TestTxResultsHash(t *testing.T) {
 	txResults := []*abci.ExecTxResult{
 		{Code: 32, Data: []byte("Hello"), Log: "Huh?"},
@@ -343,6 +464,9 @@ func TestFinalizeBlockRecoveryUsingLegacyABCIResponses(t *testing.T) {
 	require.NoError(t, err)
 	// should keep this in parity with state/store.go
 	require.NoError(t, memDB.Set(lastABCIResponseKey, bz))
+	// the legacy responses are converted by the migration of the database
+	_, err = sm.MigrateDB(memDB, log.TestingLogger())
+	require.NoError(t, err)
 	stateStore := sm.NewStore(memDB, sm.StoreOptions{DiscardABCIResponses: false})
 	resp, err := stateStore.LoadLastFinalizeBlockResponse(height)
 	require.NoError(t, err)
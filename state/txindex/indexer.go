@@ -29,6 +29,10 @@ type TxIndexer interface {
 	// Search allows you to query for transactions.
 	Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error)
 
+	// DeleteAboveHeight removes the transactions indexed above the given
+	// height, e.g. when rolling back the chain.
+	DeleteAboveHeight(height int64) error
+
 	// Set Logger
 	SetLogger(l log.Logger)
 }
@@ -17,6 +17,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	idxutil "github.com/cometbft/cometbft/internal/indexer"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
@@ -198,6 +199,51 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 	return nil
 }
 
+// DeleteAboveHeight removes the transactions indexed above the given height,
+// along with the keys of their events. As the keys aren't ordered by height,
+// the whole index is scanned.
+func (txi *TxIndex) DeleteAboveHeight(height int64) error {
+	it, err := txi.store.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	b := txi.store.NewBatch()
+	defer b.Close()
+
+	for ; it.Valid(); it.Next() {
+		key, value := it.Key(), it.Value()
+
+		// event and height keys point to the hash of the transaction
+		if len(value) == tmhash.Size && isTagKey(key) {
+			if keyHeight, err := extractHeightFromKey(key); err == nil {
+				if keyHeight > height {
+					if err := b.Delete(key); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+		}
+
+		// the hash of the transaction points to its result
+		if len(key) == tmhash.Size {
+			txResult := new(abci.TxResult)
+			if err := proto.Unmarshal(value, txResult); err == nil && txResult.Height > height {
+				if err := b.Delete(key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	return b.WriteSync()
+}
+
 // Search performs a search using the given query.
 //
 // It breaks the query into conditions (like "tx.height > 5"). For each
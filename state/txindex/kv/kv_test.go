@@ -65,6 +65,38 @@ func TestTxIndex(t *testing.T) {
 	assert.True(t, proto.Equal(txResult2, loadedTxResult2))
 }
 
+func TestTxIndexDeleteAboveHeight(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	hashes := make(map[int64][]byte)
+	for h := int64(1); h <= 4; h++ {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{{Key: "number", Value: "1", Index: true}}},
+		})
+		txResult.Height = h
+		txResult.Tx = types.Tx(fmt.Sprintf("tx%d", h))
+		require.NoError(t, indexer.Index(txResult))
+		hashes[h] = types.Tx(txResult.Tx).Hash()
+	}
+
+	require.NoError(t, indexer.DeleteAboveHeight(2))
+
+	for h, hash := range hashes {
+		txResult, err := indexer.Get(hash)
+		require.NoError(t, err)
+		if h <= 2 {
+			require.NotNil(t, txResult)
+		} else {
+			require.Nil(t, txResult)
+		}
+	}
+	for _, q := range []string{"account.number = 1", "tx.height >= 1"} {
+		results, err := indexer.Search(context.Background(), query.MustCompile(q))
+		require.NoError(t, err)
+		require.Len(t, results, 2, q)
+	}
+}
+
 func TestTxSearch(t *testing.T) {
 	indexer := NewTxIndex(db.NewMemDB())
 
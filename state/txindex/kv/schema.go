@@ -0,0 +1,26 @@
+package kv
+
+import (
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/internal/dbschema"
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// dbSchema is the layout of the tx_index database, which holds the indexes of
+// both the transactions and the blocks. A change of the layout must come with
+// a migration from the previous one.
+var dbSchema = dbschema.Schema{Name: "tx_index"}
+
+// SchemaVersion returns the current schema version of the tx_index database.
+func SchemaVersion() uint64 {
+	return dbSchema.Version()
+}
+
+// MigrateDB upgrades the tx_index database db to the current schema version,
+// and returns the version it was at. It must be called before db is used by a
+// TxIndex or a block indexer. Databases with a newer schema version are
+// refused.
+func MigrateDB(db dbm.DB, logger log.Logger) (uint64, error) {
+	return dbSchema.Migrate(db, logger)
+}
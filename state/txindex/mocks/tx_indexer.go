@@ -38,6 +38,24 @@ func (_m *TxIndexer) AddBatch(b *txindex.Batch) error {
 	return r0
 }
 
+// DeleteAboveHeight provides a mock function with given fields: height
+func (_m *TxIndexer) DeleteAboveHeight(height int64) error {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAboveHeight")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(height)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: hash
 func (_m *TxIndexer) Get(hash []byte) (*types.TxResult, error) {
 	ret := _m.Called(hash)
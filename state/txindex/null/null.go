@@ -31,6 +31,11 @@ func (txi *TxIndex) Index(_ *abci.TxResult) error {
 	return nil
 }
 
+// DeleteAboveHeight is a noop and always returns nil.
+func (txi *TxIndex) DeleteAboveHeight(int64) error {
+	return nil
+}
+
 func (txi *TxIndex) Search(_ context.Context, _ *query.Query) ([]*abci.TxResult, error) {
 	return []*abci.TxResult{}, nil
 }
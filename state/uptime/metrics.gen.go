@@ -0,0 +1,51 @@
+// Code generated by metricsgen. DO NOT EDIT.
+
+package uptime
+
+import (
+	"github.com/go-kit/kit/metrics/discard"
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		ValidatorSignedBlocks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_signed_blocks",
+			Help:      "Number of blocks of the window signed by the local validator.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
+		ValidatorAbsentBlocks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_absent_blocks",
+			Help:      "Number of blocks of the window the local validator didn't sign.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
+		ValidatorNilBlocks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_nil_blocks",
+			Help:      "Number of blocks of the window the local validator precommitted nil for.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
+		ValidatorConsecutiveMissedBlocks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_consecutive_missed_blocks",
+			Help:      "Number of consecutive blocks missed by the local validator, up to the latest block.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
+	}
+}
+
+func NopMetrics() *Metrics {
+	return &Metrics{
+		ValidatorSignedBlocks:            discard.NewGauge(),
+		ValidatorAbsentBlocks:            discard.NewGauge(),
+		ValidatorNilBlocks:               discard.NewGauge(),
+		ValidatorConsecutiveMissedBlocks: discard.NewGauge(),
+	}
+}
@@ -0,0 +1,29 @@
+package uptime
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "uptime"
+)
+
+//go:generate go run ../../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of blocks of the window signed by the local validator.
+	ValidatorSignedBlocks metrics.Gauge `metrics_labels:"validator_address"`
+
+	// Number of blocks of the window the local validator didn't sign.
+	ValidatorAbsentBlocks metrics.Gauge `metrics_labels:"validator_address"`
+
+	// Number of blocks of the window the local validator precommitted nil for.
+	ValidatorNilBlocks metrics.Gauge `metrics_labels:"validator_address"`
+
+	// Number of consecutive blocks missed by the local validator, up to the
+	// latest block.
+	ValidatorConsecutiveMissedBlocks metrics.Gauge `metrics_labels:"validator_address"`
+}
@@ -0,0 +1,339 @@
+package uptime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	subscriber = "UptimeTracker"
+
+	// subscriptionCapacity is the number of new blocks buffered for the
+	// tracker before it is considered to have fallen behind.
+	subscriptionCapacity = 100
+)
+
+// ValidatorUptime counts the commit signatures of a validator over the window
+// of the latest heights.
+type ValidatorUptime struct {
+	Address cmtbytes.HexBytes `json:"address"`
+	// Blocks signed by the validator.
+	Signed int64 `json:"signed"`
+	// Blocks the validator didn't sign.
+	Absent int64 `json:"absent"`
+	// Blocks the validator precommitted nil for.
+	Nil int64 `json:"nil"`
+	// Latest height signed by the validator, zero if it didn't sign in the
+	// window.
+	LastSignedHeight int64 `json:"last_signed_height"`
+	// Number of consecutive blocks, up to the latest one, which the validator
+	// absent from or precommitted nil for.
+	ConsecutiveMissed int64 `json:"consecutive_missed"`
+}
+
+// heightSignatures are the flags of the commit signatures of the validators
+// at a height.
+type heightSignatures struct {
+	height     int64
+	addresses  []string
+	blockFlags []types.BlockIDFlag
+}
+
+// Tracker counts, for each validator, the blocks it signed, it was absent from
+// and it precommitted nil for over a sliding window of the latest heights.
+// The commits are read from the block store when the tracker starts, and then
+// from the new blocks.
+type Tracker struct {
+	service.BaseService
+
+	stateStore sm.Store
+	blockStore sm.BlockStore
+	eventBus   *types.EventBus
+	window     int64
+
+	// address of the local validator, if any
+	address types.Address
+	// consecutive blocks missed by the local validator before warning about
+	// it, 0 to never warn
+	missedBlocksWarnThreshold int64
+	metrics                   *Metrics
+
+	mtx        cmtsync.RWMutex
+	heights    []heightSignatures // ring buffer indexed by height % window
+	lastHeight int64
+	validators map[string]*ValidatorUptime
+}
+
+// TrackerOption sets an optional parameter on the Tracker.
+type TrackerOption func(*Tracker)
+
+// WithLocalValidator sets the address of the local validator, whose uptime is
+// reported in the metrics. A warning is logged whenever it misses a multiple
+// of missedBlocksWarnThreshold consecutive blocks, if positive.
+func WithLocalValidator(address types.Address, missedBlocksWarnThreshold int64) TrackerOption {
+	return func(t *Tracker) {
+		t.address = address
+		t.missedBlocksWarnThreshold = missedBlocksWarnThreshold
+	}
+}
+
+// WithMetrics sets the metrics.
+func WithMetrics(metrics *Metrics) TrackerOption {
+	return func(t *Tracker) { t.metrics = metrics }
+}
+
+// NewTracker returns a new tracker over a window of the given number of
+// heights, which must be positive.
+func NewTracker(
+	stateStore sm.Store,
+	blockStore sm.BlockStore,
+	eventBus *types.EventBus,
+	window int64,
+	options ...TrackerOption,
+) *Tracker {
+	t := &Tracker{
+		stateStore: stateStore,
+		blockStore: blockStore,
+		eventBus:   eventBus,
+		window:     window,
+		metrics:    NopMetrics(),
+		heights:    make([]heightSignatures, window),
+		validators: make(map[string]*ValidatorUptime),
+	}
+	for _, option := range options {
+		option(t)
+	}
+	t.BaseService = *service.NewBaseService(nil, "UptimeTracker", t)
+	return t
+}
+
+// OnStart implements service.Service by counting the commits of the window
+// stored in the block store, and then those of the new blocks.
+func (t *Tracker) OnStart() error {
+	// Subscribe before reading the block store, so that no block is missed.
+	blockSub, err := t.subscribe()
+	if err != nil {
+		return err
+	}
+	if err := t.addStoredCommits(); err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case <-blockSub.Canceled():
+				if !errors.Is(blockSub.Err(), cmtpubsub.ErrOutOfCapacity) {
+					return
+				}
+				// The tracker fell behind the new blocks. Subscribe again, and
+				// count the missed commits from the block store rather than
+				// slowing down the publishing of the blocks.
+				t.Logger.Info("uptime tracker fell behind the new blocks, catching up from the block store")
+				if blockSub, err = t.subscribe(); err != nil {
+					t.Logger.Error("failed to subscribe to new blocks", "err", err)
+					return
+				}
+				if err := t.addStoredCommits(); err != nil {
+					t.Logger.Error("failed to track stored commits", "err", err)
+				}
+			case msg := <-blockSub.Out():
+				block := msg.Data().(types.EventDataNewBlock).Block
+				if block.LastCommit == nil || block.LastCommit.Height == 0 {
+					continue
+				}
+				if err := t.AddCommit(block.LastCommit); err != nil {
+					t.Logger.Error("failed to track commit", "height", block.LastCommit.Height, "err", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// subscribe subscribes to the new blocks. The subscription is buffered, so
+// that a slow tracker never holds up the publishing of the blocks.
+func (t *Tracker) subscribe() (types.Subscription, error) {
+	return t.eventBus.Subscribe(context.Background(), subscriber, types.EventQueryNewBlock, subscriptionCapacity)
+}
+
+// addStoredCommits counts the commits of the window stored in the block store
+// which are above the latest counted height.
+func (t *Tracker) addStoredCommits() error {
+	t.mtx.RLock()
+	lastHeight := t.lastHeight
+	t.mtx.RUnlock()
+
+	// The commit of the latest block is only known once the next block is
+	// committed.
+	to := t.blockStore.Height() - 1
+	from := max(lastHeight+1, t.blockStore.Base(), to-t.window+1, 1)
+	for height := from; height <= to; height++ {
+		commit := t.blockStore.LoadBlockCommit(height)
+		if commit == nil {
+			continue
+		}
+		if err := t.AddCommit(commit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnStop implements service.Service by unsubscribing from the new blocks.
+func (t *Tracker) OnStop() {
+	if t.eventBus.IsRunning() {
+		_ = t.eventBus.UnsubscribeAll(context.Background(), subscriber)
+	}
+}
+
+// AddCommit counts the signatures of the given commit. Commits at or below the
+// latest counted height are ignored.
+func (t *Tracker) AddCommit(commit *types.Commit) error {
+	vals, err := t.stateStore.LoadValidators(commit.Height)
+	if err != nil {
+		return err
+	}
+	if len(commit.Signatures) != vals.Size() {
+		return fmt.Errorf("commit at height %d has %d signatures, but there are %d validators",
+			commit.Height, len(commit.Signatures), vals.Size())
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if commit.Height <= t.lastHeight {
+		return nil
+	}
+	t.evictUpTo(commit.Height)
+
+	hs := heightSignatures{
+		height:     commit.Height,
+		addresses:  make([]string, len(commit.Signatures)),
+		blockFlags: make([]types.BlockIDFlag, len(commit.Signatures)),
+	}
+	for i, sig := range commit.Signatures {
+		address := vals.Validators[i].Address
+		key := string(address)
+		hs.addresses[i], hs.blockFlags[i] = key, sig.BlockIDFlag
+
+		vu, ok := t.validators[key]
+		if !ok {
+			vu = &ValidatorUptime{Address: cmtbytes.HexBytes(address)}
+			t.validators[key] = vu
+		}
+		switch sig.BlockIDFlag {
+		case types.BlockIDFlagCommit:
+			vu.Signed++
+			vu.LastSignedHeight = commit.Height
+			vu.ConsecutiveMissed = 0
+		case types.BlockIDFlagNil:
+			vu.Nil++
+			vu.ConsecutiveMissed++
+		default:
+			vu.Absent++
+			vu.ConsecutiveMissed++
+		}
+	}
+	t.heights[commit.Height%t.window] = hs
+	t.lastHeight = commit.Height
+
+	t.reportLocalValidator(commit.Height)
+	return nil
+}
+
+// evictUpTo removes from the counters the heights which leave the window once
+// the given height is counted.
+func (t *Tracker) evictUpTo(height int64) {
+	if t.lastHeight == 0 || height-t.lastHeight >= t.window {
+		clear(t.heights)
+		t.validators = make(map[string]*ValidatorUptime)
+		return
+	}
+	for h := t.lastHeight + 1; h <= height; h++ {
+		hs := &t.heights[h%t.window]
+		for i, key := range hs.addresses {
+			vu := t.validators[key]
+			switch hs.blockFlags[i] {
+			case types.BlockIDFlagCommit:
+				vu.Signed--
+				if vu.LastSignedHeight == hs.height {
+					vu.LastSignedHeight = 0
+				}
+			case types.BlockIDFlagNil:
+				vu.Nil--
+			default:
+				vu.Absent--
+			}
+			if vu.Signed+vu.Nil+vu.Absent == 0 {
+				delete(t.validators, key)
+			}
+		}
+		*hs = heightSignatures{}
+	}
+}
+
+// reportLocalValidator updates the metrics of the local validator, and warns
+// if it has been missing blocks.
+func (t *Tracker) reportLocalValidator(height int64) {
+	if len(t.address) == 0 {
+		return
+	}
+	vu, ok := t.validators[string(t.address)]
+	if !ok {
+		return
+	}
+
+	label := []string{"validator_address", vu.Address.String()}
+	t.metrics.ValidatorSignedBlocks.With(label...).Set(float64(vu.Signed))
+	t.metrics.ValidatorAbsentBlocks.With(label...).Set(float64(vu.Absent))
+	t.metrics.ValidatorNilBlocks.With(label...).Set(float64(vu.Nil))
+	t.metrics.ValidatorConsecutiveMissedBlocks.With(label...).Set(float64(vu.ConsecutiveMissed))
+
+	if t.missedBlocksWarnThreshold > 0 && vu.ConsecutiveMissed > 0 &&
+		vu.ConsecutiveMissed%t.missedBlocksWarnThreshold == 0 {
+		t.Logger.Error("local validator missed consecutive blocks",
+			"height", height,
+			"missed", vu.ConsecutiveMissed,
+			"last_signed_height", vu.LastSignedHeight,
+		)
+	}
+}
+
+// Uptimes returns the uptimes of the validators over the heights from and to,
+// sorted by address. Only the validators which were in a validator set of the
+// window are included.
+func (t *Tracker) Uptimes() (from, to int64, uptimes []ValidatorUptime) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	if t.lastHeight == 0 {
+		return 0, 0, nil
+	}
+	uptimes = make([]ValidatorUptime, 0, len(t.validators))
+	for _, vu := range t.validators {
+		uptimes = append(uptimes, *vu)
+	}
+	sort.Slice(uptimes, func(i, j int) bool {
+		return bytes.Compare(uptimes[i].Address, uptimes[j].Address) < 0
+	})
+
+	from = t.lastHeight - t.window + 1
+	for h := max(from, 1); h <= t.lastHeight; h++ {
+		if t.heights[h%t.window].height == h {
+			from = h
+			break
+		}
+	}
+	return from, t.lastHeight, uptimes
+}
@@ -0,0 +1,105 @@
+package uptime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	absent = types.BlockIDFlagAbsent
+	signed = types.BlockIDFlagCommit
+	voted  = types.BlockIDFlagNil
+)
+
+func TestTrackerCountsSignatures(t *testing.T) {
+	vals, _ := types.RandValidatorSet(3, 10)
+	stateStore := mocks.NewStore(t)
+	stateStore.On("LoadValidators", mock.Anything).Return(vals, nil)
+	tracker := NewTracker(stateStore, mocks.NewBlockStore(t), nil, 3)
+
+	flags := [][]types.BlockIDFlag{
+		{signed, signed, absent},
+		{signed, voted, absent},
+		{signed, signed, absent},
+		{absent, signed, signed},
+	}
+	for i, f := range flags {
+		require.NoError(t, tracker.AddCommit(makeCommit(int64(i+1), f)))
+	}
+	// commits at or below the latest height are ignored
+	require.NoError(t, tracker.AddCommit(makeCommit(2, []types.BlockIDFlag{absent, absent, absent})))
+
+	from, to, uptimes := tracker.Uptimes()
+	assert.EqualValues(t, 2, from)
+	assert.EqualValues(t, 4, to)
+	want := map[string]ValidatorUptime{
+		vals.Validators[0].Address.String(): {Signed: 2, Absent: 1, LastSignedHeight: 3, ConsecutiveMissed: 1},
+		vals.Validators[1].Address.String(): {Signed: 2, Nil: 1, LastSignedHeight: 4},
+		vals.Validators[2].Address.String(): {Signed: 1, Absent: 2, LastSignedHeight: 4},
+	}
+	require.Len(t, uptimes, 3)
+	for _, vu := range uptimes {
+		w := want[vu.Address.String()]
+		w.Address = vu.Address
+		assert.Equal(t, w, vu)
+	}
+
+	// a gap larger than the window resets the counts
+	require.NoError(t, tracker.AddCommit(makeCommit(10, []types.BlockIDFlag{absent, signed, signed})))
+	from, to, uptimes = tracker.Uptimes()
+	assert.EqualValues(t, 10, from)
+	assert.EqualValues(t, 10, to)
+	for _, vu := range uptimes {
+		assert.EqualValues(t, 1, vu.Signed+vu.Absent+vu.Nil)
+	}
+}
+
+func TestTrackerRejectsMismatchingCommit(t *testing.T) {
+	vals, _ := types.RandValidatorSet(3, 10)
+	stateStore := mocks.NewStore(t)
+	stateStore.On("LoadValidators", mock.Anything).Return(vals, nil)
+	tracker := NewTracker(stateStore, mocks.NewBlockStore(t), nil, 3)
+
+	assert.Error(t, tracker.AddCommit(makeCommit(1, []types.BlockIDFlag{signed})))
+}
+
+func TestTrackerAddsStoredCommitsAboveLastHeight(t *testing.T) {
+	vals, _ := types.RandValidatorSet(1, 10)
+	stateStore := mocks.NewStore(t)
+	stateStore.On("LoadValidators", mock.Anything).Return(vals, nil)
+	blockStore := mocks.NewBlockStore(t)
+	blockStore.On("Height").Return(int64(6))
+	blockStore.On("Base").Return(int64(1))
+	// only the commits above the latest counted height are loaded
+	for _, height := range []int64{4, 5} {
+		blockStore.On("LoadBlockCommit", height).Return(makeCommit(height, []types.BlockIDFlag{signed})).Once()
+	}
+	tracker := NewTracker(stateStore, blockStore, nil, 10)
+
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, tracker.AddCommit(makeCommit(height, []types.BlockIDFlag{absent})))
+	}
+	require.NoError(t, tracker.addStoredCommits())
+
+	from, to, uptimes := tracker.Uptimes()
+	assert.EqualValues(t, 1, from)
+	assert.EqualValues(t, 5, to)
+	require.Len(t, uptimes, 1)
+	assert.EqualValues(t, 2, uptimes[0].Signed)
+	assert.EqualValues(t, 3, uptimes[0].Absent)
+}
+
+func makeCommit(height int64, flags []types.BlockIDFlag) *types.Commit {
+	sigs := make([]types.CommitSig, len(flags))
+	for i, flag := range flags {
+		sigs[i] = types.CommitSig{BlockIDFlag: flag, Timestamp: time.Now()}
+	}
+	return &types.Commit{Height: height, Signatures: sigs}
+}
@@ -0,0 +1,73 @@
+package store
+
+import (
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// defaultCacheSize is the number of block metas and commits cached by a
+// BlockStore created without WithCacheSizes.
+const defaultCacheSize = 100
+
+// WithCacheSizes sets the number of block metas and of each kind of commit
+// kept in memory in LRU caches to size, and the number of recent blocks to
+// blockSize. A size of 0 disables the caches.
+func WithCacheSizes(size, blockSize int) BlockStoreOption {
+	return func(bs *BlockStore) {
+		bs.cacheSize = size
+		bs.blockCacheSize = blockSize
+	}
+}
+
+// WithMetrics sets the metrics of the BlockStore.
+func WithMetrics(metrics *Metrics) BlockStoreOption {
+	return func(bs *BlockStore) {
+		bs.metrics = metrics
+	}
+}
+
+// heightCache is an LRU cache of the items of the heights, which counts its
+// hits and misses. A cache of size 0 caches nothing.
+type heightCache[V any] struct {
+	name    string
+	lru     *lru.Cache[int64, V]
+	metrics *Metrics
+}
+
+func newHeightCache[V any](name string, size int, metrics *Metrics) *heightCache[V] {
+	c := &heightCache[V]{name: name, metrics: metrics}
+	if size > 0 {
+		var err error
+		// err can only occur if the size is non-positive.
+		c.lru, err = lru.New[int64, V](size)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return c
+}
+
+func (c *heightCache[V]) Get(height int64) (V, bool) {
+	if c.lru == nil {
+		var zero V
+		return zero, false
+	}
+	v, ok := c.lru.Get(height)
+	if ok {
+		c.metrics.CacheHits.With("cache", c.name).Add(1)
+	} else {
+		c.metrics.CacheMisses.With("cache", c.name).Add(1)
+	}
+	return v, ok
+}
+
+func (c *heightCache[V]) Add(height int64, v V) {
+	if c.lru != nil {
+		c.lru.Add(height, v)
+	}
+}
+
+func (c *heightCache[V]) Remove(height int64) {
+	if c.lru != nil {
+		c.lru.Remove(height)
+	}
+}
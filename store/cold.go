@@ -0,0 +1,321 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/dbschema"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+)
+
+// ColdDBName is the name of the database of the cold tier of the block store.
+const ColdDBName = "blockstore-cold"
+
+// coldMoveBatchSize is the maximum number of blocks moved to the cold store at
+// once, so that a block store whose cold tier was just enabled catches up
+// gradually, without holding off the pruning and the rollbacks.
+const coldMoveBatchSize = 10
+
+// coldHeightKey is the key of the hot store holding the last height moved to
+// the cold store.
+var coldHeightKey = []byte("coldHeight")
+
+// BlockStoreOption configures a BlockStore.
+type BlockStoreOption func(*BlockStore)
+
+// WithColdStore makes the BlockStore move the blocks older than depth heights
+// below the latest one to db, a cold store, e.g. on a cheaper disk. The blocks
+// are moved in the background once a block is saved, and are loaded from
+// either store. The blocks of the cold store are only deleted when they are
+// pruned or rolled back. With a depth of 0, no block is moved, but the ones
+// moved before are still loaded from db.
+//
+// db must be at the schema version of the block store database, see
+// MigrateColdDB.
+func WithColdStore(db dbm.DB, depth int64) BlockStoreOption {
+	return func(bs *BlockStore) {
+		bs.cold = db
+		bs.coldDepth = depth
+	}
+}
+
+// WithLogger sets the logger of the BlockStore, which reports the blocks which
+// failed to be moved to the cold store.
+func WithLogger(logger log.Logger) BlockStoreOption {
+	return func(bs *BlockStore) {
+		bs.logger = logger
+	}
+}
+
+// MigrateColdDB upgrades the cold store database db to the schema version of
+// the block store database hotDB, which must have been migrated first, so
+// that both use the same key layout. Returns the version db was at.
+func MigrateColdDB(db, hotDB dbm.DB, logger log.Logger) (uint64, error) {
+	version, err := dbschema.LoadVersion(hotDB)
+	if err != nil {
+		return 0, err
+	}
+	if version == 0 {
+		return 0, errors.New("block store database must be migrated before the cold store one")
+	}
+	coldSchema := dbSchema
+	coldSchema.Name = ColdDBName
+	return coldSchema.MigrateTo(db, version, logger)
+}
+
+// LoadBlockStore opens the block store database of config with dbProvider,
+// along with the one of its cold tier if it's enabled or was enabled before,
+// upgrades their schemas, and returns the BlockStore with the given options
+// and the cache sizes of config.
+func LoadBlockStore(
+	config *cfg.Config,
+	dbProvider cfg.DBProvider,
+	logger log.Logger,
+	options ...BlockStoreOption,
+) (*BlockStore, error) {
+	db, err := dbProvider(&cfg.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	// Upgrade the layouts of the databases before they are used.
+	if _, err := MigrateDB(db, config.Storage.BlockStoreKeyLayout, logger); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	options = append([]BlockStoreOption{
+		WithCacheSizes(config.Storage.CacheSize, config.Storage.BlockCacheSize),
+		WithLogger(logger),
+	}, options...)
+	depth := config.Storage.ColdBlockStoreDepth
+	if depth == 0 && !cmtos.FileExists(filepath.Join(config.DBDir(), ColdDBName+".db")) {
+		return NewBlockStore(db, options...), nil
+	}
+	coldDB, err := dbProvider(&cfg.DBContext{ID: ColdDBName, Config: config})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	if _, err := MigrateColdDB(coldDB, db, logger); err != nil {
+		db.Close()
+		coldDB.Close()
+		return nil, err
+	}
+	return NewBlockStore(db, append(options, WithColdStore(coldDB, depth))...), nil
+}
+
+// get loads the value of key from the hot store, or from the cold store if
+// it's missing.
+func (bs *BlockStore) get(key []byte) ([]byte, error) {
+	bz, err := bs.db.Get(key)
+	if err != nil || len(bz) > 0 || bs.cold == nil {
+		return bz, err
+	}
+	return bs.cold.Get(key)
+}
+
+// newBatch returns a batch of the hot store, whose deletions also apply to
+// the cold store, if any.
+func (bs *BlockStore) newBatch() dbm.Batch {
+	if bs.cold == nil {
+		return bs.db.NewBatch()
+	}
+	return &tieredBatch{Batch: bs.db.NewBatch(), cold: bs.cold.NewBatch()}
+}
+
+// dbs returns the hot store, followed by the cold store if any.
+func (bs *BlockStore) dbs() []dbm.DB {
+	if bs.cold == nil {
+		return []dbm.DB{bs.db}
+	}
+	return []dbm.DB{bs.db, bs.cold}
+}
+
+// startColdMoves starts the routine moving the blocks to the cold store, if
+// they are moved.
+func (bs *BlockStore) startColdMoves() {
+	if bs.cold == nil || bs.coldDepth <= 0 {
+		return
+	}
+	bs.coldMoves = make(chan struct{}, 1)
+	bs.coldQuit = make(chan struct{})
+	bs.coldDone = make(chan struct{})
+	go bs.coldMoveRoutine()
+}
+
+// stopColdMoves stops the routine moving the blocks to the cold store, if
+// started, once the blocks being moved are.
+func (bs *BlockStore) stopColdMoves() {
+	if bs.coldQuit == nil {
+		return
+	}
+	close(bs.coldQuit)
+	<-bs.coldDone
+	bs.coldQuit = nil
+}
+
+// signalColdMoves signals the routine moving the blocks to the cold store, if
+// started, that a block was saved.
+func (bs *BlockStore) signalColdMoves() {
+	if bs.coldMoves == nil {
+		return
+	}
+	select {
+	case bs.coldMoves <- struct{}{}:
+	default:
+	}
+}
+
+// coldMoveRoutine moves the blocks to the cold store whenever a block is
+// saved, so that the moves are kept off the path of the commits. The moves
+// which fail are logged, and attempted again once the next block is saved.
+func (bs *BlockStore) coldMoveRoutine() {
+	defer close(bs.coldDone)
+	for {
+		select {
+		case <-bs.coldQuit:
+			return
+		case <-bs.coldMoves:
+		}
+		for {
+			moved, err := bs.moveToColdStore(bs.Height())
+			if err != nil {
+				bs.logger.Error("Failed to move blocks to the cold store", "err", err)
+				break
+			}
+			if moved == 0 {
+				break
+			}
+			select {
+			case <-bs.coldQuit:
+				return
+			default:
+			}
+		}
+	}
+}
+
+// moveToColdStore moves the blocks older than the cold store depth below the
+// given height, up to coldMoveBatchSize of them, from the hot store to the
+// cold store, and returns the number of heights moved. The blocks are written
+// to the cold store before they are deleted from the hot one, along with the
+// last moved height, so that an interrupted move is run again.
+func (bs *BlockStore) moveToColdStore(height int64) (int64, error) {
+	bs.tierMtx.Lock()
+	defer bs.tierMtx.Unlock()
+
+	from := max(bs.coldHeight+1, bs.Base())
+	to := min(height-bs.coldDepth, from+coldMoveBatchSize-1)
+	if from <= 0 || to < from {
+		return 0, nil
+	}
+
+	coldBatch := bs.cold.NewBatch()
+	defer coldBatch.Close()
+	hotBatch := bs.db.NewBatch()
+	defer hotBatch.Close()
+	for h := from; h <= to; h++ {
+		for _, key := range bs.blockKeys(h) {
+			bz, err := bs.db.Get(key)
+			if err != nil {
+				return 0, err
+			}
+			if len(bz) == 0 {
+				continue
+			}
+			if err := coldBatch.Set(key, bz); err != nil {
+				return 0, err
+			}
+			if err := hotBatch.Delete(key); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if err := hotBatch.Set(coldHeightKey, []byte(strconv.FormatInt(to, 10))); err != nil {
+		return 0, err
+	}
+	if err := coldBatch.WriteSync(); err != nil {
+		return 0, fmt.Errorf("failed to write blocks %d to %d to the cold store: %w", from, to, err)
+	}
+	if err := hotBatch.WriteSync(); err != nil {
+		return 0, fmt.Errorf("failed to delete blocks %d to %d from the hot store: %w", from, to, err)
+	}
+	bs.coldHeight = to
+	return to - from + 1, nil
+}
+
+// blockKeys returns the keys of the block, commits and block parts of a
+// height.
+func (bs *BlockStore) blockKeys(height int64) [][]byte {
+	keys := [][]byte{
+		bs.keys.blockCommitKey(height),
+		bs.keys.seenCommitKey(height),
+		bs.keys.extCommitKey(height),
+	}
+	if meta := bs.LoadBlockMeta(height); meta != nil {
+		keys = append(keys, bs.keys.blockHashKey(meta.BlockID.Hash))
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			keys = append(keys, bs.keys.blockPartKey(height, p))
+		}
+	}
+	return append(keys, bs.keys.blockMetaKey(height))
+}
+
+// loadColdHeight returns the last height moved to the cold store, or 0.
+func loadColdHeight(db dbm.DB) int64 {
+	bz, err := db.Get(coldHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0
+	}
+	height, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("failed to extract cold height from %s: %v", bz, err))
+	}
+	return height
+}
+
+// tieredBatch is a batch of the hot store, whose deletions also apply to the
+// cold store, so that the blocks pruned or rolled back are deleted from
+// either store.
+type tieredBatch struct {
+	dbm.Batch
+	cold dbm.Batch
+}
+
+func (b *tieredBatch) Delete(key []byte) error {
+	if err := b.cold.Delete(key); err != nil {
+		return err
+	}
+	return b.Batch.Delete(key)
+}
+
+func (b *tieredBatch) Write() error {
+	if err := b.cold.Write(); err != nil {
+		return err
+	}
+	return b.Batch.Write()
+}
+
+func (b *tieredBatch) WriteSync() error {
+	if err := b.cold.WriteSync(); err != nil {
+		return err
+	}
+	return b.Batch.WriteSync()
+}
+
+func (b *tieredBatch) Close() error {
+	coldErr := b.cold.Close()
+	if err := b.Batch.Close(); err != nil {
+		return err
+	}
+	return coldErr
+}
@@ -0,0 +1,221 @@
+package store
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/orderedcode"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+// The layouts of the keys of the block store database.
+const (
+	// KeyLayoutV1 encodes the heights of the keys as decimal strings, e.g.
+	// "H:12", so that the keys aren't sorted by height.
+	KeyLayoutV1 = "v1"
+	// KeyLayoutV2 encodes the keys with orderedcode, so that the keys of each
+	// kind are sorted by height, and ranges of heights can be iterated over
+	// and deleted.
+	KeyLayoutV2 = "v2"
+)
+
+// keyLayoutV2Version is the schema version of the block store databases with
+// the v2 key layout.
+const keyLayoutV2Version = 2
+
+// keyLayout builds the keys of the block store database.
+type keyLayout interface {
+	blockMetaKey(height int64) []byte
+	blockPartKey(height int64, partIndex int) []byte
+	blockCommitKey(height int64) []byte
+	seenCommitKey(height int64) []byte
+	extCommitKey(height int64) []byte
+	blockHashKey(hash []byte) []byte
+	// ordered reports whether the keys of each kind are sorted by height.
+	ordered() bool
+}
+
+// keyLayoutForVersion returns the key layout of a database at the given schema
+// version.
+func keyLayoutForVersion(version uint64) keyLayout {
+	if version >= keyLayoutV2Version {
+		return v2KeyLayout{}
+	}
+	return v1KeyLayout{}
+}
+
+type v1KeyLayout struct{}
+
+func (v1KeyLayout) blockMetaKey(height int64) []byte { return calcBlockMetaKey(height) }
+
+func (v1KeyLayout) blockPartKey(height int64, partIndex int) []byte {
+	return calcBlockPartKey(height, partIndex)
+}
+
+func (v1KeyLayout) blockCommitKey(height int64) []byte { return calcBlockCommitKey(height) }
+
+func (v1KeyLayout) seenCommitKey(height int64) []byte { return calcSeenCommitKey(height) }
+
+func (v1KeyLayout) extCommitKey(height int64) []byte { return calcExtCommitKey(height) }
+
+func (v1KeyLayout) blockHashKey(hash []byte) []byte { return calcBlockHashKey(hash) }
+
+func (v1KeyLayout) ordered() bool { return false }
+
+// The prefixes of the keys of the v2 layout. Their orderedcode encoding
+// starts with a byte of at least 0x80, so that the v2 keys sort after the
+// printable keys of the v1 layout and the block store state.
+const (
+	prefixBlockMeta int64 = iota
+	prefixBlockPart
+	prefixBlockCommit
+	prefixSeenCommit
+	prefixExtCommit
+	prefixBlockHash
+)
+
+type v2KeyLayout struct{}
+
+func (v2KeyLayout) blockMetaKey(height int64) []byte {
+	return orderedKey(prefixBlockMeta, height)
+}
+
+func (v2KeyLayout) blockPartKey(height int64, partIndex int) []byte {
+	return orderedKey(prefixBlockPart, height, int64(partIndex))
+}
+
+func (v2KeyLayout) blockCommitKey(height int64) []byte {
+	return orderedKey(prefixBlockCommit, height)
+}
+
+func (v2KeyLayout) seenCommitKey(height int64) []byte {
+	return orderedKey(prefixSeenCommit, height)
+}
+
+func (v2KeyLayout) extCommitKey(height int64) []byte {
+	return orderedKey(prefixExtCommit, height)
+}
+
+func (v2KeyLayout) blockHashKey(hash []byte) []byte {
+	return orderedKey(prefixBlockHash, string(hash))
+}
+
+func (v2KeyLayout) ordered() bool { return true }
+
+func orderedKey(items ...interface{}) []byte {
+	key, err := orderedcode.Append(nil, items...)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+//-----------------------------------------------------------------------------
+
+// migrateBatchSize is the number of keys rewritten per batch by
+// migrateKeyLayoutV2.
+const migrateBatchSize = 1000
+
+// migrateKeyLayoutV2 rewrites the keys of the v1 layout with the v2 layout.
+// The v1 keys sort before the v2 ones, so that an interrupted migration finds
+// the keys left to rewrite by iterating over the v1 ones again.
+func migrateKeyLayoutV2(db dbm.DB) error {
+	var start []byte
+	for {
+		keys, values, err := loadV1Keys(db, start)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		batch := db.NewBatch()
+		for i, key := range keys {
+			newKey, err := v1KeyToV2(key)
+			if err != nil {
+				batch.Close()
+				return err
+			}
+			if newKey == nil {
+				continue
+			}
+			if err := batch.Set(newKey, values[i]); err != nil {
+				batch.Close()
+				return err
+			}
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.WriteSync()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+		start = append(keys[len(keys)-1], 0)
+	}
+}
+
+// loadV1Keys loads a batch of the keys of the v1 layout from start on, along
+// with their values.
+func loadV1Keys(db dbm.DB, start []byte) ([][]byte, [][]byte, error) {
+	it, err := db.Iterator(start, []byte{0x80})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+	var keys, values [][]byte
+	for ; it.Valid() && len(keys) < migrateBatchSize; it.Next() {
+		keys = append(keys, append([]byte(nil), it.Key()...))
+		values = append(values, append([]byte(nil), it.Value()...))
+	}
+	return keys, values, it.Error()
+}
+
+// v1KeyToV2 returns the v2 key of the given v1 key, or nil if it isn't the key
+// of a block, e.g. the one of the block store state.
+func v1KeyToV2(key []byte) ([]byte, error) {
+	var layout v2KeyLayout
+	prefix, rest, _ := strings.Cut(string(key), ":")
+	switch prefix {
+	case "H", "C", "SC", "EC":
+		height, err := strconv.ParseInt(rest, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", key, err)
+		}
+		switch prefix {
+		case "H":
+			return layout.blockMetaKey(height), nil
+		case "C":
+			return layout.blockCommitKey(height), nil
+		case "SC":
+			return layout.seenCommitKey(height), nil
+		default:
+			return layout.extCommitKey(height), nil
+		}
+	case "P":
+		heightStr, indexStr, _ := strings.Cut(rest, ":")
+		height, err := strconv.ParseInt(heightStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", key, err)
+		}
+		index, err := strconv.Atoi(indexStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", key, err)
+		}
+		return layout.blockPartKey(height, index), nil
+	case "BH":
+		hash, err := hex.DecodeString(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", key, err)
+		}
+		return layout.blockHashKey(hash), nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,23 @@
+package store
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "store"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of lookups served by the in-memory caches of the block store, by
+	// cache.
+	CacheHits metrics.Counter `metrics_labels:"cache"`
+	// Number of lookups missed by the in-memory caches of the block store, by
+	// cache.
+	CacheMisses metrics.Counter `metrics_labels:"cache"`
+}
@@ -0,0 +1,47 @@
+package store
+
+import (
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/internal/dbschema"
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// dbSchema is the layout of the block store database. A change of the layout
+// must come with a migration from the previous one.
+var dbSchema = dbschema.Schema{
+	Name: "blockstore",
+	Migrations: []dbschema.Migration{
+		{
+			Version:     keyLayoutV2Version,
+			Description: "rewrite the keys with the v2 key layout",
+			Migrate:     migrateKeyLayoutV2,
+		},
+	},
+}
+
+// SchemaVersion returns the current schema version of the block store
+// database.
+func SchemaVersion() uint64 {
+	return dbSchema.Version()
+}
+
+// MigrateDB upgrades the block store database db to the schema version of the
+// given key layout, KeyLayoutV1 if empty, and returns the version it was at.
+// It must be called before db is used by a BlockStore. The v2 key layout is
+// opt-in: a database with the v1 layout has its keys rewritten only if
+// KeyLayoutV2 is given, and a database with the v2 layout is never rewritten
+// back. Databases with a newer schema version are refused.
+func MigrateDB(db dbm.DB, keyLayout string, logger log.Logger) (uint64, error) {
+	target := dbschema.BaseVersion
+	switch keyLayout {
+	case "", KeyLayoutV1:
+	case KeyLayoutV2:
+		target = keyLayoutV2Version
+	default:
+		return 0, fmt.Errorf("unknown block store key layout %q", keyLayout)
+	}
+	return dbSchema.MigrateTo(db, target, logger)
+}
@@ -7,11 +7,12 @@ import (
 
 	cmterrors "github.com/cometbft/cometbft/types/errors"
 	"github.com/cosmos/gogoproto/proto"
-	lru "github.com/hashicorp/golang-lru/v2"
 
 	dbm "github.com/cometbft/cometbft-db"
 
 	"github.com/cometbft/cometbft/evidence"
+	"github.com/cometbft/cometbft/internal/dbschema"
+	"github.com/cometbft/cometbft/libs/log"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -25,6 +26,10 @@ import (
 // this size. However, if the block is larger than 1MB, the performance degrades.
 const maxBlockPartsToBatch = 10
 
+// pruneBatchSize is the number of blocks pruned per batch, to avoid batches
+// becoming too large.
+const pruneBatchSize = 1000
+
 /*
 BlockStore is a simple low level store for blocks.
 
@@ -43,7 +48,21 @@ The store can be assumed to contain all contiguous blocks between base and heigh
 // deserializing loaded data, indicating probable corruption on disk.
 */
 type BlockStore struct {
-	db dbm.DB
+	db   dbm.DB
+	keys keyLayout
+
+	// cold is the cold store the blocks older than coldDepth are moved to, or
+	// nil. tierMtx serializes the moves to the cold store with the deletions.
+	cold       dbm.DB
+	coldDepth  int64
+	tierMtx    cmtsync.Mutex
+	coldHeight int64
+	// coldMoves signals the routine moving the blocks to the cold store that
+	// a block was saved.
+	coldMoves chan struct{}
+	coldQuit  chan struct{}
+	coldDone  chan struct{}
+	logger    log.Logger
 
 	// mtx guards access to the struct fields listed below it. Although we rely on the database
 	// to enforce fine-grained concurrency control for its data, we need to make sure that
@@ -52,44 +71,78 @@ type BlockStore struct {
 	// that the database is also up to date. This prevents any concurrent external access from
 	// obtaining inconsistent data.
 	// The only reason for keeping these fields in the struct is that the data
-	// can't efficiently be queried from the database since the key encoding we use is not
+	// can't efficiently be queried from the database since the v1 key encoding is not
 	// lexicographically ordered (see https://github.com/tendermint/tendermint/issues/4567).
 	mtx    cmtsync.RWMutex
 	base   int64
 	height int64
 
-	seenCommitCache          *lru.Cache[int64, *types.Commit]
-	blockCommitCache         *lru.Cache[int64, *types.Commit]
-	blockExtendedCommitCache *lru.Cache[int64, *types.ExtendedCommit]
+	cacheSize                int
+	blockCacheSize           int
+	blockCache               *heightCache[*cmtproto.Block]
+	blockMetaCache           *heightCache[*types.BlockMeta]
+	seenCommitCache          *heightCache[*types.Commit]
+	blockCommitCache         *heightCache[*types.Commit]
+	blockExtendedCommitCache *heightCache[*types.ExtendedCommit]
+
+	metrics *Metrics
 }
 
 // NewBlockStore returns a new BlockStore with the given DB,
 // initialized to the last height that was committed to the DB.
-func NewBlockStore(db dbm.DB) *BlockStore {
+// The key layout is the one of the schema version of the DB, see MigrateDB.
+func NewBlockStore(db dbm.DB, options ...BlockStoreOption) *BlockStore {
 	bs := LoadBlockStoreState(db)
+	version, err := dbschema.LoadVersion(db)
+	if err != nil {
+		panic(err)
+	}
 	bStore := &BlockStore{
 		base:   bs.Base,
 		height: bs.Height,
 		db:     db,
+		keys:   keyLayoutForVersion(version),
+
+		cacheSize: defaultCacheSize,
+		metrics:   NopMetrics(),
+		logger:    log.NewNopLogger(),
+	}
+	for _, option := range options {
+		option(bStore)
+	}
+	if bStore.cold != nil {
+		coldVersion, err := dbschema.LoadVersion(bStore.cold)
+		if err != nil {
+			panic(err)
+		}
+		if coldVersion != 0 && keyLayoutForVersion(coldVersion) != bStore.keys {
+			panic(fmt.Sprintf("cold store schema version %d doesn't have the key layout of the block store schema version %d",
+				coldVersion, version))
+		}
+		bStore.coldHeight = loadColdHeight(db)
+		bStore.startColdMoves()
 	}
 	bStore.addCaches()
 	return bStore
 }
 
 func (bs *BlockStore) addCaches() {
-	var err error
-	// err can only occur if the argument is non-positive, so is impossible in context.
-	bs.blockCommitCache, err = lru.New[int64, *types.Commit](100)
-	if err != nil {
-		panic(err)
-	}
-	bs.blockExtendedCommitCache, err = lru.New[int64, *types.ExtendedCommit](100)
-	if err != nil {
-		panic(err)
-	}
-	bs.seenCommitCache, err = lru.New[int64, *types.Commit](100)
-	if err != nil {
-		panic(err)
+	bs.blockCache = newHeightCache[*cmtproto.Block]("block", bs.blockCacheSize, bs.metrics)
+	bs.blockMetaCache = newHeightCache[*types.BlockMeta]("block_meta", bs.cacheSize, bs.metrics)
+	bs.blockCommitCache = newHeightCache[*types.Commit]("block_commit", bs.cacheSize, bs.metrics)
+	bs.blockExtendedCommitCache = newHeightCache[*types.ExtendedCommit]("extended_commit", bs.cacheSize, bs.metrics)
+	bs.seenCommitCache = newHeightCache[*types.Commit]("seen_commit", bs.cacheSize, bs.metrics)
+}
+
+// removeFromCaches removes the items of a height from the caches, but its
+// header and commits if keepHeader is set.
+func (bs *BlockStore) removeFromCaches(height int64, keepHeader bool) {
+	bs.blockCache.Remove(height)
+	bs.seenCommitCache.Remove(height)
+	if !keepHeader {
+		bs.blockMetaCache.Remove(height)
+		bs.blockCommitCache.Remove(height)
+		bs.blockExtendedCommitCache.Remove(height)
 	}
 }
 
@@ -136,6 +189,24 @@ func (bs *BlockStore) LoadBaseMeta() *types.BlockMeta {
 // LoadBlock returns the block with the given height.
 // If no block is found for that height, it returns nil.
 func (bs *BlockStore) LoadBlock(height int64) *types.Block {
+	pbb, ok := bs.blockCache.Get(height)
+	if !ok {
+		pbb = bs.loadBlockProto(height)
+		if pbb == nil {
+			return nil
+		}
+		bs.blockCache.Add(height, pbb)
+	}
+
+	block, err := types.BlockFromProto(pbb)
+	if err != nil {
+		panic(cmterrors.ErrMsgFromProto{MessageName: "Block", Err: err})
+	}
+
+	return block
+}
+
+func (bs *BlockStore) loadBlockProto(height int64) *cmtproto.Block {
 	blockMeta := bs.LoadBlockMeta(height)
 	if blockMeta == nil {
 		return nil
@@ -158,20 +229,14 @@ func (bs *BlockStore) LoadBlock(height int64) *types.Block {
 		// block. So, make sure meta is only saved after blocks are saved.
 		panic(fmt.Sprintf("Error reading block: %v", err))
 	}
-
-	block, err := types.BlockFromProto(pbb)
-	if err != nil {
-		panic(cmterrors.ErrMsgFromProto{MessageName: "Block", Err: err})
-	}
-
-	return block
+	return pbb
 }
 
 // LoadBlockByHash returns the block with the given hash.
 // If no block is found for that hash, it returns nil.
 // Panics if it fails to parse height associated with the given hash.
 func (bs *BlockStore) LoadBlockByHash(hash []byte) *types.Block {
-	bz, err := bs.db.Get(calcBlockHashKey(hash))
+	bz, err := bs.get(bs.keys.blockHashKey(hash))
 	if err != nil {
 		panic(err)
 	}
@@ -193,7 +258,7 @@ func (bs *BlockStore) LoadBlockByHash(hash []byte) *types.Block {
 func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 	pbpart := new(cmtproto.Part)
 
-	bz, err := bs.db.Get(calcBlockPartKey(height, index))
+	bz, err := bs.get(bs.keys.blockPartKey(height, index))
 	if err != nil {
 		panic(err)
 	}
@@ -216,8 +281,11 @@ func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 // LoadBlockMeta returns the BlockMeta for the given height.
 // If no block is found for the given height, it returns nil.
 func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
-	pbbm := new(cmtproto.BlockMeta)
-	bz, err := bs.db.Get(calcBlockMetaKey(height))
+	if meta, ok := bs.blockMetaCache.Get(height); ok {
+		metaCopy := *meta
+		return &metaCopy
+	}
+	bz, err := bs.get(bs.keys.blockMetaKey(height))
 	if err != nil {
 		panic(err)
 	}
@@ -225,8 +293,16 @@ func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 	if len(bz) == 0 {
 		return nil
 	}
+	meta := mustDecodeBlockMeta(bz)
+	metaCopy := *meta
+	bs.blockMetaCache.Add(height, &metaCopy)
+	return meta
+}
 
-	err = proto.Unmarshal(bz, pbbm)
+// mustDecodeBlockMeta decodes a stored BlockMeta and panics if it fails.
+func mustDecodeBlockMeta(bz []byte) *types.BlockMeta {
+	pbbm := new(cmtproto.BlockMeta)
+	err := proto.Unmarshal(bz, pbbm)
 	if err != nil {
 		panic(fmt.Errorf("unmarshal to cmtproto.BlockMeta: %w", err))
 	}
@@ -242,7 +318,7 @@ func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 // LoadBlockMetaByHash returns the blockmeta who's header corresponds to the given
 // hash. If none is found, returns nil.
 func (bs *BlockStore) LoadBlockMetaByHash(hash []byte) *types.BlockMeta {
-	bz, err := bs.db.Get(calcBlockHashKey(hash))
+	bz, err := bs.get(bs.keys.blockHashKey(hash))
 	if err != nil {
 		panic(err)
 	}
@@ -268,7 +344,7 @@ func (bs *BlockStore) LoadBlockCommit(height int64) *types.Commit {
 		return comm.Clone()
 	}
 	pbc := new(cmtproto.Commit)
-	bz, err := bs.db.Get(calcBlockCommitKey(height))
+	bz, err := bs.get(bs.keys.blockCommitKey(height))
 	if err != nil {
 		panic(err)
 	}
@@ -296,7 +372,7 @@ func (bs *BlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommi
 		return comm.Clone()
 	}
 	pbec := new(cmtproto.ExtendedCommit)
-	bz, err := bs.db.Get(calcExtCommitKey(height))
+	bz, err := bs.get(bs.keys.extCommitKey(height))
 	if err != nil {
 		panic(fmt.Errorf("fetching extended commit: %w", err))
 	}
@@ -324,7 +400,7 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 		return comm.Clone()
 	}
 	pbc := new(cmtproto.Commit)
-	bz, err := bs.db.Get(calcSeenCommitKey(height))
+	bz, err := bs.get(bs.keys.seenCommitKey(height))
 	if err != nil {
 		panic(err)
 	}
@@ -361,9 +437,9 @@ func (bs *BlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64,
 			height, base)
 	}
 
-	pruned := uint64(0)
-	batch := bs.db.NewBatch()
-	defer batch.Close()
+	bs.tierMtx.Lock()
+	defer bs.tierMtx.Unlock()
+
 	flush := func(batch dbm.Batch, base int64) error {
 		// We can't trust batches to be atomic, so update base first to make sure no one
 		// tries to access missing blocks.
@@ -374,6 +450,32 @@ func (bs *BlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64,
 		return bs.saveStateAndWriteDB(batch, "failed to prune")
 	}
 
+	prune := bs.pruneUnorderedBlocks
+	if bs.keys.ordered() {
+		prune = bs.pruneOrderedBlocks
+	}
+	pruned, evidencePoint, err := prune(base, height, state, flush)
+	if err != nil {
+		return 0, -1, err
+	}
+	// The pruned heights are removed from the caches once deleted, so that they
+	// aren't loaded back in the meantime.
+	for h := base; h < height; h++ {
+		bs.removeFromCaches(h, h >= evidencePoint)
+	}
+	return pruned, evidencePoint, nil
+}
+
+// pruneUnorderedBlocks is PruneBlocks for the key layouts not sorted by
+// height, which looks up the block meta of each height.
+func (bs *BlockStore) pruneUnorderedBlocks(
+	base, height int64,
+	state sm.State,
+	flush func(batch dbm.Batch, base int64) error,
+) (uint64, int64, error) {
+	pruned := uint64(0)
+	batch := bs.newBatch()
+	defer batch.Close()
 	evidencePoint := height
 	for h := base; h < height; h++ {
 
@@ -391,44 +493,43 @@ func (bs *BlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64,
 
 		// if height is beyond the evidence point we don't delete the header
 		if h < evidencePoint {
-			if err := batch.Delete(calcBlockMetaKey(h)); err != nil {
+			if err := batch.Delete(bs.keys.blockMetaKey(h)); err != nil {
 				return 0, -1, err
 			}
 		}
-		if err := batch.Delete(calcBlockHashKey(meta.BlockID.Hash)); err != nil {
+		if err := batch.Delete(bs.keys.blockHashKey(meta.BlockID.Hash)); err != nil {
 			return 0, -1, err
 		}
 		// if height is beyond the evidence point we don't delete the commit data
 		if h < evidencePoint {
-			if err := batch.Delete(calcBlockCommitKey(h)); err != nil {
+			if err := batch.Delete(bs.keys.blockCommitKey(h)); err != nil {
 				return 0, -1, err
 			}
 		}
-		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
+		if err := batch.Delete(bs.keys.seenCommitKey(h)); err != nil {
 			return 0, -1, err
 		}
 
 		if h < evidencePoint {
-			if err := batch.Delete(calcExtCommitKey(h)); err != nil {
+			if err := batch.Delete(bs.keys.extCommitKey(h)); err != nil {
 				return 0, -1, err
 			}
-			bs.blockExtendedCommitCache.Remove(h)
 		}
 
 		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
-			if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
+			if err := batch.Delete(bs.keys.blockPartKey(h, p)); err != nil {
 				return 0, -1, err
 			}
 		}
 		pruned++
 
 		// flush every 1000 blocks to avoid batches becoming too large
-		if pruned%1000 == 0 && pruned > 0 {
+		if pruned%pruneBatchSize == 0 && pruned > 0 {
 			err := flush(batch, h)
 			if err != nil {
 				return 0, -1, err
 			}
-			batch = bs.db.NewBatch()
+			batch = bs.newBatch()
 			defer batch.Close()
 		}
 	}
@@ -440,6 +541,112 @@ func (bs *BlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64,
 	return pruned, evidencePoint, nil
 }
 
+// pruneOrderedBlocks is PruneBlocks for the key layouts sorted by height. The
+// keys of each batch of heights are deleted by iterating over their ranges,
+// rather than by looking up each block meta and part.
+func (bs *BlockStore) pruneOrderedBlocks(
+	base, height int64,
+	state sm.State,
+	flush func(batch dbm.Batch, base int64) error,
+) (uint64, int64, error) {
+	pruned := uint64(0)
+	evidencePoint := height
+	for from := base; from < height; from += pruneBatchSize {
+		to := min(from+pruneBatchSize, height)
+		batch := bs.newBatch()
+
+		for _, db := range bs.dbs() {
+			n, err := bs.deleteBlockHashes(db, batch, from, to, height, state, &evidencePoint)
+			if err != nil {
+				batch.Close()
+				return 0, -1, err
+			}
+			pruned += n
+		}
+
+		// the headers and commits from the evidence point on are kept
+		keep := min(to, evidencePoint)
+		ranges := [][2][]byte{
+			{bs.keys.seenCommitKey(from), bs.keys.seenCommitKey(to)},
+			{bs.keys.blockPartKey(from, 0), bs.keys.blockPartKey(to, 0)},
+		}
+		if from < keep {
+			ranges = append(ranges,
+				[2][]byte{bs.keys.blockMetaKey(from), bs.keys.blockMetaKey(keep)},
+				[2][]byte{bs.keys.blockCommitKey(from), bs.keys.blockCommitKey(keep)},
+				[2][]byte{bs.keys.extCommitKey(from), bs.keys.extCommitKey(keep)},
+			)
+		}
+		for _, r := range ranges {
+			if err := bs.deleteRange(batch, r[0], r[1]); err != nil {
+				batch.Close()
+				return 0, -1, err
+			}
+		}
+
+		if err := flush(batch, to); err != nil {
+			return 0, -1, err
+		}
+	}
+	return pruned, evidencePoint, nil
+}
+
+// deleteBlockHashes adds the deletion of the hash keys of the blocks of db
+// from from to to, exclusive, to batch, and updates the evidence point of
+// pruning up to height. Returns the number of blocks found.
+func (bs *BlockStore) deleteBlockHashes(
+	db dbm.DB,
+	batch dbm.Batch,
+	from, to, height int64,
+	state sm.State,
+	evidencePoint *int64,
+) (uint64, error) {
+	it, err := db.Iterator(bs.keys.blockMetaKey(from), bs.keys.blockMetaKey(to))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	found := uint64(0)
+	for ; it.Valid(); it.Next() {
+		meta := mustDecodeBlockMeta(it.Value())
+		h := meta.Header.Height
+
+		// This logic is in place to protect data that proves malicious behavior.
+		// If the height is within the evidence age, we continue to persist the header and commit data.
+		if *evidencePoint == height && !evidence.IsEvidenceExpired(state.LastBlockHeight, state.LastBlockTime, h, meta.Header.Time, state.ConsensusParams.Evidence) {
+			*evidencePoint = h
+		}
+		if err := batch.Delete(bs.keys.blockHashKey(meta.BlockID.Hash)); err != nil {
+			return 0, err
+		}
+		found++
+	}
+	return found, it.Error()
+}
+
+// deleteRange adds the deletion of the keys from start to end, exclusive, of
+// both stores to batch.
+func (bs *BlockStore) deleteRange(batch dbm.Batch, start, end []byte) error {
+	for _, db := range bs.dbs() {
+		it, err := db.Iterator(start, end)
+		if err != nil {
+			return err
+		}
+		for ; it.Valid(); it.Next() {
+			if err := batch.Delete(it.Key()); err != nil {
+				it.Close()
+				return err
+			}
+		}
+		err = it.Error()
+		it.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SaveBlock persists the given block, blockParts, and seenCommit to the underlying db.
 // blockParts: Must be parts of the block
 // seenCommit: The +2/3 precommits that were seen which committed at height.
@@ -471,6 +678,9 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	if err != nil {
 		panic(err)
 	}
+	// the commit of the previous height is overwritten, e.g. after a rollback
+	bs.blockCommitCache.Remove(block.Height - 1)
+	bs.signalColdMoves()
 }
 
 // SaveBlockWithExtendedCommit persists the given block, blockParts, and
@@ -496,7 +706,7 @@ func (bs *BlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts
 
 	pbec := seenExtendedCommit.ToProto()
 	extCommitBytes := mustEncode(pbec)
-	if err := batch.Set(calcExtCommitKey(height), extCommitBytes); err != nil {
+	if err := batch.Set(bs.keys.extCommitKey(height), extCommitBytes); err != nil {
 		panic(err)
 	}
 
@@ -512,6 +722,9 @@ func (bs *BlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts
 	if err != nil {
 		panic(err)
 	}
+	// the commit of the previous height is overwritten, e.g. after a rollback
+	bs.blockCommitCache.Remove(height - 1)
+	bs.signalColdMoves()
 }
 
 func (bs *BlockStore) saveBlockToBatch(
@@ -557,17 +770,17 @@ func (bs *BlockStore) saveBlockToBatch(
 		return errors.New("nil blockmeta")
 	}
 	metaBytes := mustEncode(pbm)
-	if err := batch.Set(calcBlockMetaKey(height), metaBytes); err != nil {
+	if err := batch.Set(bs.keys.blockMetaKey(height), metaBytes); err != nil {
 		return err
 	}
-	if err := batch.Set(calcBlockHashKey(hash), []byte(fmt.Sprintf("%d", height))); err != nil {
+	if err := batch.Set(bs.keys.blockHashKey(hash), []byte(fmt.Sprintf("%d", height))); err != nil {
 		return err
 	}
 
 	// Save block commit (duplicate and separate from the Block)
 	pbc := block.LastCommit.ToProto()
 	blockCommitBytes := mustEncode(pbc)
-	if err := batch.Set(calcBlockCommitKey(height-1), blockCommitBytes); err != nil {
+	if err := batch.Set(bs.keys.blockCommitKey(height-1), blockCommitBytes); err != nil {
 		return err
 	}
 
@@ -575,7 +788,7 @@ func (bs *BlockStore) saveBlockToBatch(
 	// NOTE: we can delete this at a later height
 	pbsc := seenCommit.ToProto()
 	seenCommitBytes := mustEncode(pbsc)
-	if err := batch.Set(calcSeenCommitKey(height), seenCommitBytes); err != nil {
+	if err := batch.Set(bs.keys.seenCommitKey(height), seenCommitBytes); err != nil {
 		return err
 	}
 
@@ -589,9 +802,9 @@ func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part, b
 	}
 	partBytes := mustEncode(pbp)
 	if saveBlockPartsToBatch {
-		err = batch.Set(calcBlockPartKey(height, index), partBytes)
+		err = batch.Set(bs.keys.blockPartKey(height, index), partBytes)
 	} else {
-		err = bs.db.Set(calcBlockPartKey(height, index), partBytes)
+		err = bs.db.Set(bs.keys.blockPartKey(height, index), partBytes)
 	}
 	if err != nil {
 		panic(err)
@@ -621,10 +834,20 @@ func (bs *BlockStore) SaveSeenCommit(height int64, seenCommit *types.Commit) err
 	if err != nil {
 		return fmt.Errorf("unable to marshal commit: %w", err)
 	}
-	return bs.db.Set(calcSeenCommitKey(height), seenCommitBytes)
+	if err := bs.db.Set(bs.keys.seenCommitKey(height), seenCommitBytes); err != nil {
+		return err
+	}
+	bs.seenCommitCache.Remove(height)
+	return nil
 }
 
 func (bs *BlockStore) Close() error {
+	bs.stopColdMoves()
+	if bs.cold != nil {
+		if err := bs.cold.Close(); err != nil {
+			return err
+		}
+	}
 	return bs.db.Close()
 }
 
@@ -730,38 +953,52 @@ func mustEncode(pb proto.Message) []byte {
 // DeleteLatestBlock removes the block pointed to by height,
 // lowering height by one.
 func (bs *BlockStore) DeleteLatestBlock() error {
+	bs.tierMtx.Lock()
+	defer bs.tierMtx.Unlock()
 	bs.mtx.RLock()
 	targetHeight := bs.height
 	bs.mtx.RUnlock()
 
-	batch := bs.db.NewBatch()
+	batch := bs.newBatch()
 	defer batch.Close()
 
 	// delete what we can, skipping what's already missing, to ensure partial
 	// blocks get deleted fully.
 	if meta := bs.LoadBlockMeta(targetHeight); meta != nil {
-		if err := batch.Delete(calcBlockHashKey(meta.BlockID.Hash)); err != nil {
+		if err := batch.Delete(bs.keys.blockHashKey(meta.BlockID.Hash)); err != nil {
 			return err
 		}
 		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
-			if err := batch.Delete(calcBlockPartKey(targetHeight, p)); err != nil {
+			if err := batch.Delete(bs.keys.blockPartKey(targetHeight, p)); err != nil {
 				return err
 			}
 		}
 	}
-	if err := batch.Delete(calcBlockCommitKey(targetHeight)); err != nil {
+	if err := batch.Delete(bs.keys.blockCommitKey(targetHeight)); err != nil {
 		return err
 	}
-	if err := batch.Delete(calcSeenCommitKey(targetHeight)); err != nil {
+	if err := batch.Delete(bs.keys.seenCommitKey(targetHeight)); err != nil {
 		return err
 	}
 	// delete last, so as to not leave keys built on meta.BlockID dangling
-	if err := batch.Delete(calcBlockMetaKey(targetHeight)); err != nil {
+	if err := batch.Delete(bs.keys.blockMetaKey(targetHeight)); err != nil {
 		return err
 	}
 
+	// the block is moved to the cold store again once saved again
+	if targetHeight <= bs.coldHeight {
+		if err := batch.Set(coldHeightKey, []byte(strconv.FormatInt(targetHeight-1, 10))); err != nil {
+			return err
+		}
+		bs.coldHeight = targetHeight - 1
+	}
+
 	bs.mtx.Lock()
 	defer bs.mtx.Unlock()
 	bs.height = targetHeight - 1
-	return bs.saveStateAndWriteDB(batch, "failed to delete the latest block")
+	if err := bs.saveStateAndWriteDB(batch, "failed to delete the latest block"); err != nil {
+		return err
+	}
+	bs.removeFromCaches(targetHeight, false)
+	return nil
 }
@@ -2,6 +2,7 @@ package store
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"runtime/debug"
@@ -16,7 +17,9 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/internal/dbschema"
 	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
@@ -550,6 +553,14 @@ func TestLoadBlockPart(t *testing.T) {
 }
 
 func TestPruneBlocks(t *testing.T) {
+	for _, keyLayout := range []string{KeyLayoutV1, KeyLayoutV2} {
+		t.Run(keyLayout, func(t *testing.T) {
+			testPruneBlocks(t, keyLayout)
+		})
+	}
+}
+
+func testPruneBlocks(t *testing.T, keyLayout string) {
 	config := test.ResetTestRoot("blockchain_reactor_test")
 	defer os.RemoveAll(config.RootDir)
 	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
@@ -558,6 +569,8 @@ func TestPruneBlocks(t *testing.T) {
 	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
 	require.NoError(t, err)
 	db := dbm.NewMemDB()
+	_, err = MigrateDB(db, keyLayout, log.NewNopLogger())
+	require.NoError(t, err)
 	bs := NewBlockStore(db)
 	assert.EqualValues(t, 0, bs.Base())
 	assert.EqualValues(t, 0, bs.Height())
@@ -653,10 +666,72 @@ func TestPruneBlocks(t *testing.T) {
 	require.NoError(t, err)
 	assert.EqualValues(t, 200, pruned)
 	assert.Nil(t, bs.LoadBlock(1499))
+	assert.Nil(t, bs.LoadBlockPart(1499, 0))
+	assert.Nil(t, bs.LoadSeenCommit(1499))
 	assert.NotNil(t, bs.LoadBlock(1500))
 	assert.Nil(t, bs.LoadBlock(1501))
 }
 
+func TestMigrateKeyLayoutV2(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore()
+	defer cleanup()
+	for h := int64(1); h <= 20; h++ {
+		block, err := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		require.NoError(t, err)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+	}
+	db := bs.db
+	version, err := dbschema.LoadVersion(db)
+	require.NoError(t, err)
+	require.Equal(t, dbschema.BaseVersion, version)
+
+	// the v1 layout is kept unless the v2 one is opted into
+	_, err = MigrateDB(db, KeyLayoutV1, log.NewNopLogger())
+	require.NoError(t, err)
+	require.False(t, NewBlockStore(db).keys.ordered())
+
+	from, err := MigrateDB(db, KeyLayoutV2, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, dbschema.BaseVersion, from)
+	migrated := NewBlockStore(db)
+	require.True(t, migrated.keys.ordered())
+	require.EqualValues(t, 1, migrated.Base())
+	require.EqualValues(t, 20, migrated.Height())
+
+	// only the block store state and the schema version are left with
+	// printable keys
+	var v1Keys []string
+	it, err := db.Iterator(nil, []byte{0x80})
+	require.NoError(t, err)
+	for ; it.Valid(); it.Next() {
+		v1Keys = append(v1Keys, string(it.Key()))
+	}
+	require.NoError(t, it.Close())
+	require.ElementsMatch(t, []string{"blockStore", "schemaVersion"}, v1Keys)
+
+	for h := int64(1); h <= 20; h++ {
+		block := migrated.LoadBlock(h)
+		require.NotNil(t, block)
+		require.Equal(t, block.Hash(), migrated.LoadBlockByHash(block.Hash()).Hash())
+		require.NotNil(t, migrated.LoadSeenCommit(h))
+		require.NotNil(t, migrated.LoadBlockExtendedCommit(h))
+		if h > 1 {
+			require.NotNil(t, migrated.LoadBlockCommit(h-1))
+		}
+	}
+
+	// the migration can be run again, e.g. once interrupted
+	require.NoError(t, migrateKeyLayoutV2(db))
+	require.NotNil(t, NewBlockStore(db).LoadBlock(20))
+
+	// the v2 layout isn't rewritten back
+	_, err = MigrateDB(db, KeyLayoutV1, log.NewNopLogger())
+	require.NoError(t, err)
+	require.True(t, NewBlockStore(db).keys.ordered())
+}
+
 func TestLoadBlockMeta(t *testing.T) {
 	bs, db := newInMemoryBlockStore()
 	height := int64(10)
@@ -779,3 +854,158 @@ func newBlock(hdr types.Header, lastCommit *types.Commit) *types.Block {
 		LastCommit: lastCommit,
 	}
 }
+
+func TestColdStore(t *testing.T) {
+	for _, keyLayout := range []string{KeyLayoutV1, KeyLayoutV2} {
+		t.Run(keyLayout, func(t *testing.T) {
+			state, _, cleanup := makeStateAndBlockStore()
+			defer cleanup()
+			db, coldDB := dbm.NewMemDB(), dbm.NewMemDB()
+			_, err := MigrateDB(db, keyLayout, log.NewNopLogger())
+			require.NoError(t, err)
+			_, err = MigrateColdDB(coldDB, db, log.NewNopLogger())
+			require.NoError(t, err)
+			bs := NewBlockStore(db, WithColdStore(coldDB, 10))
+			defer bs.stopColdMoves()
+
+			hashes := make(map[int64][]byte)
+			for h := int64(1); h <= 50; h++ {
+				block, err := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+				require.NoError(t, err)
+				partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+				require.NoError(t, err)
+				bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+				hashes[h] = block.Hash()
+			}
+
+			// the blocks up to 10 heights below the latest one are moved in
+			// the background
+			require.Eventually(t, func() bool { return loadColdHeight(db) == 40 },
+				5*time.Second, 10*time.Millisecond)
+			bs.tierMtx.Lock()
+			require.EqualValues(t, 40, bs.coldHeight)
+			bs.tierMtx.Unlock()
+			for h := int64(1); h <= 50; h++ {
+				cold := h <= 40
+				inHot, err := db.Has(bs.keys.blockMetaKey(h))
+				require.NoError(t, err)
+				inCold, err := coldDB.Has(bs.keys.blockMetaKey(h))
+				require.NoError(t, err)
+				require.Equal(t, !cold, inHot, "height %d", h)
+				require.Equal(t, cold, inCold, "height %d", h)
+
+				require.NotNil(t, bs.LoadBlock(h), "height %d", h)
+				require.NotNil(t, bs.LoadBlockPart(h, 0), "height %d", h)
+				require.NotNil(t, bs.LoadBlockByHash(hashes[h]), "height %d", h)
+				require.NotNil(t, bs.LoadSeenCommit(h), "height %d", h)
+				require.NotNil(t, bs.LoadBlockExtendedCommit(h), "height %d", h)
+				if h < 50 {
+					require.NotNil(t, bs.LoadBlockCommit(h), "height %d", h)
+				}
+			}
+
+			// the moved height is loaded again
+			require.EqualValues(t, 40, NewBlockStore(db, WithColdStore(coldDB, 0)).coldHeight)
+
+			// pruning deletes the blocks from both stores
+			state.LastBlockHeight = 50
+			state.LastBlockTime = cmttime.Now()
+			state.ConsensusParams.Evidence.MaxAgeNumBlocks = 0
+			state.ConsensusParams.Evidence.MaxAgeDuration = 0
+			pruned, _, err := bs.PruneBlocks(45, state)
+			require.NoError(t, err)
+			require.EqualValues(t, 44, pruned)
+			for h := int64(1); h < 45; h++ {
+				require.Nil(t, bs.LoadBlock(h), "height %d", h)
+				require.Nil(t, bs.LoadBlockByHash(hashes[h]), "height %d", h)
+			}
+			require.NotNil(t, bs.LoadBlock(45))
+		})
+	}
+}
+
+func TestColdStoreMoveFailure(t *testing.T) {
+	state, _, cleanup := makeStateAndBlockStore()
+	defer cleanup()
+	db, coldDB := dbm.NewMemDB(), failingBatchDB{dbm.NewMemDB()}
+	bs := NewBlockStore(db, WithColdStore(coldDB, 5))
+	defer bs.stopColdMoves()
+
+	// the blocks are saved, and kept in the hot store
+	for h := int64(1); h <= 20; h++ {
+		block, err := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		require.NoError(t, err)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		require.NotPanics(t, func() { bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now())) })
+	}
+	bs.stopColdMoves()
+	require.Zero(t, loadColdHeight(db))
+	for h := int64(1); h <= 20; h++ {
+		inHot, err := db.Has(bs.keys.blockMetaKey(h))
+		require.NoError(t, err)
+		require.True(t, inHot, "height %d", h)
+	}
+}
+
+// failingBatchDB is a DB whose batches fail to be written.
+type failingBatchDB struct {
+	dbm.DB
+}
+
+func (db failingBatchDB) NewBatch() dbm.Batch {
+	return failingBatch{db.DB.NewBatch()}
+}
+
+type failingBatch struct {
+	dbm.Batch
+}
+
+func (failingBatch) WriteSync() error {
+	return errors.New("write failed")
+}
+
+func TestBlockStoreCaches(t *testing.T) {
+	state, _, cleanup := makeStateAndBlockStore()
+	defer cleanup()
+	bs := NewBlockStore(dbm.NewMemDB(), WithCacheSizes(100, 10))
+
+	for h := int64(1); h <= 20; h++ {
+		block, err := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		require.NoError(t, err)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+	}
+	for h := int64(1); h <= 20; h++ {
+		require.NotNil(t, bs.LoadBlock(h), "height %d", h)
+		require.NotNil(t, bs.LoadBlockMeta(h), "height %d", h)
+		require.NotNil(t, bs.LoadSeenCommit(h), "height %d", h)
+		require.NotNil(t, bs.LoadBlockExtendedCommit(h), "height %d", h)
+	}
+
+	// the cached items are copies
+	meta := bs.LoadBlockMeta(20)
+	meta.NumTxs = 0
+	require.NotZero(t, bs.LoadBlockMeta(20).NumTxs)
+	block := bs.LoadBlock(20)
+	block.Data.Txs = nil
+	require.Len(t, bs.LoadBlock(20).Data.Txs, 10)
+
+	// pruned and deleted heights aren't served from the caches
+	state.LastBlockHeight = 20
+	state.LastBlockTime = cmttime.Now()
+	state.ConsensusParams.Evidence.MaxAgeNumBlocks = 0
+	state.ConsensusParams.Evidence.MaxAgeDuration = 0
+	_, _, err := bs.PruneBlocks(10, state)
+	require.NoError(t, err)
+	require.NoError(t, bs.DeleteLatestBlock())
+	for _, h := range []int64{1, 9, 20} {
+		require.Nil(t, bs.LoadBlock(h), "height %d", h)
+		require.Nil(t, bs.LoadBlockMeta(h), "height %d", h)
+		require.Nil(t, bs.LoadSeenCommit(h), "height %d", h)
+	}
+	require.Nil(t, bs.LoadBlockExtendedCommit(9))
+	require.NotNil(t, bs.LoadBlock(10))
+	require.NotNil(t, bs.LoadBlockMeta(19))
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/lp2p"
+	"github.com/cometbft/cometbft/node"
+)
+
+// chaosTimeout bounds a single chaos command exchange.
+const chaosTimeout = 10 * time.Second
+
+// startChaosServer attaches a fault injector to the node's libp2p host and
+// serves chaos commands on the given unix socket, one command per connection.
+// It's used by the runner to perturb libp2p nodes. Commands are:
+//
+//	partition <peer-id>...: blocks streams to and from the given peers
+//	heal:                   removes all partitions
+//	latency <d> [jitter]:   delays stream writes by d plus up to jitter
+//	reset_rate <p>:         resets streams on write with probability p
+//	exhaust:                exhausts the host's stream resources
+//	clear:                  removes all faults
+func startChaosServer(socket string, n *node.Node, logger log.Logger) error {
+	sw, ok := n.Switch().(*lp2p.Switch)
+	if !ok {
+		return errors.New("chaos socket is only supported for libp2p nodes")
+	}
+
+	faults := lp2p.NewFaultInjector()
+	sw.Host().SetFaultInjector(faults)
+
+	if err := os.RemoveAll(socket); err != nil {
+		return fmt.Errorf("failed to remove chaos socket %q: %w", socket, err)
+	}
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return fmt.Errorf("failed to listen on chaos socket %q: %w", socket, err)
+	}
+
+	logger = logger.With("module", "chaos")
+	logger.Info("Serving chaos commands", "socket", socket)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				logger.Error("Failed to accept chaos connection", "err", err)
+				return
+			}
+			go handleChaosConn(conn, faults, logger)
+		}
+	}()
+
+	return nil
+}
+
+func handleChaosConn(conn net.Conn, faults *lp2p.FaultInjector, logger log.Logger) {
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(chaosTimeout))
+
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		logger.Error("Failed to read chaos command", "err", err)
+		return
+	}
+
+	args := strings.Fields(line)
+	reply := "ok"
+	if err := execChaosCommand(faults, args); err != nil {
+		reply = "error: " + err.Error()
+	}
+	logger.Info("Executed chaos command", "command", args, "reply", reply)
+
+	_, _ = fmt.Fprintln(conn, reply)
+}
+
+func execChaosCommand(faults *lp2p.FaultInjector, args []string) error {
+	if len(args) == 0 {
+		return errors.New("empty command")
+	}
+
+	switch cmd, args := args[0], args[1:]; cmd {
+	case "partition":
+		ids := make([]peer.ID, 0, len(args))
+		for _, arg := range args {
+			id, err := peer.Decode(arg)
+			if err != nil {
+				return fmt.Errorf("invalid peer id %q: %w", arg, err)
+			}
+			ids = append(ids, id)
+		}
+		faults.Partition(ids...)
+
+	case "heal":
+		faults.Heal()
+
+	case "latency":
+		if len(args) == 0 || len(args) > 2 {
+			return errors.New("usage: latency <duration> [jitter]")
+		}
+		durations := make([]time.Duration, 2)
+		for i, arg := range args {
+			d, err := time.ParseDuration(arg)
+			if err != nil {
+				return fmt.Errorf("invalid duration %q: %w", arg, err)
+			}
+			durations[i] = d
+		}
+		faults.SetLatency(durations[0], durations[1])
+
+	case "reset_rate":
+		if len(args) != 1 {
+			return errors.New("usage: reset_rate <probability>")
+		}
+		rate, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			return fmt.Errorf("invalid probability %q: %w", args[0], err)
+		}
+		faults.SetStreamResetRate(rate)
+
+	case "exhaust":
+		if _, err := faults.ExhaustResources(); err != nil {
+			return err
+		}
+
+	case "clear":
+		faults.Clear()
+
+	default:
+		return fmt.Errorf("unknown chaos command %q", cmd)
+	}
+
+	return nil
+}
+
+// sendChaosCommand sends a chaos command to the node serving the given unix
+// socket, returning its reply.
+func sendChaosCommand(socket string, args []string) (string, error) {
+	conn, err := net.DialTimeout("unix", socket, chaosTimeout)
+	if err != nil {
+		return "", fmt.Errorf("failed to dial chaos socket %q: %w", socket, err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(chaosTimeout))
+
+	if _, err := fmt.Fprintln(conn, strings.Join(args, " ")); err != nil {
+		return "", fmt.Errorf("failed to send chaos command: %w", err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("failed to read chaos reply: %w", err)
+	}
+	reply = strings.TrimSpace(reply)
+	if strings.HasPrefix(reply, "error: ") {
+		return "", errors.New(strings.TrimPrefix(reply, "error: "))
+	}
+
+	return reply, nil
+}
@@ -26,6 +26,7 @@ type Config struct {
 	VoteExtensionsEnableHeight int64                       `toml:"vote_extensions_enable_height"`
 	VoteExtensionsUpdateHeight int64                       `toml:"vote_extensions_update_height"`
 	VoteExtensionSize          uint                        `toml:"vote_extension_size"`
+	ChaosSocket                string                      `toml:"chaos_socket"`
 }
 
 // App extracts out the application specific configuration parameters
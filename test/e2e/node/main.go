@@ -35,8 +35,17 @@ var logger = log.NewTMLogger(log.NewSyncWriter(os.Stdout))
 
 // main is the binary entrypoint.
 func main() {
+	if len(os.Args) >= 4 && os.Args[1] == "chaos" {
+		reply, err := sendChaosCommand(os.Args[2], os.Args[3:])
+		if err != nil {
+			logger.Error(err.Error())
+			os.Exit(1)
+		}
+		fmt.Println(reply)
+		return
+	}
 	if len(os.Args) != 2 {
-		fmt.Printf("Usage: %v <configfile>", os.Args[0])
+		fmt.Printf("Usage: %v <configfile>\n       %v chaos <socket> <command> [args...]", os.Args[0], os.Args[0])
 		return
 	}
 	configFile := ""
@@ -144,6 +153,11 @@ func startNode(cfg *Config) error {
 	if err != nil {
 		return err
 	}
+	if cfg.ChaosSocket != "" {
+		if err := startChaosServer(cfg.ChaosSocket, n, nodeLogger); err != nil {
+			return err
+		}
+	}
 	return n.Start()
 }
 
@@ -116,6 +116,22 @@ type Manifest struct {
 	// Maximum number of peers to which the node gossips transactions
 	ExperimentalMaxGossipConnectionsToPersistentPeers    uint `toml:"experimental_max_gossip_connections_to_persistent_peers"`
 	ExperimentalMaxGossipConnectionsToNonPersistentPeers uint `toml:"experimental_max_gossip_connections_to_non_persistent_peers"`
+
+	// Partitions lists network partitions to apply once the testnet is
+	// running, along with the node perturbations. Only nodes using libp2p
+	// and a builtin ABCI protocol can be partitioned.
+	Partitions []ManifestPartition `toml:"partition"`
+}
+
+// ManifestPartition represents a network partition in a testnet manifest.
+type ManifestPartition struct {
+	// Groups lists groups of node names. Nodes in different groups can't
+	// communicate while the partition lasts, nodes in the same group can.
+	// Nodes not listed in any group are unaffected.
+	Groups [][]string `toml:"groups"`
+
+	// Duration specifies how long the partition lasts. Defaults to 10s.
+	Duration time.Duration `toml:"duration"`
 }
 
 // ManifestNode represents a node in a testnet manifest.
@@ -189,6 +205,14 @@ type ManifestNode struct {
 	// kill:       kills the node with SIGKILL then restarts it
 	// pause:      temporarily pauses (freezes) the node
 	// restart:    restarts the node, shutting it down with SIGTERM
+	//
+	// The following perturbations inject faults into the node's libp2p host
+	// for 10s, and require use_libp2p and a builtin ABCI protocol:
+	//
+	// lp2p_partition:    blocks streams to and from all other nodes
+	// lp2p_latency:      delays stream writes by 500ms, plus up to 500ms jitter
+	// lp2p_stream_reset: resets 20% of stream writes
+	// lp2p_exhaust:      exhausts the node's stream resources
 	Perturb []string `toml:"perturb"`
 
 	// SendNoLoad determines if the e2e test should send load to this node.
@@ -33,6 +33,8 @@ const (
 	defaultConnections = 1
 	defaultTxSizeBytes = 1024
 
+	defaultPartitionDuration = 10 * time.Second
+
 	localVersion = "cometbft/e2e-node:local-version"
 )
 
@@ -61,6 +63,11 @@ const (
 	PerturbationRestart    Perturbation = "restart"
 	PerturbationUpgrade    Perturbation = "upgrade"
 
+	PerturbationLibp2pPartition   Perturbation = "lp2p_partition"
+	PerturbationLibp2pLatency     Perturbation = "lp2p_latency"
+	PerturbationLibp2pStreamReset Perturbation = "lp2p_stream_reset"
+	PerturbationLibp2pExhaust     Perturbation = "lp2p_exhaust"
+
 	EvidenceAgeHeight int64         = 14
 	EvidenceAgeTime   time.Duration = 1500 * time.Millisecond
 )
@@ -98,6 +105,14 @@ type Testnet struct {
 	VoteExtensionSize                                    uint
 	ExperimentalMaxGossipConnectionsToPersistentPeers    uint
 	ExperimentalMaxGossipConnectionsToNonPersistentPeers uint
+	Partitions                                           []*Partition
+}
+
+// Partition represents a network partition between groups of libp2p nodes,
+// applied by the runner's perturbations.
+type Partition struct {
+	Groups   [][]*Node
+	Duration time.Duration
 }
 
 // Node represents a CometBFT node in a testnet.
@@ -352,6 +367,26 @@ func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureDa
 		testnet.ValidatorUpdates[int64(height)] = valUpdate
 	}
 
+	// Set up partitions.
+	for i, partitionManifest := range manifest.Partitions {
+		partition := &Partition{Duration: partitionManifest.Duration}
+		if partition.Duration == 0 {
+			partition.Duration = defaultPartitionDuration
+		}
+		for _, groupNames := range partitionManifest.Groups {
+			group := make([]*Node, 0, len(groupNames))
+			for _, name := range groupNames {
+				node := testnet.LookupNode(name)
+				if node == nil {
+					return nil, fmt.Errorf("unknown node %q in partition %d", name, i)
+				}
+				group = append(group, node)
+			}
+			partition.Groups = append(partition.Groups, group)
+		}
+		testnet.Partitions = append(testnet.Partitions, partition)
+	}
+
 	return testnet, testnet.Validate()
 }
 
@@ -405,6 +440,37 @@ func (t Testnet) Validate() error {
 			return fmt.Errorf("invalid node %q: %w", node.Name, err)
 		}
 	}
+	for i, partition := range t.Partitions {
+		if err := partition.Validate(); err != nil {
+			return fmt.Errorf("invalid partition %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Validate validates a partition.
+func (p Partition) Validate() error {
+	if len(p.Groups) < 2 {
+		return errors.New("partition must have at least 2 groups")
+	}
+	if p.Duration < 0 {
+		return fmt.Errorf("partition duration must be positive, got %v", p.Duration)
+	}
+	seen := map[*Node]bool{}
+	for _, group := range p.Groups {
+		if len(group) == 0 {
+			return errors.New("partition groups can't be empty")
+		}
+		for _, node := range group {
+			if seen[node] {
+				return fmt.Errorf("node %q is in several partition groups", node.Name)
+			}
+			seen[node] = true
+			if !node.SupportsChaos() {
+				return fmt.Errorf("node %q must use libp2p and a builtin ABCI protocol to be partitioned", node.Name)
+			}
+		}
+	}
 	return nil
 }
 
@@ -492,6 +558,11 @@ func (n Node) Validate(testnet Testnet) error {
 			}
 			upgradeFound = true
 		case PerturbationDisconnect, PerturbationKill, PerturbationPause, PerturbationRestart:
+		case PerturbationLibp2pPartition, PerturbationLibp2pLatency,
+			PerturbationLibp2pStreamReset, PerturbationLibp2pExhaust:
+			if !n.SupportsChaos() {
+				return fmt.Errorf("%q perturbation requires libp2p and a builtin ABCI protocol", perturbation)
+			}
 		default:
 			return fmt.Errorf("invalid perturbation %q", perturbation)
 		}
@@ -540,6 +611,9 @@ func (t Testnet) IPv6() bool {
 
 // HasPerturbations returns whether the network has any perturbations.
 func (t Testnet) HasPerturbations() bool {
+	if len(t.Partitions) > 0 {
+		return true
+	}
 	for _, node := range t.Nodes {
 		if len(node.Perturbations) > 0 {
 			return true
@@ -610,6 +684,13 @@ func (n Node) Stateless() bool {
 	return n.Mode == ModeLight || n.Mode == ModeSeed
 }
 
+// SupportsChaos returns true if the node serves chaos commands, i.e. it runs
+// a builtin libp2p node that faults can be injected into.
+func (n Node) SupportsChaos() bool {
+	return n.UseLibp2p && n.Mode != ModeLight &&
+		(n.ABCIProtocol == ProtocolBuiltin || n.ABCIProtocol == ProtocolBuiltinConnSync)
+}
+
 // keyGenerator generates pseudorandom Ed25519 keys based on a seed.
 type keyGenerator struct {
 	random *rand.Rand
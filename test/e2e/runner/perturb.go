@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/lp2p"
 	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
 	"github.com/cometbft/cometbft/test/e2e/pkg/infra/docker"
@@ -22,9 +23,73 @@ func Perturb(ctx context.Context, testnet *e2e.Testnet) error {
 			time.Sleep(3 * time.Second) // give network some time to recover between each
 		}
 	}
+	for _, partition := range testnet.Partitions {
+		if err := PerturbPartition(ctx, testnet, partition); err != nil {
+			return err
+		}
+		time.Sleep(3 * time.Second)
+	}
 	return nil
 }
 
+// PerturbPartition partitions groups of libp2p nodes from each other for the
+// partition's duration, then waits for them to recover.
+func PerturbPartition(ctx context.Context, testnet *e2e.Testnet, partition *e2e.Partition) error {
+	groupIDs := make([][]string, len(partition.Groups))
+	for i, group := range partition.Groups {
+		for _, node := range group {
+			id, err := lp2p.IDFromPrivateKey(node.NodeKey)
+			if err != nil {
+				return err
+			}
+			groupIDs[i] = append(groupIDs[i], id.String())
+		}
+	}
+
+	logger.Info("perturb partition", "msg",
+		log.NewLazySprintf("Partitioning %d groups for %v...", len(partition.Groups), partition.Duration))
+	for i, group := range partition.Groups {
+		var others []string
+		for j, ids := range groupIDs {
+			if j != i {
+				others = append(others, ids...)
+			}
+		}
+		for _, node := range group {
+			if err := execChaos(ctx, testnet, node.Name, append([]string{"partition"}, others...)...); err != nil {
+				return err
+			}
+		}
+	}
+
+	time.Sleep(partition.Duration)
+
+	for _, group := range partition.Groups {
+		for _, node := range group {
+			if err := execChaos(ctx, testnet, node.Name, "heal"); err != nil {
+				return err
+			}
+		}
+	}
+	for _, group := range partition.Groups {
+		for _, node := range group {
+			status, err := waitForNode(ctx, node, 0, 20*time.Second)
+			if err != nil {
+				return err
+			}
+			logger.Info("perturb partition", "msg",
+				log.NewLazySprintf("Node %v recovered at height %v", node.Name, status.SyncInfo.LatestBlockHeight))
+		}
+	}
+	return nil
+}
+
+// execChaos sends a chaos command to the node running in the given container.
+func execChaos(ctx context.Context, testnet *e2e.Testnet, container string, args ...string) error {
+	return docker.ExecCompose(ctx, testnet.Dir,
+		append([]string{"exec", "-T", container, "/usr/bin/app", "chaos", ChaosSocket}, args...)...)
+}
+
 // PerturbNode perturbs a node with a given perturbation, returning its status
 // after recovering.
 func PerturbNode(ctx context.Context, node *e2e.Node, perturbation e2e.Perturbation) (*rpctypes.ResultStatus, error) {
@@ -79,6 +144,41 @@ func PerturbNode(ctx context.Context, node *e2e.Node, perturbation e2e.Perturbat
 			return nil, err
 		}
 
+	case e2e.PerturbationLibp2pPartition:
+		logger.Info("perturb node", "msg", log.NewLazySprintf("Partitioning node %v...", node.Name))
+		args := []string{"partition"}
+		for _, peer := range testnet.Nodes {
+			if peer.Name == node.Name || !peer.UseLibp2p {
+				continue
+			}
+			id, err := lp2p.IDFromPrivateKey(peer.NodeKey)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, id.String())
+		}
+		if err := perturbChaos(ctx, testnet, name, args, "heal"); err != nil {
+			return nil, err
+		}
+
+	case e2e.PerturbationLibp2pLatency:
+		logger.Info("perturb node", "msg", log.NewLazySprintf("Delaying streams of node %v...", node.Name))
+		if err := perturbChaos(ctx, testnet, name, []string{"latency", "500ms", "500ms"}, "clear"); err != nil {
+			return nil, err
+		}
+
+	case e2e.PerturbationLibp2pStreamReset:
+		logger.Info("perturb node", "msg", log.NewLazySprintf("Resetting streams of node %v...", node.Name))
+		if err := perturbChaos(ctx, testnet, name, []string{"reset_rate", "0.2"}, "clear"); err != nil {
+			return nil, err
+		}
+
+	case e2e.PerturbationLibp2pExhaust:
+		logger.Info("perturb node", "msg", log.NewLazySprintf("Exhausting stream resources of node %v...", node.Name))
+		if err := perturbChaos(ctx, testnet, name, []string{"exhaust"}, "clear"); err != nil {
+			return nil, err
+		}
+
 	case e2e.PerturbationUpgrade:
 		oldV := node.Version
 		newV := node.Testnet.UpgradeVersion
@@ -117,3 +217,13 @@ func PerturbNode(ctx context.Context, node *e2e.Node, perturbation e2e.Perturbat
 		log.NewLazySprintf("Node %v recovered at height %v", node.Name, status.SyncInfo.LatestBlockHeight))
 	return status, nil
 }
+
+// perturbChaos applies a chaos command to the node running in the given
+// container, then reverts it with another command after 10 seconds.
+func perturbChaos(ctx context.Context, testnet *e2e.Testnet, container string, apply []string, revert string) error {
+	if err := execChaos(ctx, testnet, container, apply...); err != nil {
+		return err
+	}
+	time.Sleep(10 * time.Second)
+	return execChaos(ctx, testnet, container, revert)
+}
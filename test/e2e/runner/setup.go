@@ -35,6 +35,8 @@ const (
 	PrivvalStateFile      = "data/priv_validator_state.json"
 	PrivvalDummyKeyFile   = "config/dummy_validator_key.json"
 	PrivvalDummyStateFile = "data/dummy_validator_state.json"
+
+	ChaosSocket = "/var/run/chaos.sock"
 )
 
 // Setup sets up the testnet configuration.
@@ -361,6 +363,10 @@ func MakeAppConfig(node *e2e.Node) ([]byte, error) {
 		cfg["validator_update"] = validatorUpdates
 	}
 
+	if node.SupportsChaos() {
+		cfg["chaos_socket"] = ChaosSocket
+	}
+
 	var buf bytes.Buffer
 	err := toml.NewEncoder(&buf).Encode(cfg)
 	if err != nil {
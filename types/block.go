@@ -19,6 +19,7 @@ import (
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmterrors "github.com/cometbft/cometbft/types/errors"
 	"github.com/cometbft/cometbft/version"
 )
 
@@ -446,22 +447,83 @@ func (h *Header) Hash() cmtbytes.HexBytes {
 	if h == nil || len(h.ValidatorsHash) == 0 {
 		return nil
 	}
-	hbz, err := h.Version.Marshal()
+	leaves, err := h.hashLeaves()
 	if err != nil {
 		return nil
 	}
+	return merkle.HashFromByteSlices(leaves)
+}
+
+// Indexes of the header fields in the Merkle tree whose root is Header.Hash.
+const (
+	headerValidatorsHashIndex = 7
+	headerConsensusHashIndex  = 9
+)
+
+// ValidatorsHashProof returns a Merkle proof that h.ValidatorsHash is included
+// in h.Hash(). It can be verified with VerifyValidatorsHashProof.
+func (h *Header) ValidatorsHashProof() (*merkle.Proof, error) {
+	return h.fieldProof(headerValidatorsHashIndex)
+}
+
+// ConsensusHashProof returns a Merkle proof that h.ConsensusHash is included
+// in h.Hash(). It can be verified with VerifyConsensusHashProof.
+func (h *Header) ConsensusHashProof() (*merkle.Proof, error) {
+	return h.fieldProof(headerConsensusHashIndex)
+}
+
+func (h *Header) fieldProof(index int) (*merkle.Proof, error) {
+	if h == nil || len(h.ValidatorsHash) == 0 {
+		return nil, errors.New("nil or incomplete header")
+	}
+	leaves, err := h.hashLeaves()
+	if err != nil {
+		return nil, err
+	}
+	_, proofs := merkle.ProofsFromByteSlices(leaves)
+	return proofs[index], nil
+}
+
+// VerifyValidatorsHashProof verifies that proof proves valsHash to be the
+// ValidatorsHash of the header with the given hash.
+func VerifyValidatorsHashProof(headerHash, valsHash []byte, proof *merkle.Proof) error {
+	return verifyHeaderFieldProof(headerHash, valsHash, headerValidatorsHashIndex, proof)
+}
+
+// VerifyConsensusHashProof verifies that proof proves paramsHash to be the
+// ConsensusHash of the header with the given hash.
+func VerifyConsensusHashProof(headerHash, paramsHash []byte, proof *merkle.Proof) error {
+	return verifyHeaderFieldProof(headerHash, paramsHash, headerConsensusHashIndex, proof)
+}
+
+func verifyHeaderFieldProof(headerHash, fieldHash []byte, index int, proof *merkle.Proof) error {
+	if proof == nil {
+		return errors.New("nil proof")
+	}
+	if proof.Index != int64(index) {
+		return fmt.Errorf("proof index %d does not match header field index %d", proof.Index, index)
+	}
+	return proof.Verify(headerHash, cdcEncode(cmtbytes.HexBytes(fieldHash)))
+}
+
+// hashLeaves returns the encoded header fields hashed by Hash.
+func (h *Header) hashLeaves() ([][]byte, error) {
+	hbz, err := h.Version.Marshal()
+	if err != nil {
+		return nil, err
+	}
 
 	pbt, err := gogotypes.StdTimeMarshal(h.Time)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
 	pbbi := h.LastBlockID.ToProto()
 	bzbi, err := pbbi.Marshal()
 	if err != nil {
-		return nil
+		return nil, err
 	}
-	return merkle.HashFromByteSlices([][]byte{
+	return [][]byte{
 		hbz,
 		cdcEncode(h.ChainID),
 		cdcEncode(h.Height),
@@ -476,7 +538,7 @@ func (h *Header) Hash() cmtbytes.HexBytes {
 		cdcEncode(h.LastResultsHash),
 		cdcEncode(h.EvidenceHash),
 		cdcEncode(h.ProposerAddress),
-	})
+	}, nil
 }
 
 // StringIndented returns an indented string representation of the header.
@@ -726,8 +788,8 @@ func (cs *CommitSig) FromProto(csp cmtproto.CommitSig) error {
 // vote extension and vote extension signature.
 type ExtendedCommitSig struct {
 	CommitSig                 // Commit signature
-	Extension          []byte // Vote extension
-	ExtensionSignature []byte // Vote extension signature
+	Extension          []byte `json:"extension"`           // Vote extension
+	ExtensionSignature []byte `json:"extension_signature"` // Vote extension signature
 }
 
 // NewExtendedCommitSigAbsent returns new ExtendedCommitSig with
@@ -1056,10 +1118,10 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 // ExtendedCommit is similar to Commit, except that its signatures also retain
 // their corresponding vote extensions and vote extension signatures.
 type ExtendedCommit struct {
-	Height             int64
-	Round              int32
-	BlockID            BlockID
-	ExtendedSignatures []ExtendedCommitSig
+	Height             int64               `json:"height"`
+	Round              int32               `json:"round"`
+	BlockID            BlockID             `json:"block_id"`
+	ExtendedSignatures []ExtendedCommitSig `json:"signatures"`
 
 	bitArray *bits.BitArray
 }
@@ -1132,6 +1194,49 @@ func (ec *ExtendedCommit) EnsureExtensions(extEnabled bool) error {
 	return nil
 }
 
+// VerifyExtension checks that the precommit of the validator at the given
+// index, along with its vote extension, is signed by the given public key.
+// Absent precommits are rejected; precommits for nil have no extension, so
+// only their vote signature is checked.
+func (ec *ExtendedCommit) VerifyExtension(chainID string, valIdx int32, pubKey crypto.PubKey) error {
+	if valIdx < 0 || int(valIdx) >= len(ec.ExtendedSignatures) {
+		return fmt.Errorf("validator index %d out of range [0, %d)", valIdx, len(ec.ExtendedSignatures))
+	}
+	if ec.ExtendedSignatures[valIdx].BlockIDFlag == BlockIDFlagAbsent {
+		return fmt.Errorf("precommit of validator %d is absent", valIdx)
+	}
+
+	vote := ec.GetExtendedVote(valIdx)
+	if !bytes.Equal(vote.ValidatorAddress, pubKey.Address()) {
+		return fmt.Errorf("validator address %X doesn't match public key address %X",
+			vote.ValidatorAddress, pubKey.Address())
+	}
+	if err := vote.VerifyVoteAndExtension(chainID, pubKey); err != nil {
+		return fmt.Errorf("verifying precommit of validator %d: %w", valIdx, err)
+	}
+	return nil
+}
+
+// VerifyExtensions checks that all the precommits of the extended commit,
+// along with their vote extensions, are signed by the validators of the given
+// set, which must be the validator set at the commit's height. It doesn't check
+// the voting power of the precommits; use ValidatorSet.VerifyCommit with
+// ToCommit to do so.
+func (ec *ExtendedCommit) VerifyExtensions(chainID string, vals *ValidatorSet) error {
+	if vals.Size() != len(ec.ExtendedSignatures) {
+		return cmterrors.NewErrInvalidCommitSignatures(vals.Size(), len(ec.ExtendedSignatures))
+	}
+	for idx, ecs := range ec.ExtendedSignatures {
+		if ecs.BlockIDFlag == BlockIDFlagAbsent {
+			continue
+		}
+		if err := ec.VerifyExtension(chainID, int32(idx), vals.Validators[idx].PubKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ToCommit converts an ExtendedCommit to a Commit by removing all vote
 // extension-related fields.
 func (ec *ExtendedCommit) ToCommit() *Commit {
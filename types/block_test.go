@@ -396,6 +396,30 @@ func TestHeaderHash(t *testing.T) {
 	}
 }
 
+func TestHeaderFieldProofs(t *testing.T) {
+	h := makeRandHeader()
+	h.ValidatorsHash = tmhash.Sum([]byte("validators_hash"))
+	h.ConsensusHash = tmhash.Sum([]byte("consensus_hash"))
+	otherHash := tmhash.Sum([]byte("other_hash"))
+	hash := h.Hash()
+
+	proof, err := h.ValidatorsHashProof()
+	require.NoError(t, err)
+	require.NoError(t, VerifyValidatorsHashProof(hash, h.ValidatorsHash, proof))
+	require.Error(t, VerifyValidatorsHashProof(hash, otherHash, proof))
+	require.Error(t, VerifyConsensusHashProof(hash, h.ValidatorsHash, proof))
+
+	proof, err = h.ConsensusHashProof()
+	require.NoError(t, err)
+	require.NoError(t, VerifyConsensusHashProof(hash, h.ConsensusHash, proof))
+	require.Error(t, VerifyConsensusHashProof(hash, otherHash, proof))
+	require.Error(t, VerifyValidatorsHashProof(hash, h.ConsensusHash, proof))
+
+	var nilHeader *Header
+	_, err = nilHeader.ValidatorsHashProof()
+	require.Error(t, err)
+}
+
 func TestMaxHeaderBytes(t *testing.T) {
 	// Construct a UTF-8 string of MaxChainIDLen length using the supplementary
 	// characters.
@@ -652,6 +676,40 @@ func TestExtendedCommitToVoteSet(t *testing.T) {
 	}
 }
 
+func TestExtendedCommitVerifyExtensions(t *testing.T) {
+	blockID := makeBlockIDRandom()
+	h := int64(3)
+
+	voteSet, valSet, vals := randVoteSet(h, 1, cmtproto.PrecommitType, 4, 1, true)
+	extCommit, err := MakeExtCommit(blockID, h, 1, voteSet, vals, time.Now(), true)
+	require.NoError(t, err)
+	chainID := voteSet.ChainID()
+
+	require.NoError(t, extCommit.VerifyExtensions(chainID, valSet))
+	for i, val := range valSet.Validators {
+		require.NoError(t, extCommit.VerifyExtension(chainID, int32(i), val.PubKey))
+	}
+
+	// absent precommits are skipped, but can't be verified on their own
+	absent := extCommit.Clone()
+	absent.ExtendedSignatures[1] = NewExtendedCommitSigAbsent()
+	require.NoError(t, absent.VerifyExtensions(chainID, valSet))
+	require.Error(t, absent.VerifyExtension(chainID, 1, valSet.Validators[1].PubKey))
+
+	// a tampered extension is rejected
+	tampered := extCommit.Clone()
+	tampered.ExtendedSignatures[2].Extension = []byte("tampered")
+	require.Error(t, tampered.VerifyExtensions(chainID, valSet))
+	require.Error(t, tampered.VerifyExtension(chainID, 2, valSet.Validators[2].PubKey))
+
+	// the precommits must be signed by the given validators
+	require.Error(t, extCommit.VerifyExtension(chainID, 0, valSet.Validators[1].PubKey))
+	require.Error(t, extCommit.VerifyExtension(chainID, 4, valSet.Validators[1].PubKey))
+	require.Error(t, extCommit.VerifyExtensions("other-chain", valSet))
+	otherVals, _ := RandValidatorSet(3, 1)
+	require.Error(t, extCommit.VerifyExtensions(chainID, otherVals))
+}
+
 func TestCommitToVoteSetWithVotesForNilBlock(t *testing.T) {
 	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
 
@@ -160,6 +160,37 @@ func (b *EventBus) PublishEventNewEvidence(evidence EventDataNewEvidence) error
 	return b.Publish(EventNewEvidence, evidence)
 }
 
+// PublishEventEvidenceAdded publishes an evidence event. Note it will add
+// the predefined EvidenceHashKey key.
+func (b *EventBus) PublishEventEvidenceAdded(data EventDataEvidence) error {
+	return b.publishEvidence(EventEvidenceAdded, data)
+}
+
+// PublishEventEvidenceCommitted publishes the event of evidence committed in a
+// block. Note it will add the predefined EvidenceHashKey key.
+func (b *EventBus) PublishEventEvidenceCommitted(data EventDataEvidence) error {
+	return b.publishEvidence(EventEvidenceCommitted, data)
+}
+
+// PublishEventEvidenceExpired publishes the event of pending evidence removed
+// from the pool because it expired before being committed. Note it will add
+// the predefined EvidenceHashKey key.
+func (b *EventBus) PublishEventEvidenceExpired(data EventDataEvidence) error {
+	return b.publishEvidence(EventEvidenceExpired, data)
+}
+
+func (b *EventBus) publishEvidence(eventType string, data EventDataEvidence) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey:    {eventType},
+		EvidenceHashKey: {fmt.Sprintf("%X", data.Evidence.Hash())},
+	}
+
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
 func (b *EventBus) PublishEventVote(data EventDataVote) error {
 	return b.Publish(EventVote, data)
 }
@@ -261,6 +292,18 @@ func (NopEventBus) PublishEventNewEvidence(EventDataNewEvidence) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventEvidenceAdded(EventDataEvidence) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventEvidenceCommitted(EventDataEvidence) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventEvidenceExpired(EventDataEvidence) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventVote(EventDataVote) error {
 	return nil
 }
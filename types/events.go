@@ -23,6 +23,13 @@ const (
 	EventTx                  = "Tx"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
 
+	// Evidence pool events.
+	// These are triggered from the evidence package as evidence goes through
+	// the pool.
+	EventEvidenceAdded     = "EvidenceAdded"
+	EventEvidenceCommitted = "EvidenceCommitted"
+	EventEvidenceExpired   = "EvidenceExpired"
+
 	// Internal consensus events.
 	// These are used for testing the consensus state machine.
 	// They can also be used to build real-time consensus visualizers.
@@ -52,6 +59,7 @@ func init() {
 	cmtjson.RegisterType(EventDataNewBlockHeader{}, "tendermint/event/NewBlockHeader")
 	cmtjson.RegisterType(EventDataNewBlockEvents{}, "tendermint/event/NewBlockEvents")
 	cmtjson.RegisterType(EventDataNewEvidence{}, "tendermint/event/NewEvidence")
+	cmtjson.RegisterType(EventDataEvidence{}, "tendermint/event/Evidence")
 	cmtjson.RegisterType(EventDataTx{}, "tendermint/event/Tx")
 	cmtjson.RegisterType(EventDataRoundState{}, "tendermint/event/RoundState")
 	cmtjson.RegisterType(EventDataNewRound{}, "tendermint/event/NewRound")
@@ -85,6 +93,13 @@ type EventDataNewEvidence struct {
 	Evidence Evidence `json:"evidence"`
 }
 
+// EventDataEvidence is fired when evidence is added to the evidence pool,
+// committed or expired. Height is the last block height known to the pool.
+type EventDataEvidence struct {
+	Height   int64    `json:"height"`
+	Evidence Evidence `json:"evidence"`
+}
+
 // All txs fire EventDataTx
 type EventDataTx struct {
 	abci.TxResult
@@ -144,10 +159,17 @@ const (
 
 	// BlockHeightKey is a reserved key used for indexing FinalizeBlock events.
 	BlockHeightKey = "block.height"
+
+	// EvidenceHashKey is a reserved key, used to specify evidence's hash.
+	// see EventBus#PublishEventEvidenceAdded
+	EvidenceHashKey = "evidence.hash"
 )
 
 var (
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
+	EventQueryEvidenceAdded       = QueryForEvent(EventEvidenceAdded)
+	EventQueryEvidenceCommitted   = QueryForEvent(EventEvidenceCommitted)
+	EventQueryEvidenceExpired     = QueryForEvent(EventEvidenceExpired)
 	EventQueryLock                = QueryForEvent(EventLock)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
@@ -187,3 +209,10 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
+
+// EvidenceEventPublisher publishes the evidence pool events.
+type EvidenceEventPublisher interface {
+	PublishEventEvidenceAdded(EventDataEvidence) error
+	PublishEventEvidenceCommitted(EventDataEvidence) error
+	PublishEventEvidenceExpired(EventDataEvidence) error
+}
@@ -47,6 +47,7 @@ type ConsensusParams struct {
 	Version   VersionParams   `json:"version"`
 	ABCI      ABCIParams      `json:"abci"`
 	Authority AuthorityParams `json:"authority"`
+	Timeout   TimeoutParams   `json:"timeout"`
 }
 
 // BlockParams define limits on the block size and gas plus minimum time
@@ -98,6 +99,17 @@ type AuthorityParams struct {
 	Authority string `json:"authority"`
 }
 
+// TimeoutParams configure the timeouts of the consensus steps on chain. A zero
+// timeout isn't set on chain: the one of the node's configuration is used
+// instead.
+type TimeoutParams struct {
+	Propose      time.Duration `json:"propose"`
+	ProposeDelta time.Duration `json:"propose_delta"`
+	Vote         time.Duration `json:"vote"`
+	VoteDelta    time.Duration `json:"vote_delta"`
+	Commit       time.Duration `json:"commit"`
+}
+
 // DefaultConsensusParams returns a default ConsensusParams.
 func DefaultConsensusParams() *ConsensusParams {
 	return &ConsensusParams{
@@ -107,6 +119,7 @@ func DefaultConsensusParams() *ConsensusParams {
 		Version:   DefaultVersionParams(),
 		ABCI:      DefaultABCIParams(),
 		Authority: DefaultAuthorityParams(),
+		Timeout:   DefaultTimeoutParams(),
 	}
 }
 
@@ -157,6 +170,12 @@ func DefaultAuthorityParams() AuthorityParams {
 	}
 }
 
+// DefaultTimeoutParams returns a default TimeoutParams, which doesn't set any
+// timeout on chain.
+func DefaultTimeoutParams() TimeoutParams {
+	return TimeoutParams{}
+}
+
 func IsValidPubkeyType(params ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -235,6 +254,22 @@ func (params ConsensusParams) ValidateBasic() error {
 			maxAuthorityLength, len(params.Authority.Authority))
 	}
 
+	if params.Timeout.Propose < 0 {
+		return fmt.Errorf("timeout.Propose cannot be negative. Got: %v", params.Timeout.Propose)
+	}
+	if params.Timeout.ProposeDelta < 0 {
+		return fmt.Errorf("timeout.ProposeDelta cannot be negative. Got: %v", params.Timeout.ProposeDelta)
+	}
+	if params.Timeout.Vote < 0 {
+		return fmt.Errorf("timeout.Vote cannot be negative. Got: %v", params.Timeout.Vote)
+	}
+	if params.Timeout.VoteDelta < 0 {
+		return fmt.Errorf("timeout.VoteDelta cannot be negative. Got: %v", params.Timeout.VoteDelta)
+	}
+	if params.Timeout.Commit < 0 {
+		return fmt.Errorf("timeout.Commit cannot be negative. Got: %v", params.Timeout.Commit)
+	}
+
 	return nil
 }
 
@@ -355,6 +390,9 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 	if params2.Authority != nil {
 		res.Authority.Authority = params2.Authority.Authority
 	}
+	if params2.Timeout != nil {
+		res.Timeout = TimeoutParamsFromProto(params2.Timeout)
+	}
 	return res
 }
 
@@ -381,6 +419,13 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 		Authority: &cmtproto.AuthorityParams{
 			Authority: params.Authority.Authority,
 		},
+		Timeout: &cmtproto.TimeoutParams{
+			Propose:      params.Timeout.Propose,
+			ProposeDelta: params.Timeout.ProposeDelta,
+			Vote:         params.Timeout.Vote,
+			VoteDelta:    params.Timeout.VoteDelta,
+			Commit:       params.Timeout.Commit,
+		},
 	}
 }
 
@@ -408,5 +453,18 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 	if pbParams.Authority != nil {
 		c.Authority.Authority = pbParams.Authority.Authority
 	}
+	if pbParams.Timeout != nil {
+		c.Timeout = TimeoutParamsFromProto(pbParams.Timeout)
+	}
 	return c
 }
+
+func TimeoutParamsFromProto(pbParams *cmtproto.TimeoutParams) TimeoutParams {
+	return TimeoutParams{
+		Propose:      pbParams.Propose,
+		ProposeDelta: pbParams.ProposeDelta,
+		Vote:         pbParams.Vote,
+		VoteDelta:    pbParams.VoteDelta,
+		Commit:       pbParams.Commit,
+	}
+}
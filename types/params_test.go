@@ -172,6 +172,21 @@ func TestConsensusParamsUpdate_Authority(t *testing.T) {
 	assert.Equal(t, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", updated.Authority.Authority)
 }
 
+func TestConsensusParamsUpdate_Timeout(t *testing.T) {
+	params := makeParams(1, 2, 3, 0, valEd25519, 0, "")
+
+	assert.Equal(t, DefaultTimeoutParams(), params.Timeout)
+
+	updated := params.Update(
+		&cmtproto.ConsensusParams{Timeout: &cmtproto.TimeoutParams{Propose: time.Second, Commit: 2 * time.Second}})
+
+	assert.Equal(t, TimeoutParams{Propose: time.Second, Commit: 2 * time.Second}, updated.Timeout)
+	assert.NoError(t, updated.ValidateBasic())
+
+	updated.Timeout.VoteDelta = -time.Second
+	assert.Error(t, updated.ValidateBasic())
+}
+
 func TestConsensusParamsUpdate_VoteExtensionsEnableHeight(t *testing.T) {
 	const nilTest = -10000000
 	testCases := []struct {
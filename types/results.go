@@ -41,3 +41,43 @@ func (a ABCIResults) toByteSlices() [][]byte {
 	}
 	return bzs
 }
+
+// ABCIEvents wraps a list of events to return a proof. CometBFT does not
+// commit to events, so the proof only binds an event to the chain by an
+// application convention: if the application sets the Data of each
+// ExecTxResult to the hash of its events, the tx events become provable against
+// the LastResultsHash of the next header. FinalizeBlock events can't be proven.
+type ABCIEvents []*abci.Event
+
+// NewEvents strips indexing hints from events and returns ABCIEvents.
+func NewEvents(events []abci.Event) ABCIEvents {
+	res := make(ABCIEvents, len(events))
+	for i, e := range events {
+		res[i] = abci.DeterministicEvent(e)
+	}
+	return res
+}
+
+// Hash returns a merkle hash of all events.
+func (a ABCIEvents) Hash() []byte {
+	return merkle.HashFromByteSlices(a.toByteSlices())
+}
+
+// ProveEvent returns a merkle proof of one event from the set
+func (a ABCIEvents) ProveEvent(i int) merkle.Proof {
+	_, proofs := merkle.ProofsFromByteSlices(a.toByteSlices())
+	return *proofs[i]
+}
+
+func (a ABCIEvents) toByteSlices() [][]byte {
+	l := len(a)
+	bzs := make([][]byte, l)
+	for i := 0; i < l; i++ {
+		bz, err := a[i].Marshal()
+		if err != nil {
+			panic(err)
+		}
+		bzs[i] = bz
+	}
+	return bzs
+}
@@ -52,3 +52,34 @@ func TestABCIResults(t *testing.T) {
 		assert.NoError(t, valid, "%d", i)
 	}
 }
+
+func TestABCIEvents(t *testing.T) {
+	events := []abci.Event{
+		{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: "sender", Value: "alice", Index: true},
+			{Key: "amount", Value: "10"},
+		}},
+		{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: "sender", Value: "bob"},
+		}},
+		{Type: "empty"},
+	}
+	commitment := NewEvents(events)
+	root := commitment.Hash()
+	require.NotEmpty(t, root)
+
+	// Indexing hints are not committed to.
+	events[0].Attributes[0].Index = false
+	require.Equal(t, root, NewEvents(events).Hash())
+
+	for i, e := range commitment {
+		bz, err := e.Marshal()
+		require.NoError(t, err)
+
+		proof := commitment.ProveEvent(i)
+		assert.NoError(t, proof.Verify(root, bz), "%d", i)
+	}
+
+	events[1].Attributes[0].Value = "carol"
+	require.NotEqual(t, root, NewEvents(events).Hash())
+}
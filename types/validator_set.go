@@ -261,8 +261,11 @@ func validatorListCopy(valsList []*Validator) []*Validator {
 	return valsCopy
 }
 
-// Copy each validator into a new ValidatorSet.
+// Copy each validator into a new ValidatorSet. Copy of nil is nil.
 func (vals *ValidatorSet) Copy() *ValidatorSet {
+	if vals == nil {
+		return nil
+	}
 	return &ValidatorSet{
 		Validators:          validatorListCopy(vals.Validators),
 		Proposer:            vals.Proposer,